@@ -15,6 +15,16 @@ import (
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 )
 
+const (
+	FlagState      = "state"
+	FlagStartEpoch = "start-epoch"
+	FlagEndEpoch   = "end-epoch"
+	FlagAddress    = "address"
+	FlagValidator  = "validator"
+	FlagEpoch      = "epoch"
+	FlagRecipient  = "recipient"
+)
+
 // NewQueryCmd returns the parent command for all x/liquidstakeibc CLi query commands.
 func NewQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -37,6 +47,20 @@ func NewQueryCmd() *cobra.Command {
 		QueryDepositAccountBalanceCmd(),
 		QueryExchangeRateCmd(),
 		QueryUnbondingCmd(),
+		QueryLiquidStakeCapacityCmd(),
+		QueryOutflowQuotaCmd(),
+		QuerySlashRecordsCmd(),
+		QueryValidatorSetProposalCmd(),
+		QueryHostChainVotesCmd(),
+		QueryVoteSignalingCmd(),
+		QueryTimelockedUpdatesCmd(),
+		QueryCValueHistoryCmd(),
+		QueryRewardRateCmd(),
+		QuerySimulateLiquidStakeCmd(),
+		QuerySimulateLiquidStakeLSMCmd(),
+		QuerySimulateLiquidUnstakeCmd(),
+		QuerySimulateRedeemCmd(),
+		QueryUnbondingEstimatesCmd(),
 	)
 
 	return cmd
@@ -129,7 +153,36 @@ func QueryDepositsCmd() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.Deposits(cmd.Context(), &types.QueryDepositsRequest{ChainId: args[0]})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			state, err := cmd.Flags().GetString(FlagState)
+			if err != nil {
+				return err
+			}
+
+			startEpoch, err := cmd.Flags().GetInt64(FlagStartEpoch)
+			if err != nil {
+				return err
+			}
+
+			endEpoch, err := cmd.Flags().GetInt64(FlagEndEpoch)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Deposits(
+				cmd.Context(),
+				&types.QueryDepositsRequest{
+					ChainId:    args[0],
+					Pagination: pageReq,
+					State:      state,
+					StartEpoch: startEpoch,
+					EndEpoch:   endEpoch,
+				},
+			)
 			if err != nil {
 				return err
 			}
@@ -139,6 +192,10 @@ func QueryDepositsCmd() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "deposits")
+	cmd.Flags().String(FlagState, "", "deposit state to filter by, e.g. DEPOSIT_PENDING")
+	cmd.Flags().Int64(FlagStartEpoch, 0, "first epoch to query")
+	cmd.Flags().Int64(FlagEndEpoch, 0, "last epoch to query, no upper bound if 0")
 
 	return cmd
 }
@@ -163,7 +220,24 @@ func QueryLSMDepositsCmd() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.LSMDeposits(cmd.Context(), &types.QueryLSMDepositsRequest{ChainId: args[0]})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			state, err := cmd.Flags().GetString(FlagState)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.LSMDeposits(
+				cmd.Context(),
+				&types.QueryLSMDepositsRequest{
+					ChainId:    args[0],
+					Pagination: pageReq,
+					State:      state,
+				},
+			)
 			if err != nil {
 				return err
 			}
@@ -173,6 +247,8 @@ func QueryLSMDepositsCmd() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "lsm-deposits")
+	cmd.Flags().String(FlagState, "", "lsm deposit state to filter by, e.g. DEPOSIT_RECEIVED")
 
 	return cmd
 }
@@ -197,7 +273,36 @@ func QueryUnbondingsCmd() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.Unbondings(context.Background(), &types.QueryUnbondingsRequest{ChainId: args[0]})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			state, err := cmd.Flags().GetString(FlagState)
+			if err != nil {
+				return err
+			}
+
+			startEpoch, err := cmd.Flags().GetInt64(FlagStartEpoch)
+			if err != nil {
+				return err
+			}
+
+			endEpoch, err := cmd.Flags().GetInt64(FlagEndEpoch)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Unbondings(
+				context.Background(),
+				&types.QueryUnbondingsRequest{
+					ChainId:    args[0],
+					Pagination: pageReq,
+					State:      state,
+					StartEpoch: startEpoch,
+					EndEpoch:   endEpoch,
+				},
+			)
 			if err != nil {
 				return err
 			}
@@ -207,6 +312,10 @@ func QueryUnbondingsCmd() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbondings")
+	cmd.Flags().String(FlagState, "", "unbonding state to filter by, e.g. UNBONDING_MATURING")
+	cmd.Flags().Int64(FlagStartEpoch, 0, "first epoch to query")
+	cmd.Flags().Int64(FlagEndEpoch, 0, "last epoch to query, no upper bound if 0")
 
 	return cmd
 }
@@ -277,10 +386,28 @@ func QueryUserUnbondingsCmd() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			startEpoch, err := cmd.Flags().GetInt64(FlagStartEpoch)
+			if err != nil {
+				return err
+			}
+
+			endEpoch, err := cmd.Flags().GetInt64(FlagEndEpoch)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.UserUnbondings(
 				context.Background(),
 				&types.QueryUserUnbondingsRequest{
-					Address: args[0],
+					Address:    args[0],
+					Pagination: pageReq,
+					StartEpoch: startEpoch,
+					EndEpoch:   endEpoch,
 				},
 			)
 			if err != nil {
@@ -292,6 +419,9 @@ func QueryUserUnbondingsCmd() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "user-unbondings")
+	cmd.Flags().Int64(FlagStartEpoch, 0, "first epoch to query")
+	cmd.Flags().Int64(FlagEndEpoch, 0, "last epoch to query, no upper bound if 0")
 
 	return cmd
 }
@@ -316,10 +446,28 @@ func QueryValidatorUnbondingsCmd() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			startEpoch, err := cmd.Flags().GetInt64(FlagStartEpoch)
+			if err != nil {
+				return err
+			}
+
+			endEpoch, err := cmd.Flags().GetInt64(FlagEndEpoch)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.ValidatorUnbondings(
 				context.Background(),
 				&types.QueryValidatorUnbondingRequest{
-					ChainId: args[0],
+					ChainId:    args[0],
+					Pagination: pageReq,
+					StartEpoch: startEpoch,
+					EndEpoch:   endEpoch,
 				},
 			)
 			if err != nil {
@@ -331,6 +479,9 @@ func QueryValidatorUnbondingsCmd() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator-unbondings")
+	cmd.Flags().Int64(FlagStartEpoch, 0, "first epoch to query")
+	cmd.Flags().Int64(FlagEndEpoch, 0, "last epoch to query, no upper bound if 0")
 
 	return cmd
 }
@@ -405,3 +556,633 @@ func QueryExchangeRateCmd() *cobra.Command {
 
 	return cmd
 }
+
+// QueryLiquidStakeCapacityCmd returns the amount that can still be liquid staked on a host chain.
+func QueryLiquidStakeCapacityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquid-stake-capacity [chain-id]",
+		Short: "Query the remaining liquid stake capacity of a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the remaining liquid stake capacity of a host chain: $ %s query liquidstakeibc liquid-stake-capacity [chain-id] --address [address]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := cmd.Flags().GetString(FlagAddress)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LiquidStakeCapacity(
+				cmd.Context(),
+				&types.QueryLiquidStakeCapacityRequest{ChainId: args[0], Address: address},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagAddress, "", "address to include the per address epoch cap for")
+
+	return cmd
+}
+
+// QueryOutflowQuotaCmd returns the amount that can still be liquid unstaked and instantly redeemed on a host chain.
+func QueryOutflowQuotaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outflow-quota [chain-id]",
+		Short: "Query the remaining liquid unstake and instant redeem quota of a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the remaining liquid unstake and instant redeem quota of a host chain: $ %s query liquidstakeibc outflow-quota [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OutflowQuota(cmd.Context(), &types.QueryOutflowQuotaRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QuerySlashRecordsCmd returns the slash records of the validators of a host chain.
+func QuerySlashRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-records [chain-id]",
+		Short: "Query the slash records of the validators of a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the slash records of the validators of a host chain: $ %s query liquidstakeibc slash-records [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			validatorAddress, err := cmd.Flags().GetString(FlagValidator)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SlashRecords(
+				cmd.Context(),
+				&types.QuerySlashRecordsRequest{
+					ChainId:          args[0],
+					ValidatorAddress: validatorAddress,
+					Pagination:       pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slash-records")
+	cmd.Flags().String(FlagValidator, "", "validator address to filter by")
+
+	return cmd
+}
+
+// QueryValidatorSetProposalCmd returns the latest validator set proposal of a host chain.
+func QueryValidatorSetProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-set-proposal [chain-id]",
+		Short: "Query the latest validator set proposal of a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the latest validator set proposal of a host chain: $ %s query liquidstakeibc validator-set-proposal [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ValidatorSetProposal(
+				cmd.Context(),
+				&types.QueryValidatorSetProposalRequest{ChainId: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryHostChainVotesCmd returns the governance votes cast on a host chain.
+func QueryHostChainVotesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host-chain-votes [chain-id]",
+		Short: "Query the governance votes cast on a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the governance votes cast on a host chain: $ %s query liquidstakeibc host-chain-votes [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HostChainVotes(
+				cmd.Context(),
+				&types.QueryHostChainVotesRequest{ChainId: args[0], Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "host-chain-votes")
+
+	return cmd
+}
+
+// QueryVoteSignalingCmd returns a stk holder vote signaling on a host chain proposal, its current tally and its signals.
+func QueryVoteSignalingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-signaling [chain-id] [proposal-id]",
+		Short: "Query a vote signaling on a host chain proposal and its current tally",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query a vote signaling on a host chain proposal: $ %s query liquidstakeibc vote-signaling [chain-id] [proposal-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VoteSignaling(
+				cmd.Context(),
+				&types.QueryVoteSignalingRequest{ChainId: args[0], ProposalId: proposalID, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "vote-signaling")
+
+	return cmd
+}
+
+// QueryTimelockedUpdatesCmd returns the host chain updates waiting for their timelock.
+func QueryTimelockedUpdatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timelocked-updates [chain-id]",
+		Short: "Query the host chain updates waiting for their timelock",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the timelocked updates of a host chain: $ %s query liquidstakeibc timelocked-updates [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TimelockedUpdates(
+				cmd.Context(),
+				&types.QueryTimelockedUpdatesRequest{
+					ChainId:    args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "timelocked-updates")
+
+	return cmd
+}
+
+// QueryCValueHistoryCmd returns the c value history of a host chain.
+func QueryCValueHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "c-value-history [chain-id]",
+		Short: "Query the c value history of a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the c value history of a host chain: $ %s query liquidstakeibc c-value-history [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			startEpoch, err := cmd.Flags().GetInt64(FlagStartEpoch)
+			if err != nil {
+				return err
+			}
+
+			endEpoch, err := cmd.Flags().GetInt64(FlagEndEpoch)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CValueHistory(
+				cmd.Context(),
+				&types.QueryCValueHistoryRequest{
+					ChainId:    args[0],
+					StartEpoch: startEpoch,
+					EndEpoch:   endEpoch,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "c-value-history")
+	cmd.Flags().Int64(FlagStartEpoch, 0, "first c value epoch to query")
+	cmd.Flags().Int64(FlagEndEpoch, 0, "last c value epoch to query, no upper bound if 0")
+
+	return cmd
+}
+
+// QueryRewardRateCmd returns the trailing reward rates of a host chain.
+func QueryRewardRateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-rate [chain-id]",
+		Short: "Query the trailing APR and APY of a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the 7 and 30 epoch APR and APY of a host chain: $ %s query liquidstakeibc reward-rate [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RewardRate(
+				cmd.Context(),
+				&types.QueryRewardRateRequest{ChainId: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QuerySimulateLiquidStakeCmd returns the outcome of liquid staking an amount of ibc tokens.
+func QuerySimulateLiquidStakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-liquid-stake [amount]",
+		Short: "Simulate liquid staking an amount of ibc tokens",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Simulate liquid staking an amount of ibc tokens: $ %s query liquidstakeibc simulate-liquid-stake [amount] --address [address]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := cmd.Flags().GetString(FlagAddress)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateLiquidStake(
+				cmd.Context(),
+				&types.QuerySimulateLiquidStakeRequest{DelegatorAddress: address, Amount: amount},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagAddress, "", "address liquid staking, to include the per address epoch cap for")
+
+	return cmd
+}
+
+// QuerySimulateLiquidStakeLSMCmd returns the outcome of liquid staking an amount of ibc LSM shares.
+func QuerySimulateLiquidStakeLSMCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-liquid-stake-lsm [amount]",
+		Short: "Simulate liquid staking an amount of ibc LSM shares",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Simulate liquid staking an amount of ibc LSM shares: $ %s query liquidstakeibc simulate-liquid-stake-lsm [amount] --address [address]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := cmd.Flags().GetString(FlagAddress)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateLiquidStakeLSM(
+				cmd.Context(),
+				&types.QuerySimulateLiquidStakeLSMRequest{DelegatorAddress: address, Delegation: amount},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagAddress, "", "address owning the LSM shares")
+
+	return cmd
+}
+
+// QuerySimulateLiquidUnstakeCmd returns the outcome of liquid unstaking an amount of stk tokens.
+func QuerySimulateLiquidUnstakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-liquid-unstake [amount]",
+		Short: "Simulate liquid unstaking an amount of stk tokens",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Simulate liquid unstaking an amount of stk tokens: $ %s query liquidstakeibc simulate-liquid-unstake [amount]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateLiquidUnstake(
+				cmd.Context(),
+				&types.QuerySimulateLiquidUnstakeRequest{Amount: amount},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QuerySimulateRedeemCmd returns the outcome of instantly redeeming an amount of stk tokens.
+func QuerySimulateRedeemCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-redeem [amount]",
+		Short: "Simulate instantly redeeming an amount of stk tokens",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Simulate instantly redeeming an amount of stk tokens: $ %s query liquidstakeibc simulate-redeem [amount]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateRedeem(
+				cmd.Context(),
+				&types.QuerySimulateRedeemRequest{Amount: amount},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryUnbondingEstimatesCmd returns the estimated unbonding times of an address or an unbonding epoch.
+func QueryUnbondingEstimatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-estimates [chain-id]",
+		Short: "Query the estimated unbonding times of an address or an unbonding epoch",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the estimated unbonding times of an address or an unbonding epoch: $ %s query liquidstakeibc unbonding-estimates [chain-id] --address [address] --epoch [epoch]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := cmd.Flags().GetString(FlagAddress)
+			if err != nil {
+				return err
+			}
+
+			epoch, err := cmd.Flags().GetInt64(FlagEpoch)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UnbondingEstimates(
+				cmd.Context(),
+				&types.QueryUnbondingEstimatesRequest{ChainId: args[0], Address: address, Epoch: epoch},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagAddress, "", "address to estimate the unbondings of")
+	cmd.Flags().Int64(FlagEpoch, 0, "unbonding epoch to estimate")
+
+	return cmd
+}
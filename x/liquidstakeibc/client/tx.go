@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
@@ -36,6 +37,12 @@ func NewTxCmd() *cobra.Command {
 		NewLiquidUnstakeCmd(),
 		NewRedeemCmd(),
 		NewUpdateParamsCmd(),
+		NewVoteOnHostChainProposalCmd(),
+		NewSignalHostChainVoteCmd(),
+		NewDeregisterHostChainCmd(),
+		NewCancelTimelockedUpdateCmd(),
+		NewClaimCmd(),
+		NewSetAutoClaimCmd(),
 	)
 
 	return txCmd
@@ -367,3 +374,241 @@ Params file contents:
 
 	return cmd
 }
+
+// NewVoteOnHostChainProposalCmd implements the command to vote on a host chain governance proposal.
+func NewVoteOnHostChainProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-host-chain-proposal [chain-id] [proposal-id] [options]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Vote on a host chain governance proposal with the delegation account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit a vote on a host chain governance proposal, options are a single vote option or weighted options:
+$ %s tx liquidstakeibc vote-host-chain-proposal gaia-1 10 yes
+$ %s tx liquidstakeibc vote-host-chain-proposal gaia-1 10 yes=0.6,no=0.3,abstain=0.1`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			options, err := govv1beta1.WeightedVoteOptionsFromString(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVoteOnHostChainProposal(clientCtx.GetFromAddress(), args[0], proposalID, options)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSignalHostChainVoteCmd implements the command to signal a vote on a host chain governance proposal.
+func NewSignalHostChainVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signal-host-chain-vote [chain-id] [proposal-id] [options]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Signal a vote on a host chain governance proposal, weighted by the liquid staked token balance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit a vote signal on a host chain governance proposal, options are a single vote option or weighted options:
+$ %s tx liquidstakeibc signal-host-chain-vote gaia-1 10 yes
+$ %s tx liquidstakeibc signal-host-chain-vote gaia-1 10 yes=0.6,no=0.3,abstain=0.1`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			options, err := govv1beta1.WeightedVoteOptionsFromString(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSignalHostChainVote(clientCtx.GetFromAddress(), args[0], proposalID, options)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewDeregisterHostChainCmd implements the command to deregister a host chain.
+func NewDeregisterHostChainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister-host-chain [chain-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Deregister a host chain, unbonding all of its liquid stakers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit a deregister host chain transaction: $ %s tx liquidstakeibc deregister-host-chain gaia-1`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeregisterHostChain(clientCtx.GetFromAddress(), args[0])
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelTimelockedUpdateCmd implements the command to cancel queued host chain updates.
+func NewCancelTimelockedUpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-timelocked-update [chain-id] [id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Cancel host chain updates waiting for their timelock",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit a cancel timelocked update transaction: $ %s tx liquidstakeibc cancel-timelocked-update gaia-1 3`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelTimelockedUpdate(clientCtx.GetFromAddress(), args[0], id)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewClaimCmd implements the command to claim matured unbondings.
+func NewClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim [chain-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Claim all claimable unbondings of the sender for a host chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit a claim transaction: $ %s tx liquidstakeibc claim gaia-1 --recipient persistence1...`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := cmd.Flags().GetString(FlagRecipient)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaim(clientCtx.GetFromAddress(), args[0], recipient)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagRecipient, "", "address receiving the claimed tokens, defaults to the sender")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetAutoClaimCmd implements the command to opt in or out of the automatic claim.
+func NewSetAutoClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-claim [true|false]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Opt in or out of the automatic claim of matured unbondings",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Submit a set auto claim transaction: $ %s tx liquidstakeibc set-auto-claim false`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			autoClaim, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoClaim(clientCtx.GetFromAddress(), autoClaim)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
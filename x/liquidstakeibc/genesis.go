@@ -28,6 +28,65 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState *types.GenesisState)
 	for _, valUnbonding := range genState.ValidatorUnbondings {
 		k.SetValidatorUnbonding(ctx, valUnbonding)
 	}
+	for _, lsmDeposit := range genState.LsmDeposits {
+		k.SetLSMDeposit(ctx, lsmDeposit)
+	}
+	for _, redelegation := range genState.Redelegations {
+		k.SetRedelegation(ctx, redelegation)
+	}
+	for _, slashRecord := range genState.SlashRecords {
+		k.SetSlashRecord(ctx, slashRecord)
+	}
+	for _, proposal := range genState.ValidatorSetProposals {
+		k.SetValidatorSetProposal(ctx, proposal)
+	}
+	for _, vote := range genState.HostChainVotes {
+		k.SetHostChainVote(ctx, vote)
+	}
+	for _, signaling := range genState.VoteSignalings {
+		k.SetVoteSignaling(ctx, signaling)
+	}
+	for _, signal := range genState.VoteSignals {
+		k.SetVoteSignal(ctx, signal)
+	}
+	for _, update := range genState.TimelockedUpdates {
+		k.SetTimelockedUpdate(ctx, update)
+
+		// keep the timelocked update ids unique
+		if update.Id >= k.GetNextTimelockedUpdateID(ctx) {
+			k.SetNextTimelockedUpdateID(ctx, update.Id+1)
+		}
+	}
+	for _, record := range genState.CValueRecords {
+		k.SetCValueRecord(ctx, record)
+	}
+	for _, record := range genState.RewardRecords {
+		k.SetRewardRecord(ctx, record)
+	}
+	for _, address := range genState.AutoClaimOptOuts {
+		k.SetAutoClaim(ctx, address, false)
+	}
+	for _, inflow := range genState.Inflows {
+		k.SetInflow(ctx, inflow)
+	}
+	for _, outflow := range genState.RedeemOutflows {
+		k.SetRedeemOutflow(ctx, outflow)
+	}
+	for _, round := range genState.ValidatorSetRounds {
+		k.SetValidatorSetRound(ctx, round)
+	}
+	for _, candidate := range genState.ValidatorSetCandidates {
+		k.SetValidatorSetCandidate(ctx, candidate)
+	}
+	for _, cursor := range genState.WorkflowCursors {
+		k.SetWorkflowCursor(ctx, cursor.ChainId, cursor.Workflow, cursor.Cursor)
+	}
+	if genState.TimelockEpochsUpdate != nil {
+		k.SetTimelockEpochsUpdate(ctx, genState.TimelockEpochsUpdate)
+	}
+	for _, record := range genState.RedemptionRecords {
+		k.SetRedemptionRecord(ctx, record)
+	}
 
 	k.GetDepositModuleAccount(ctx)
 	k.GetUndelegationModuleAccount(ctx)
@@ -36,12 +95,35 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState *types.GenesisState)
 // ExportGenesis returns the liquidstakeibc module's genesis state.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
+	timelockEpochsUpdate, found := k.GetTimelockEpochsUpdate(ctx)
+	if !found {
+		timelockEpochsUpdate = nil
+	}
+
 	return &types.GenesisState{
-		Params:              k.GetParams(ctx),
-		HostChains:          k.GetAllHostChains(ctx),
-		Deposits:            k.GetAllDeposits(ctx),
-		Unbondings:          k.FilterUnbondings(ctx, func(u types.Unbonding) bool { return true }),         //GetAll
-		UserUnbondings:      k.FilterUserUnbondings(ctx, func(u types.UserUnbonding) bool { return true }), //GetAll
-		ValidatorUnbondings: k.FilterValidatorUnbondings(ctx, func(u types.ValidatorUnbonding) bool { return true }),
+		Params:                 k.GetParams(ctx),
+		HostChains:             k.GetAllHostChains(ctx),
+		Deposits:               k.GetAllDeposits(ctx),
+		Unbondings:             k.FilterUnbondings(ctx, func(u types.Unbonding) bool { return true }),         //GetAll
+		UserUnbondings:         k.FilterUserUnbondings(ctx, func(u types.UserUnbonding) bool { return true }), //GetAll
+		ValidatorUnbondings:    k.FilterValidatorUnbondings(ctx, func(u types.ValidatorUnbonding) bool { return true }),
+		LsmDeposits:            k.FilterLSMDeposits(ctx, func(d types.LSMDeposit) bool { return true }),
+		Redelegations:          k.FilterRedelegations(ctx, func(r types.Redelegation) bool { return true }),
+		SlashRecords:           k.FilterSlashRecords(ctx, func(r types.SlashRecord) bool { return true }),
+		ValidatorSetProposals:  k.GetAllValidatorSetProposals(ctx),
+		HostChainVotes:         k.FilterHostChainVotes(ctx, func(v types.HostChainVote) bool { return true }),
+		VoteSignalings:         k.FilterVoteSignalings(ctx, func(s types.VoteSignaling) bool { return true }),
+		VoteSignals:            k.GetAllVoteSignals(ctx),
+		TimelockedUpdates:      k.GetTimelockedUpdates(ctx, ""),
+		CValueRecords:          k.GetCValueRecords(ctx, ""),
+		RewardRecords:          k.GetRewardRecords(ctx, ""),
+		AutoClaimOptOuts:       k.GetAutoClaimOptOuts(ctx),
+		Inflows:                k.GetAllInflows(ctx),
+		RedeemOutflows:         k.GetAllRedeemOutflows(ctx),
+		ValidatorSetRounds:     k.GetAllValidatorSetRounds(ctx),
+		ValidatorSetCandidates: k.GetAllValidatorSetCandidates(ctx),
+		WorkflowCursors:        k.GetAllWorkflowCursors(ctx),
+		TimelockEpochsUpdate:   timelockEpochsUpdate,
+		RedemptionRecords:      k.GetAllRedemptionRecords(ctx),
 	}
 }
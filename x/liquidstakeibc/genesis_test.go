@@ -1,11 +1,14 @@
 package liquidstakeibc_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 
@@ -21,12 +24,22 @@ func TestGenesis(t *testing.T) {
 			ChainId:      "chainA-1",
 			ConnectionId: "connection-1",
 			Params: &types.HostChainLSParams{
-				DepositFee:      sdk.ZeroDec(),
-				RestakeFee:      sdk.ZeroDec(),
-				UnstakeFee:      sdk.ZeroDec(),
-				RedemptionFee:   sdk.ZeroDec(),
-				LsmValidatorCap: sdk.NewDec(1),
-				LsmBondFactor:   sdk.NewDec(-1),
+				DepositFee:             sdk.ZeroDec(),
+				RestakeFee:             sdk.ZeroDec(),
+				UnstakeFee:             sdk.ZeroDec(),
+				RedemptionFee:          sdk.ZeroDec(),
+				LsmValidatorCap:        sdk.NewDec(1),
+				LsmBondFactor:          sdk.NewDec(-1),
+				MaxTvl:                 sdk.ZeroInt(),
+				MaxEpochInflow:         sdk.ZeroInt(),
+				MaxAddressEpochInflow:  sdk.ZeroInt(),
+				MaxEpochUnstake:        sdk.ZeroInt(),
+				MaxEpochRedeemRatio:    sdk.ZeroDec(),
+				SlashThreshold:         sdk.ZeroDec(),
+				MaxValidatorCommission: sdk.ZeroDec(),
+				UpperCValueLimit:       sdk.ZeroDec(),
+				LowerCValueLimit:       sdk.ZeroDec(),
+				MaxCValueDelta:         sdk.ZeroDec(),
 			},
 			HostDenom: "uatom",
 			ChannelId: "channel-1",
@@ -50,6 +63,8 @@ func TestGenesis(t *testing.T) {
 				DelegatedAmount: sdk.NewInt(1221),
 				ExchangeRate:    sdk.OneDec(),
 				UnbondingEpoch:  0,
+				Commission:      sdk.ZeroDec(),
+				Tokens:          sdk.ZeroInt(),
 			}},
 			MinimumDeposit:     sdk.OneInt(),
 			CValue:             sdk.OneDec(),
@@ -89,6 +104,16 @@ func TestGenesis(t *testing.T) {
 			Amount:           sdk.NewInt64Coin("uatom", 1000),
 			IbcSequenceId:    "",
 		}},
+		LsmDeposits: []*types.LSMDeposit{{
+			ChainId:          "chainA-1",
+			Amount:           sdk.NewInt(1000),
+			Shares:           sdk.NewDec(1000),
+			Denom:            "cosmosvaloper1hcqg5wj9t42zawqkqucs7la85ffyv08le09ljt/1",
+			IbcDenom:         "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9",
+			DelegatorAddress: authtypes.NewModuleAddressOrBech32Address("test").String(),
+			State:            types.LSMDeposit_DEPOSIT_SENT,
+			IbcSequenceId:    "channel-0-sequence-1",
+		}},
 	}
 
 	_, pStakeApp, ctx := helpers.CreateTestApp(t)
@@ -101,4 +126,435 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.Params, got.Params)
 	require.Equal(t, genesisState.HostChains, got.HostChains)
 	require.Equal(t, genesisState.Deposits, got.Deposits)
+	require.Equal(t, genesisState.LsmDeposits, got.LsmDeposits)
+
+	// the exported state must be importable into a fresh chain
+	_, newPStakeApp, newCtx := helpers.CreateTestApp(t)
+	newK := newPStakeApp.LiquidStakeIBCKeeper
+	liquidstakeibc.InitGenesis(newCtx, newK, got)
+
+	require.Equal(t, got.LsmDeposits, liquidstakeibc.ExportGenesis(newCtx, newK).LsmDeposits)
+}
+
+// TestGenesisRoundTrip exports a populated module state, imports it into a fresh app and checks that every
+// store prefix ends up byte-for-byte identical, so any record InitGenesis forgets to restore makes it fail.
+func TestGenesisRoundTrip(t *testing.T) {
+	genesisState := richGenesisState()
+	require.NoError(t, genesisState.Validate())
+
+	_, pStakeApp, ctx := helpers.CreateTestApp(t)
+	k := pStakeApp.LiquidStakeIBCKeeper
+	liquidstakeibc.InitGenesis(ctx, k, genesisState)
+
+	exported := liquidstakeibc.ExportGenesis(ctx, k)
+	require.NoError(t, exported.Validate())
+
+	_, newPStakeApp, newCtx := helpers.CreateTestApp(t)
+	newK := newPStakeApp.LiquidStakeIBCKeeper
+	liquidstakeibc.InitGenesis(newCtx, newK, exported)
+
+	store := ctx.KVStore(pStakeApp.GetKey(types.StoreKey))
+	newStore := newCtx.KVStore(newPStakeApp.GetKey(types.StoreKey))
+
+	for _, p := range []struct {
+		name   string
+		prefix []byte
+	}{
+		{"host chains", types.HostChainKey},
+		{"deposits", types.DepositKey},
+		{"unbondings", types.UnbondingKey},
+		{"user unbondings", types.UserUnbondingKey},
+		{"validator unbondings", types.ValidatorUnbondingKey},
+		{"params", types.ParamsKey},
+		{"lsm deposits", types.LSMDepositKey},
+		{"redelegations", types.RedelegationKey},
+		{"slash records", types.SlashRecordKey},
+		{"validator set proposals", types.ValidatorSetProposalKey},
+		{"host chain votes", types.HostChainVoteKey},
+		{"vote signalings", types.VoteSignalingKey},
+		{"vote signals", types.VoteSignalKey},
+		{"timelocked updates", types.TimelockedUpdateKey},
+		{"timelocked update id", types.TimelockedUpdateIDKey},
+		{"timelock epochs update", types.TimelockEpochsUpdateKey},
+		{"c value records", types.CValueRecordKey},
+		{"reward records", types.RewardRecordKey},
+		{"auto claim opt outs", types.AutoClaimOptOutKey},
+		{"inflows", types.InflowKey},
+		{"redeem outflows", types.RedeemOutflowKey},
+		{"validator set rounds", types.ValidatorSetRoundKey},
+		{"validator set candidates", types.ValidatorSetCandidateKey},
+		{"validator set candidate consensus index", types.ValidatorSetCandidateConsIndexKey},
+		{"workflow cursors", types.WorkflowCursorKey},
+		{"vote signaling end time index", types.VoteSignalingEndTimeIndexKey},
+		{"vote signaling closed index", types.VoteSignalingClosedIndexKey},
+		{"deposit sequence index", types.DepositSequenceIndexKey},
+		{"deposit state index", types.DepositStateIndexKey},
+		{"unbonding sequence index", types.UnbondingSequenceIndexKey},
+		{"unbonding state index", types.UnbondingStateIndexKey},
+		{"lsm deposit sequence index", types.LSMDepositSequenceIndexKey},
+		{"lsm deposit state index", types.LSMDepositStateIndexKey},
+		{"validator unbonding sequence index", types.ValidatorUnbondingSequenceIndexKey},
+		{"user unbonding epoch index", types.UserUnbondingEpochIndexKey},
+		{"redemption records", types.RedemptionRecordKey},
+	} {
+		expected := storeEntries(store, p.prefix)
+		require.NotEmpty(t, expected, "%s store is empty, the round-trip state should populate it", p.name)
+		require.Equal(t, expected, storeEntries(newStore, p.prefix), "%s store mismatch after import", p.name)
+	}
+
+	// catch prefixes added to the module without being listed above
+	require.Equal(t, storeEntries(store, nil), storeEntries(newStore, nil))
+
+	require.Equal(t, exported, liquidstakeibc.ExportGenesis(newCtx, newK))
+}
+
+// storeEntries returns every key/value pair under the given prefix, hex encoded to produce readable diffs.
+func storeEntries(store storetypes.KVStore, prefix []byte) map[string]string {
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	entries := make(map[string]string)
+	for ; iterator.Valid(); iterator.Next() {
+		entries[fmt.Sprintf("%X", iterator.Key())] = fmt.Sprintf("%X", iterator.Value())
+	}
+
+	return entries
+}
+
+// richGenesisState builds a genesis with several host chains and records in every possible state.
+func richGenesisState() *types.GenesisState {
+	genesisState := &types.GenesisState{
+		Params: types.DefaultParams(),
+	}
+
+	matureTime := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	validatorA := authtypes.NewModuleAddressOrBech32Address("testvalA").String()
+	validatorB := authtypes.NewModuleAddressOrBech32Address("testvalB").String()
+	delegator := authtypes.NewModuleAddressOrBech32Address("delegator").String()
+
+	for i, chainID := range []string{"chainA-1", "chainB-1"} {
+		hc := &types.HostChain{
+			ChainId:      chainID,
+			ConnectionId: fmt.Sprintf("connection-%d", i),
+			Params: &types.HostChainLSParams{
+				DepositFee:             sdk.MustNewDecFromStr("0.01"),
+				RestakeFee:             sdk.MustNewDecFromStr("0.05"),
+				UnstakeFee:             sdk.ZeroDec(),
+				RedemptionFee:          sdk.MustNewDecFromStr("0.1"),
+				LsmValidatorCap:        sdk.MustNewDecFromStr("0.5"),
+				LsmBondFactor:          sdk.NewDec(250),
+				MaxRedelegationEntries: types.DefaultMaxRedelegationEntries,
+			},
+			HostDenom: "uatom",
+			ChannelId: fmt.Sprintf("channel-%d", i),
+			PortId:    "transfer",
+			DelegationAccount: &types.ICAAccount{
+				Address:      authtypes.NewModuleAddressOrBech32Address(chainID + "delegation").String(),
+				Balance:      sdk.NewInt64Coin("uatom", 100),
+				Owner:        types.DefaultDelegateAccountPortOwner(chainID),
+				ChannelState: types.ICAAccount_ICA_CHANNEL_CREATED,
+			},
+			RewardsAccount: &types.ICAAccount{
+				Address:      authtypes.NewModuleAddressOrBech32Address(chainID + "rewards").String(),
+				Balance:      sdk.NewInt64Coin("uatom", 10),
+				Owner:        types.DefaultRewardsAccountPortOwner(chainID),
+				ChannelState: types.ICAAccount_ICA_CHANNEL_CREATED,
+			},
+			Validators: []*types.Validator{
+				{
+					OperatorAddress: validatorA,
+					Status:          stakingtypes.BondStatusBonded,
+					Weight:          sdk.MustNewDecFromStr("0.6"),
+					DelegatedAmount: sdk.NewInt(1221),
+					ExchangeRate:    sdk.OneDec(),
+					UnbondingEpoch:  0,
+					Delegable:       true,
+					Commission:      sdk.MustNewDecFromStr("0.05"),
+					Tokens:          sdk.NewInt(1000000),
+				},
+				{
+					OperatorAddress: validatorB,
+					Status:          stakingtypes.BondStatusUnbonding,
+					Weight:          sdk.MustNewDecFromStr("0.4"),
+					DelegatedAmount: sdk.NewInt(800),
+					ExchangeRate:    sdk.MustNewDecFromStr("0.99"),
+					UnbondingEpoch:  4,
+					Delegable:       false,
+					Commission:      sdk.MustNewDecFromStr("0.1"),
+					Tokens:          sdk.NewInt(500000),
+				},
+			},
+			MinimumDeposit:     sdk.NewInt(5),
+			CValue:             sdk.MustNewDecFromStr("0.98"),
+			LastCValue:         sdk.MustNewDecFromStr("0.97"),
+			UnbondingFactor:    4,
+			Active:             true,
+			AutoCompoundFactor: sdk.MustNewDecFromStr("0.05"),
+			Flags:              &types.HostChainFlags{Lsm: true, Rebalance: i == 0},
+			DelegationStrategy: types.HostChain_DelegationStrategy(i),
+			ValidatorSetConfig: &types.ValidatorSetConfig{
+				Enabled:       true,
+				AutoApply:     i == 0,
+				MaxValidators: 2,
+				MinWeight:     sdk.MustNewDecFromStr("0.1"),
+				MaxWeight:     sdk.MustNewDecFromStr("0.8"),
+				MaxCommission: sdk.MustNewDecFromStr("0.1"),
+			},
+		}
+		genesisState.HostChains = append(genesisState.HostChains, hc)
+
+		for state := range types.Deposit_DepositState_name {
+			genesisState.Deposits = append(genesisState.Deposits, &types.Deposit{
+				ChainId:       chainID,
+				Amount:        sdk.NewInt64Coin(hc.IBCDenom(), 100),
+				Epoch:         int64(state),
+				State:         types.Deposit_DepositState(state),
+				IbcSequenceId: fmt.Sprintf("%s-sequence-%d", hc.ChannelId, state),
+			})
+		}
+
+		for state := range types.Unbonding_UnbondingState_name {
+			genesisState.Unbondings = append(genesisState.Unbondings, &types.Unbonding{
+				ChainId:       chainID,
+				EpochNumber:   int64(state),
+				MatureTime:    matureTime,
+				BurnAmount:    sdk.NewInt64Coin(hc.MintDenom(), 10),
+				UnbondAmount:  sdk.NewInt64Coin(hc.HostDenom, 10),
+				IbcSequenceId: fmt.Sprintf("%s-sequence-%d", hc.ChannelId, state),
+				State:         types.Unbonding_UnbondingState(state),
+			})
+
+			genesisState.UserUnbondings = append(genesisState.UserUnbondings, &types.UserUnbonding{
+				ChainId:      chainID,
+				EpochNumber:  int64(state),
+				Address:      delegator,
+				StkAmount:    sdk.NewInt64Coin(hc.MintDenom(), 10),
+				UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 10),
+			})
+		}
+
+		for _, validator := range hc.Validators {
+			genesisState.ValidatorUnbondings = append(genesisState.ValidatorUnbondings, &types.ValidatorUnbonding{
+				ChainId:          chainID,
+				EpochNumber:      4,
+				MatureTime:       matureTime,
+				ValidatorAddress: validator.OperatorAddress,
+				Amount:           sdk.NewInt64Coin(hc.HostDenom, 1000),
+				IbcSequenceId:    fmt.Sprintf("%s-sequence-unbond", hc.ChannelId),
+			})
+		}
+
+		for state := range types.LSMDeposit_LSMDepositState_name {
+			genesisState.LsmDeposits = append(genesisState.LsmDeposits, &types.LSMDeposit{
+				ChainId:          chainID,
+				Amount:           sdk.NewInt(1000),
+				Shares:           sdk.NewDec(1000),
+				Denom:            fmt.Sprintf("%s/%d", validatorA, state),
+				IbcDenom:         fmt.Sprintf("ibc/%064d", state),
+				DelegatorAddress: delegator,
+				State:            types.LSMDeposit_LSMDepositState(state),
+				IbcSequenceId:    fmt.Sprintf("%s-sequence-lsm-%d", hc.ChannelId, state),
+			})
+		}
+
+		for state := range types.Redelegation_RedelegationState_name {
+			genesisState.Redelegations = append(genesisState.Redelegations, &types.Redelegation{
+				ChainId:             chainID,
+				SrcValidatorAddress: validatorB,
+				DstValidatorAddress: validatorA,
+				Amount:              sdk.NewInt64Coin(hc.HostDenom, 100),
+				CompletionTime:      matureTime,
+				IbcSequenceId:       fmt.Sprintf("%s-sequence-redelegate-%d", hc.ChannelId, state),
+				State:               types.Redelegation_RedelegationState(state),
+			})
+		}
+
+		for height, validator := range hc.Validators {
+			genesisState.SlashRecords = append(genesisState.SlashRecords, &types.SlashRecord{
+				ChainId:            chainID,
+				ValidatorAddress:   validator.OperatorAddress,
+				Height:             int64(height + 1),
+				Time:               matureTime,
+				SlashedAmount:      sdk.NewInt(10),
+				ExchangeRateBefore: sdk.OneDec(),
+				ExchangeRateAfter:  sdk.MustNewDecFromStr("0.99"),
+			})
+		}
+
+		genesisState.ValidatorSetProposals = append(genesisState.ValidatorSetProposals, &types.ValidatorSetProposal{
+			ChainId: chainID,
+			Height:  10,
+			Validators: []*types.ValidatorScore{
+				{OperatorAddress: validatorA, Score: sdk.MustNewDecFromStr("0.9"), Weight: sdk.MustNewDecFromStr("0.6")},
+				{OperatorAddress: validatorB, Score: sdk.MustNewDecFromStr("0.6"), Weight: sdk.MustNewDecFromStr("0.4")},
+			},
+			Epoch: int64(i),
+		})
+
+		genesisState.ValidatorSetRounds = append(genesisState.ValidatorSetRounds, &types.ValidatorSetRound{
+			ChainId:            chainID,
+			Epoch:              3,
+			DiscoveryDone:      true,
+			SignedBlocksWindow: 10000,
+			Candidates:         2,
+			Verified:           1,
+		})
+		genesisState.ValidatorSetCandidates = append(
+			genesisState.ValidatorSetCandidates,
+			&types.ValidatorSetCandidate{
+				ChainId:             chainID,
+				OperatorAddress:     validatorA,
+				ConsensusAddress:    sdk.ConsAddress("testconsA").String(),
+				Status:              stakingtypes.BondStatusBonded,
+				Tokens:              sdk.NewInt(1000),
+				Commission:          sdk.MustNewDecFromStr("0.05"),
+				DelegatorShares:     sdk.NewDec(1000),
+				LiquidShares:        sdk.NewDec(100),
+				ValidatorBondShares: sdk.NewDec(10),
+				MissedBlocksCounter: 20,
+				ValidatorVerified:   true,
+				SigningInfoVerified: true,
+			},
+			&types.ValidatorSetCandidate{
+				ChainId:             chainID,
+				OperatorAddress:     validatorB,
+				Tokens:              sdk.ZeroInt(),
+				Commission:          sdk.ZeroDec(),
+				DelegatorShares:     sdk.ZeroDec(),
+				LiquidShares:        sdk.ZeroDec(),
+				ValidatorBondShares: sdk.ZeroDec(),
+			},
+		)
+
+		genesisState.WorkflowCursors = append(genesisState.WorkflowCursors,
+			&types.WorkflowCursor{
+				ChainId:  chainID,
+				Workflow: types.WorkflowClaim,
+				Cursor:   types.GetUserUnbondingStoreKey(chainID, authtypes.NewModuleAddress("delegator").String(), 1),
+			},
+			&types.WorkflowCursor{
+				ChainId:  chainID,
+				Workflow: types.WorkflowMaturedValidatorUnbondings,
+				Cursor:   types.GetValidatorUnbondingStoreKey(chainID, validatorA, 1),
+			},
+		)
+
+		for proposalID, state := range types.HostChainVote_VoteState_name {
+			genesisState.HostChainVotes = append(genesisState.HostChainVotes, &types.HostChainVote{
+				ChainId:    chainID,
+				ProposalId: uint64(proposalID) + 1,
+				Options: govv1beta1.WeightedVoteOptions{
+					{Option: govv1beta1.OptionYes, Weight: sdk.MustNewDecFromStr("0.8")},
+					{Option: govv1beta1.OptionNo, Weight: sdk.MustNewDecFromStr("0.2")},
+				},
+				Height:        int64(proposalID),
+				Time:          matureTime,
+				IbcSequenceId: fmt.Sprintf("%s-sequence-vote-%s", hc.ChannelId, state),
+				State:         types.HostChainVote_VoteState(proposalID),
+			})
+		}
+
+		genesisState.VoteSignalings = append(genesisState.VoteSignalings,
+			&types.VoteSignaling{
+				ChainId:    chainID,
+				ProposalId: 1,
+				EndTime:    matureTime,
+				Tallied:    true,
+				Tally:      govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionNo),
+				Closed:     true,
+				OptionPowers: []types.VoteOptionPower{
+					{Option: govv1beta1.OptionNo, Power: sdk.NewDec(5)},
+				},
+				TotalPower: sdk.NewInt(5),
+			},
+			&types.VoteSignaling{
+				ChainId:    chainID,
+				ProposalId: 2,
+				EndTime:    matureTime,
+				OptionPowers: []types.VoteOptionPower{
+					{Option: govv1beta1.OptionYes, Power: sdk.NewDec(20)},
+				},
+				TotalPower: sdk.NewInt(20),
+			},
+		)
+		for _, signaler := range []string{delegator, validatorA} {
+			genesisState.VoteSignals = append(genesisState.VoteSignals, &types.VoteSignal{
+				ChainId:    chainID,
+				ProposalId: 2,
+				Signaler:   signaler,
+				Options:    govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes),
+				Power:      sdk.NewInt(10),
+			})
+		}
+
+		genesisState.TimelockedUpdates = append(genesisState.TimelockedUpdates, &types.TimelockedUpdate{
+			Id:             uint64(i + 1),
+			ChainId:        chainID,
+			Authority:      delegator,
+			ExecutionEpoch: int64(i + 10),
+			Updates: []*types.KVUpdate{
+				{Key: types.KeyDepositFee, Value: "0.01"},
+				{Key: types.KeyApplyValidatorSet, Value: types.MustMarshalPinnedValidatorSetProposal(
+					&types.ValidatorSetProposal{
+						ChainId:    chainID,
+						Height:     100,
+						Validators: []*types.ValidatorScore{{OperatorAddress: validatorA, Score: sdk.OneDec(), Weight: sdk.OneDec()}},
+						Epoch:      1,
+					},
+				)},
+			},
+		})
+
+		for epoch := int64(1); epoch <= 2; epoch++ {
+			genesisState.CValueRecords = append(genesisState.CValueRecords, &types.CValueRecord{
+				ChainId:                  chainID,
+				Epoch:                    epoch,
+				Height:                   epoch * 100,
+				Time:                     matureTime,
+				CValue:                   sdk.OneDec(),
+				MintedAmount:             sdk.NewInt(1000),
+				StakedAmount:             sdk.NewInt(900),
+				AmountOnPersistence:      sdk.NewInt(50),
+				AmountOnHostChain:        sdk.NewInt(50),
+				TokenizedStakedAmount:    sdk.ZeroInt(),
+				ValidatorUnbondingAmount: sdk.ZeroInt(),
+			})
+		}
+
+		genesisState.RewardRecords = append(genesisState.RewardRecords, &types.RewardRecord{
+			ChainId: chainID,
+			Epoch:   1,
+			Amount:  sdk.NewInt(100),
+			Fee:     sdk.NewInt(5),
+		})
+	}
+
+	genesisState.AutoClaimOptOuts = []string{delegator}
+	genesisState.RedemptionRecords = []*types.RedemptionRecord{{
+		ChainId:   "deregistered-1",
+		MintDenom: "stk/uderegistered",
+		IbcDenom:  "ibc/uderegistered",
+		CValue:    sdk.MustNewDecFromStr("0.9"),
+		Amount:    sdk.NewInt(1000),
+		StkAmount: sdk.NewInt(900),
+	}}
+	genesisState.TimelockEpochsUpdate = &types.TimelockEpochsUpdate{
+		UpdateTimelockEpochs: 1,
+		Authority:            delegator,
+		ExecutionEpoch:       10,
+	}
+
+	for _, chainID := range []string{"chainA-1", "chainB-1"} {
+		genesisState.Inflows = append(
+			genesisState.Inflows,
+			&types.Inflow{ChainId: chainID, Epoch: 3, Amount: sdk.NewInt(300)},
+			&types.Inflow{ChainId: chainID, Epoch: 3, Address: delegator, Amount: sdk.NewInt(100)},
+		)
+		genesisState.RedeemOutflows = append(
+			genesisState.RedeemOutflows,
+			&types.RedeemOutflow{ChainId: chainID, Epoch: 3, Amount: sdk.NewInt(50)},
+		)
+	}
+
+	return genesisState
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -16,6 +17,12 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) {
 
 	// perform BeginBlocker tasks for each chain
 	for _, hc := range k.GetAllHostChains(ctx) {
+		if hc.Deregistration != nil {
+			// wind down the chains that are being deregistered
+			k.DoDeregisterHostChain(ctx, hc)
+			continue
+		}
+
 		if !hc.Active {
 			// don't do anything on inactive chains
 			continue
@@ -24,12 +31,17 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) {
 		// attempt to recreate closed ICA channels
 		k.DoRecreateICA(ctx, hc)
 
-		// attempt to delegate
-		k.DoDelegate(ctx, hc)
-
 		// attempt to automatically claim matured undelegations
 		k.DoClaim(ctx, hc)
 
+		if hc.GetPauses().GetIcaSends() {
+			// claims keep being processed while the ICA transactions are paused
+			continue
+		}
+
+		// attempt to delegate
+		k.DoDelegate(ctx, hc)
+
 		// attempt to process any matured unbondings
 		k.DoProcessMaturedUndelegations(ctx, hc)
 
@@ -37,11 +49,20 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) {
 		if hc.Flags.Lsm {
 			k.DoRedeemLSMTokens(ctx, hc)
 		}
+
+		// attempt to vote the tally of the ended vote signalings
+		k.DoTallyVoteSignalings(ctx, hc)
 	}
 }
 
+// DoDelegate delegates a bounded batch of the received deposits of a host chain, the rest are delegated on the
+// next blocks.
 func (k *Keeper) DoDelegate(ctx sdk.Context, hc *types.HostChain) {
-	deposits := k.GetDelegableDepositsForChain(ctx, hc.ChainId)
+	receivedPrefix := types.GetStateIndexPrefix(hc.ChainId, int32(types.Deposit_DEPOSIT_RECEIVED))
+	deposits := k.getDepositsFromStoreKeys(
+		ctx,
+		k.getIndexedStoreKeysBatch(ctx, hc.ChainId, types.WorkflowDelegate, types.DepositStateIndexKey, receivedPrefix),
+	)
 
 	// nothing to do if there are no deposits
 	if len(deposits) == 0 {
@@ -87,69 +108,75 @@ func (k *Keeper) DoDelegate(ctx sdk.Context, hc *types.HostChain) {
 		deposit.State = types.Deposit_DEPOSIT_DELEGATING
 		k.SetDeposit(ctx, deposit)
 	}
+
+	setWorkflowGauge(hc.ChainId, types.WorkflowDelegate, len(deposits))
 }
 
+// DoClaim sends the tokens of the claimable and failed unbondings to their delegators. Every block it visits a
+// bounded batch of the host chain user unbondings, resuming where the previous block stopped, and skips the
+// delegators that opted out of the automatic claim unless the host chain is being deregistered.
 func (k *Keeper) DoClaim(ctx sdk.Context, hc *types.HostChain) {
-	claimableUnbondings := k.FilterUnbondings(
-		ctx,
-		func(u types.Unbonding) bool {
-			return u.ChainId == hc.ChainId &&
-				(u.State == types.Unbonding_UNBONDING_CLAIMABLE || u.State == types.Unbonding_UNBONDING_FAILED)
-		},
-	)
+	// nothing to do if there are no claimable unbondings
+	if len(k.GetUnbondingsForChainAndState(ctx, hc.ChainId, types.Unbonding_UNBONDING_CLAIMABLE)) == 0 &&
+		len(k.GetUnbondingsForChainAndState(ctx, hc.ChainId, types.Unbonding_UNBONDING_FAILED)) == 0 {
+		return
+	}
 
-	for _, unbonding := range claimableUnbondings {
-		epochNumber := unbonding.EpochNumber
-		userUnbondings := k.FilterUserUnbondings(
-			ctx,
-			func(u types.UserUnbonding) bool {
-				return u.ChainId == hc.ChainId && u.EpochNumber == epochNumber
-			},
-		)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserUnbondingKey)
+	batch := k.nextWorkflowBatch(ctx, hc.ChainId, types.WorkflowClaim, store, []byte(hc.ChainId))
+
+	claimed := 0
+	for _, pair := range batch.Pairs {
+		userUnbonding := &types.UserUnbonding{}
+		k.cdc.MustUnmarshal(pair.Value, userUnbonding)
+
+		// other chain ids starting with the host chain id share the prefix
+		if userUnbonding.ChainId != hc.ChainId {
+			continue
+		}
+
+		unbonding, found := k.GetUnbonding(ctx, hc.ChainId, userUnbonding.EpochNumber)
+		if !found || (unbonding.State != types.Unbonding_UNBONDING_CLAIMABLE &&
+			unbonding.State != types.Unbonding_UNBONDING_FAILED) {
+			continue
+		}
+
+		if hc.Deregistration == nil && !k.IsAutoClaimEnabled(ctx, userUnbonding.Address) {
+			continue
+		}
+
+		address, err := sdk.AccAddressFromBech32(userUnbonding.Address)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"invalid user unbonding address",
+				"host_chain",
+				hc.ChainId,
+				"address",
+				userUnbonding.Address,
+			)
+			continue
+		}
 
-		for _, userUnbonding := range userUnbondings {
-			address, err := sdk.AccAddressFromBech32(userUnbonding.Address)
-			if err != nil {
-				return
-			}
-
-			var claimableCoins sdk.Coins
-			switch unbonding.State {
-			case types.Unbonding_UNBONDING_CLAIMABLE:
-				claimableCoins = sdk.NewCoins(sdk.NewCoin(hc.IBCDenom(), userUnbonding.UnbondAmount.Amount))
-				unbonding.UnbondAmount = unbonding.UnbondAmount.Sub(userUnbonding.UnbondAmount)
-			case types.Unbonding_UNBONDING_FAILED:
-				claimableCoins = sdk.NewCoins(sdk.NewCoin(hc.MintDenom(), userUnbonding.StkAmount.Amount))
-				unbonding.BurnAmount = unbonding.BurnAmount.Sub(userUnbonding.StkAmount)
-			}
-
-			// send coin to the delegator address from the undelegation module account
-			if err = k.bankKeeper.SendCoinsFromModuleToAccount(
-				ctx,
-				types.UndelegationModuleAccount,
-				address,
-				claimableCoins,
-			); err != nil {
-				k.Logger(ctx).Error(
-					"could not send unbonded tokens from module account to delegator",
-					"host_chain",
-					hc.ChainId,
-					"epoch",
-					userUnbonding.EpochNumber,
-				)
-				return
-			}
-
-			// update the unbonding remaining amount and delete it if it reaches zero
-			if unbonding.UnbondAmount.IsZero() || unbonding.BurnAmount.IsZero() {
-				k.DeleteUnbonding(ctx, unbonding)
-			} else {
-				k.SetUnbonding(ctx, unbonding)
-			}
-
-			k.DeleteUserUnbonding(ctx, userUnbonding)
+		// a failed claim is left for the next pass or a manual claim without affecting the others
+		cacheCtx, write := ctx.CacheContext()
+		if _, err = k.ClaimUserUnbonding(cacheCtx, hc, userUnbonding, address); err != nil {
+			k.Logger(ctx).Error(
+				"could not send unbonded tokens from module account to delegator",
+				"host_chain",
+				hc.ChainId,
+				"epoch",
+				userUnbonding.EpochNumber,
+				"error",
+				err.Error(),
+			)
+			continue
 		}
+		write()
+
+		claimed++
 	}
+
+	setWorkflowGauge(hc.ChainId, types.WorkflowClaim, claimed)
 }
 
 func (k *Keeper) DoRecreateICA(ctx sdk.Context, hc *types.HostChain) {
@@ -187,18 +214,25 @@ func (k *Keeper) DoRecreateICA(ctx sdk.Context, hc *types.HostChain) {
 	}
 }
 
+// DoProcessMaturedUndelegations transfers the matured unbondings and validator unbondings of a host chain back to
+// Persistence. Every block it visits a bounded batch of each, resuming where the previous block stopped.
 func (k *Keeper) DoProcessMaturedUndelegations(ctx sdk.Context, hc *types.HostChain) {
-	// get all the unbondings that are matured
-	unbondings := k.FilterUnbondings(
-		ctx,
-		func(u types.Unbonding) bool {
-			return u.ChainId == hc.ChainId &&
-				ctx.BlockTime().After(u.MatureTime) &&
-				u.State == types.Unbonding_UNBONDING_MATURING
-		},
-	)
+	// get a batch of the maturing unbondings, only the ones past their mature time are processed
+	maturingPrefix := types.GetStateIndexPrefix(hc.ChainId, int32(types.Unbonding_UNBONDING_MATURING))
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingStateIndexKey)
+	batch := k.nextWorkflowBatch(ctx, hc.ChainId, types.WorkflowMaturedUnbondings, indexStore, maturingPrefix)
+	storeKeys := make([][]byte, 0, len(batch.Pairs))
+	for _, pair := range batch.Pairs {
+		storeKeys = append(storeKeys, pair.Key[len(maturingPrefix):])
+	}
+	unbondings := k.getUnbondingsFromStoreKeys(ctx, storeKeys)
 
+	processed := 0
 	for _, unbonding := range unbondings {
+		if !ctx.BlockTime().After(unbonding.MatureTime) {
+			continue
+		}
+
 		sequenceID, err := k.SendICATransfer(
 			ctx,
 			hc,
@@ -222,18 +256,29 @@ func (k *Keeper) DoProcessMaturedUndelegations(ctx sdk.Context, hc *types.HostCh
 		unbonding.IbcSequenceId = sequenceID
 		unbonding.State = types.Unbonding_UNBONDING_MATURED
 		k.SetUnbonding(ctx, unbonding)
+		processed++
 	}
 
-	// get all the validator unbondings that are matured
-	validatorUnbondings := k.FilterValidatorUnbondings(
-		ctx,
-		func(u types.ValidatorUnbonding) bool {
-			return u.ChainId == hc.ChainId && u.MatureTime != time.Time{} &&
-				ctx.BlockTime().After(u.MatureTime) && u.IbcSequenceId == ""
-		},
-	)
+	setWorkflowGauge(hc.ChainId, types.WorkflowMaturedUnbondings, processed)
+
+	// get a batch of the validator unbondings, only the matured ones that haven't been transferred are processed
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorUnbondingKey)
+	batch = k.nextWorkflowBatch(ctx, hc.ChainId, types.WorkflowMaturedValidatorUnbondings, store, []byte(hc.ChainId))
+
+	processed = 0
+	for _, pair := range batch.Pairs {
+		validatorUnbonding := &types.ValidatorUnbonding{}
+		k.cdc.MustUnmarshal(pair.Value, validatorUnbonding)
+
+		// other chain ids starting with the host chain id share the prefix
+		if validatorUnbonding.ChainId != hc.ChainId || validatorUnbonding.IbcSequenceId != "" {
+			continue
+		}
+
+		if validatorUnbonding.MatureTime == (time.Time{}) || !ctx.BlockTime().After(validatorUnbonding.MatureTime) {
+			continue
+		}
 
-	for _, validatorUnbonding := range validatorUnbondings {
 		sequenceID, err := k.SendICATransfer(
 			ctx,
 			hc,
@@ -258,13 +303,28 @@ func (k *Keeper) DoProcessMaturedUndelegations(ctx sdk.Context, hc *types.HostCh
 		// update the validator unbonding sequence id and state
 		validatorUnbonding.IbcSequenceId = sequenceID
 		k.SetValidatorUnbonding(ctx, validatorUnbonding)
+		processed++
 	}
+
+	setWorkflowGauge(hc.ChainId, types.WorkflowMaturedValidatorUnbondings, processed)
 }
 
+// DoRedeemLSMTokens untokenizes a bounded batch of the received lsm deposits of a host chain, the rest are
+// untokenized on the next blocks.
 func (k *Keeper) DoRedeemLSMTokens(ctx sdk.Context, hc *types.HostChain) {
 	// generate the ICA messages
 	messages := make([]proto.Message, 0)
-	deposits := k.GetRedeemableLSMDeposits(ctx, hc.ChainId)
+	receivedPrefix := types.GetStateIndexPrefix(hc.ChainId, int32(types.LSMDeposit_DEPOSIT_RECEIVED))
+	deposits := k.getLSMDepositsFromStoreKeys(
+		ctx,
+		k.getIndexedStoreKeysBatch(
+			ctx,
+			hc.ChainId,
+			types.WorkflowRedeemLSMTokens,
+			types.LSMDepositStateIndexKey,
+			receivedPrefix,
+		),
+	)
 	for _, deposit := range deposits {
 		messages = append(
 			messages,
@@ -306,4 +366,6 @@ func (k *Keeper) DoRedeemLSMTokens(ctx sdk.Context, hc *types.HostChain) {
 		"sequence-id",
 		sequenceID,
 	)
+
+	setWorkflowGauge(hc.ChainId, types.WorkflowRedeemLSMTokens, len(deposits))
 }
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetCValueRecord(ctx sdk.Context, record *types.CValueRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CValueRecordKey)
+	bytes := k.cdc.MustMarshal(record)
+	store.Set(types.GetCValueRecordStoreKey(record.ChainId, record.Epoch), bytes)
+}
+
+func (k *Keeper) GetCValueRecord(ctx sdk.Context, chainID string, epochNumber int64) (*types.CValueRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CValueRecordKey)
+	bz := store.Get(types.GetCValueRecordStoreKey(chainID, epochNumber))
+	if bz == nil {
+		return &types.CValueRecord{}, false
+	}
+
+	var record types.CValueRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return &record, true
+}
+
+// GetCValueRecords returns the c value records of a host chain ordered by epoch, or the records of all the host
+// chains if the chain id is empty
+func (k *Keeper) GetCValueRecords(ctx sdk.Context, chainID string) []*types.CValueRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CValueRecordKey)
+
+	var keyPrefix []byte
+	if chainID != "" {
+		keyPrefix = types.GetCValueRecordChainPrefix(chainID)
+	}
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	records := make([]*types.CValueRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.CValueRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, &record)
+	}
+
+	return records
+}
+
+// RecordCValue appends a c value record to the host chain history, dropping the records older than the c value
+// history length param
+func (k *Keeper) RecordCValue(ctx sdk.Context, record *types.CValueRecord) {
+	length := k.GetParams(ctx).CValueHistoryLength
+	if length == 0 {
+		return
+	}
+
+	k.SetCValueRecord(ctx, record)
+
+	// nothing to drop until the history is longer than the record epoch
+	if length >= uint64(record.Epoch) {
+		return
+	}
+
+	// the records are ordered by epoch, only the expired range from the oldest record is iterated
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CValueRecordKey)
+	iterator := store.Iterator(
+		types.GetCValueRecordChainPrefix(record.ChainId),
+		types.GetCValueRecordStoreKey(record.ChainId, record.Epoch-int64(length)+1),
+	)
+
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
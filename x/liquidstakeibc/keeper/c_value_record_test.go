@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func newCValueRecord(chainID string, epoch int64) *types.CValueRecord {
+	return &types.CValueRecord{
+		ChainId:                  chainID,
+		Epoch:                    epoch,
+		CValue:                   sdk.OneDec(),
+		MintedAmount:             sdk.ZeroInt(),
+		StakedAmount:             sdk.ZeroInt(),
+		AmountOnPersistence:      sdk.ZeroInt(),
+		AmountOnHostChain:        sdk.ZeroInt(),
+		TokenizedStakedAmount:    sdk.ZeroInt(),
+		ValidatorUnbondingAmount: sdk.ZeroInt(),
+	}
+}
+
+func (suite *IntegrationTestSuite) TestRecordCValue() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	params := k.GetParams(suite.ctx)
+	params.CValueHistoryLength = 3
+	k.SetParams(suite.ctx, params)
+
+	for epoch := int64(1); epoch <= 5; epoch++ {
+		k.RecordCValue(suite.ctx, newCValueRecord("test-chain", epoch))
+	}
+	k.RecordCValue(suite.ctx, newCValueRecord("other-chain", 1))
+
+	// only the latest records are kept
+	records := k.GetCValueRecords(suite.ctx, "test-chain")
+	suite.Require().Len(records, 3)
+	suite.Require().Equal(int64(3), records[0].Epoch)
+	suite.Require().Equal(int64(5), records[2].Epoch)
+
+	_, found := k.GetCValueRecord(suite.ctx, "test-chain", 2)
+	suite.Require().False(found)
+	_, found = k.GetCValueRecord(suite.ctx, "other-chain", 1)
+	suite.Require().True(found)
+
+	// shrinking the history drops the oldest records on the next computation
+	params.CValueHistoryLength = 1
+	k.SetParams(suite.ctx, params)
+	k.RecordCValue(suite.ctx, newCValueRecord("test-chain", 6))
+
+	records = k.GetCValueRecords(suite.ctx, "test-chain")
+	suite.Require().Len(records, 1)
+	suite.Require().Equal(int64(6), records[0].Epoch)
+
+	// records outside the history window are dropped even if epochs were skipped
+	params.CValueHistoryLength = 2
+	k.SetParams(suite.ctx, params)
+	k.RecordCValue(suite.ctx, newCValueRecord("test-chain", 9))
+
+	records = k.GetCValueRecords(suite.ctx, "test-chain")
+	suite.Require().Len(records, 1)
+	suite.Require().Equal(int64(9), records[0].Epoch)
+
+	// nothing is recorded with the history disabled
+	params.CValueHistoryLength = 0
+	k.SetParams(suite.ctx, params)
+	k.RecordCValue(suite.ctx, newCValueRecord("test-chain", 7))
+
+	_, found = k.GetCValueRecord(suite.ctx, "test-chain", 7)
+	suite.Require().False(found)
+}
+
+func (suite *IntegrationTestSuite) TestUpdateCValuesRecordsHistory() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	k.UpdateCValues(suite.ctx)
+
+	epoch := k.GetEpochNumber(suite.ctx, types.CValueEpoch)
+	record, found := k.GetCValueRecord(suite.ctx, suite.chainB.ChainID, epoch)
+	suite.Require().True(found)
+
+	hc, _ := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().Equal(hc.CValue, record.CValue)
+	suite.Require().Equal(suite.ctx.BlockHeight(), record.Height)
+}
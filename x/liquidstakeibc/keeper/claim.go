@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// SetAutoClaim opts an address in or out of the automatic claim of its unbondings
+func (k *Keeper) SetAutoClaim(ctx sdk.Context, address string, autoClaim bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoClaimOptOutKey)
+	if autoClaim {
+		store.Delete([]byte(address))
+	} else {
+		store.Set([]byte(address), []byte{0x01})
+	}
+}
+
+// IsAutoClaimEnabled returns true if the unbondings of an address are claimed automatically
+func (k *Keeper) IsAutoClaimEnabled(ctx sdk.Context, address string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoClaimOptOutKey)
+	return !store.Has([]byte(address))
+}
+
+// GetAutoClaimOptOuts returns all the addresses that opted out of the automatic claim
+func (k *Keeper) GetAutoClaimOptOuts(ctx sdk.Context) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoClaimOptOutKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	addresses := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, string(iterator.Key()))
+	}
+
+	return addresses
+}
+
+// ClaimUserUnbonding sends the tokens of a user unbonding to the recipient if its unbonding is claimable, the
+// unbonded tokens if it succeeded or the stk tokens back if it failed
+func (k *Keeper) ClaimUserUnbonding(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	userUnbonding *types.UserUnbonding,
+	recipient sdk.AccAddress,
+) (sdk.Coins, error) {
+	unbonding, found := k.GetUnbonding(ctx, hc.ChainId, userUnbonding.EpochNumber)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrNothingToClaim,
+			"unbonding for chain %s and epoch %d not found",
+			hc.ChainId,
+			userUnbonding.EpochNumber,
+		)
+	}
+
+	var claimableCoins sdk.Coins
+	switch unbonding.State {
+	case types.Unbonding_UNBONDING_CLAIMABLE:
+		claimableCoins = sdk.NewCoins(sdk.NewCoin(hc.IBCDenom(), userUnbonding.UnbondAmount.Amount))
+		unbonding.UnbondAmount = unbonding.UnbondAmount.Sub(userUnbonding.UnbondAmount)
+	case types.Unbonding_UNBONDING_FAILED:
+		claimableCoins = sdk.NewCoins(sdk.NewCoin(hc.MintDenom(), userUnbonding.StkAmount.Amount))
+		unbonding.BurnAmount = unbonding.BurnAmount.Sub(userUnbonding.StkAmount)
+	default:
+		return nil, errorsmod.Wrapf(
+			types.ErrNothingToClaim,
+			"unbonding for chain %s and epoch %d is in state %s",
+			hc.ChainId,
+			userUnbonding.EpochNumber,
+			unbonding.State,
+		)
+	}
+
+	// send coin to the recipient address from the undelegation module account
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.UndelegationModuleAccount,
+		recipient,
+		claimableCoins,
+	); err != nil {
+		return nil, err
+	}
+
+	// update the unbonding remaining amount and delete it if it reaches zero
+	if unbonding.UnbondAmount.IsZero() || unbonding.BurnAmount.IsZero() {
+		k.DeleteUnbonding(ctx, unbonding)
+	} else {
+		k.SetUnbonding(ctx, unbonding)
+	}
+
+	k.DeleteUserUnbonding(ctx, userUnbonding)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaim,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeDelegatorAddress, userUnbonding.Address),
+			sdk.NewAttribute(types.AttributeRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeUnstakeEpoch, strconv.FormatInt(userUnbonding.EpochNumber, 10)),
+			sdk.NewAttribute(types.AttributeAmountReceived, claimableCoins.String()),
+		),
+	)
+
+	return claimableCoins, nil
+}
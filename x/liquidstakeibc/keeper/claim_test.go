@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// setClaimableUnbondings creates a claimable unbonding for the epoch with a user unbonding per address, and funds
+// the undelegation module account with the unbonded tokens
+func (suite *IntegrationTestSuite) setClaimableUnbondings(hc *types.HostChain, epoch int64, addresses []sdk.AccAddress) {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+
+	for _, address := range addresses {
+		stkAmount := sdk.NewInt64Coin(hc.MintDenom(), 100)
+		unbondAmount := sdk.NewInt64Coin(hc.HostDenom, 110)
+		k.IncreaseUserUnbondingAmountForEpoch(ctx, hc.ChainId, address.String(), epoch, stkAmount, unbondAmount)
+		k.IncreaseUndelegatingAmountForEpoch(ctx, hc.ChainId, epoch, stkAmount, unbondAmount)
+	}
+
+	unbonding, found := k.GetUnbonding(ctx, hc.ChainId, epoch)
+	suite.Require().True(found)
+	unbonding.State = types.Unbonding_UNBONDING_CLAIMABLE
+	k.SetUnbonding(ctx, unbonding)
+
+	suite.Require().NoError(testutil.FundModuleAccount(
+		suite.app.BankKeeper,
+		ctx,
+		types.UndelegationModuleAccount,
+		sdk.NewCoins(sdk.NewCoin(hc.IBCDenom(), unbonding.UnbondAmount.Amount)),
+	))
+}
+
+// chainUserUnbondings returns the user unbondings left for a host chain
+func (suite *IntegrationTestSuite) chainUserUnbondings(chainID string) []*types.UserUnbonding {
+	return suite.app.LiquidStakeIBCKeeper.FilterUserUnbondings(
+		suite.ctx,
+		func(u types.UserUnbonding) bool { return u.ChainId == chainID },
+	)
+}
+
+func (suite *IntegrationTestSuite) TestSetAutoClaim() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+
+	address := authtypes.NewModuleAddress("claimer").String()
+	suite.Require().True(k.IsAutoClaimEnabled(ctx, address))
+
+	k.SetAutoClaim(ctx, address, false)
+	suite.Require().False(k.IsAutoClaimEnabled(ctx, address))
+	suite.Require().Equal([]string{address}, k.GetAutoClaimOptOuts(ctx))
+
+	k.SetAutoClaim(ctx, address, true)
+	suite.Require().True(k.IsAutoClaimEnabled(ctx, address))
+	suite.Require().Empty(k.GetAutoClaimOptOuts(ctx))
+}
+
+func (suite *IntegrationTestSuite) TestClaimUserUnbonding() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	delegator := authtypes.NewModuleAddress("delegator")
+	recipient := authtypes.NewModuleAddress("recipient")
+	suite.setClaimableUnbondings(hc, 100, []sdk.AccAddress{delegator})
+
+	userUnbonding, found := k.GetUserUnbonding(ctx, hc.ChainId, delegator.String(), 100)
+	suite.Require().True(found)
+
+	// a pending unbonding can't be claimed
+	unbonding, _ := k.GetUnbonding(ctx, hc.ChainId, 100)
+	unbonding.State = types.Unbonding_UNBONDING_MATURING
+	k.SetUnbonding(ctx, unbonding)
+	_, err := k.ClaimUserUnbonding(ctx, hc, userUnbonding, recipient)
+	suite.Require().ErrorIs(err, types.ErrNothingToClaim)
+
+	unbonding.State = types.Unbonding_UNBONDING_CLAIMABLE
+	k.SetUnbonding(ctx, unbonding)
+	claimed, err := k.ClaimUserUnbonding(ctx, hc, userUnbonding, recipient)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 110)), claimed)
+	suite.Require().Equal(claimed, suite.app.BankKeeper.GetAllBalances(ctx, recipient))
+
+	_, found = k.GetUserUnbonding(ctx, hc.ChainId, delegator.String(), 100)
+	suite.Require().False(found)
+	_, found = k.GetUnbonding(ctx, hc.ChainId, 100)
+	suite.Require().False(found)
+}
+
+func (suite *IntegrationTestSuite) TestDoClaimBounded() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	addresses := make([]sdk.AccAddress, 0)
+	for i := 0; i < int(k.GetParams(ctx).MaxRecordsPerBlock)+5; i++ {
+		addresses = append(addresses, authtypes.NewModuleAddress(fmt.Sprintf("delegator-%d", i)))
+	}
+	suite.setClaimableUnbondings(hc, 100, addresses)
+
+	// the first block claims a full batch and stores where to resume
+	k.DoClaim(ctx, hc)
+	suite.Require().Len(suite.chainUserUnbondings(hc.ChainId), 5)
+	_, found = k.GetWorkflowCursor(ctx, hc.ChainId, types.WorkflowClaim)
+	suite.Require().True(found)
+
+	// the second block claims the rest and resets the cursor
+	k.DoClaim(ctx, hc)
+	suite.Require().Empty(suite.chainUserUnbondings(hc.ChainId))
+	_, found = k.GetWorkflowCursor(ctx, hc.ChainId, types.WorkflowClaim)
+	suite.Require().False(found)
+	_, found = k.GetUnbonding(ctx, hc.ChainId, 100)
+	suite.Require().False(found)
+
+	for _, address := range addresses {
+		suite.Require().Equal(
+			sdk.NewInt(110),
+			suite.app.BankKeeper.GetBalance(ctx, address, hc.IBCDenom()).Amount,
+		)
+	}
+}
+
+func (suite *IntegrationTestSuite) TestDoClaimSkipsOptOutsAndFailures() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	delegator := authtypes.NewModuleAddress("delegator")
+	optedOut := authtypes.NewModuleAddress("opted-out")
+	// the distribution module account can't receive tokens, so its claim fails
+	blocked := authtypes.NewModuleAddress(distrtypes.ModuleName)
+	suite.setClaimableUnbondings(hc, 100, []sdk.AccAddress{delegator, optedOut, blocked})
+	k.SetAutoClaim(ctx, optedOut.String(), false)
+
+	k.DoClaim(ctx, hc)
+
+	suite.Require().Equal(sdk.NewInt(110), suite.app.BankKeeper.GetBalance(ctx, delegator, hc.IBCDenom()).Amount)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(ctx, optedOut, hc.IBCDenom()).IsZero())
+
+	_, found = k.GetUserUnbonding(ctx, hc.ChainId, delegator.String(), 100)
+	suite.Require().False(found)
+	_, found = k.GetUserUnbonding(ctx, hc.ChainId, optedOut.String(), 100)
+	suite.Require().True(found)
+	_, found = k.GetUserUnbonding(ctx, hc.ChainId, blocked.String(), 100)
+	suite.Require().True(found)
+
+	unbonding, found := k.GetUnbonding(ctx, hc.ChainId, 100)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(220), unbonding.UnbondAmount.Amount)
+
+	// opt outs are claimed anyway while the host chain deregisters
+	hc.Deregistration = &types.Deregistration{State: types.Deregistration_DEREGISTRATION_REDEEMING}
+	k.DoClaim(ctx, hc)
+	suite.Require().Equal(sdk.NewInt(110), suite.app.BankKeeper.GetBalance(ctx, optedOut, hc.IBCDenom()).Amount)
+}
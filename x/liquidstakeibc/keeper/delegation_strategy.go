@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"sort"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,7 +17,141 @@ type DelegateAmount struct {
 	Amount     sdk.Dec
 }
 
+// DelegationStrategy defines how a host chain distributes its delegations among its validators.
+type DelegationStrategy interface {
+	// TargetWeights returns the target weight of each validator, in the same order they are received.
+	// The target weights add up to the same total as the weights of the validators received.
+	TargetWeights(validators []*types.Validator) []sdk.Dec
+}
+
+// NewDelegationStrategy returns the delegation strategy implementation for a host chain strategy.
+func NewDelegationStrategy(strategy types.HostChain_DelegationStrategy) DelegationStrategy {
+	switch strategy {
+	case types.HostChain_DELEGATION_STRATEGY_EQUAL_SPLIT:
+		return equalSplitStrategy{}
+	case types.HostChain_DELEGATION_STRATEGY_STAKE_INVERSE:
+		return stakeInverseStrategy{}
+	case types.HostChain_DELEGATION_STRATEGY_COMMISSION_AWARE:
+		return commissionAwareStrategy{}
+	default:
+		return weightProportionalStrategy{}
+	}
+}
+
+// weightProportionalStrategy uses the validator weights as they are set on the host chain.
+type weightProportionalStrategy struct{}
+
+func (s weightProportionalStrategy) TargetWeights(validators []*types.Validator) []sdk.Dec {
+	weights := make([]sdk.Dec, 0, len(validators))
+	for _, validator := range validators {
+		weights = append(weights, validator.Weight)
+	}
+
+	return weights
+}
+
+// equalSplitStrategy splits the delegations evenly among the validators that have weight.
+type equalSplitStrategy struct{}
+
+func (s equalSplitStrategy) TargetWeights(validators []*types.Validator) []sdk.Dec {
+	scores := make([]sdk.Dec, 0, len(validators))
+	for _, validator := range validators {
+		if validator.Weight.IsPositive() {
+			scores = append(scores, sdk.OneDec())
+		} else {
+			scores = append(scores, sdk.ZeroDec())
+		}
+	}
+
+	return scaleWeights(validators, scores)
+}
+
+// stakeInverseStrategy favours the validators with less voting power on the host chain, a validator with
+// weight receives delegations inversely proportional to its total bonded tokens.
+type stakeInverseStrategy struct{}
+
+func (s stakeInverseStrategy) TargetWeights(validators []*types.Validator) []sdk.Dec {
+	// the largest validator is used as the reference to keep precision on the scores
+	maxTokens := sdk.ZeroInt()
+	for _, validator := range validators {
+		if !validator.Weight.IsPositive() {
+			continue
+		}
+
+		// if the voting power of a validator is still unknown, fall back to the configured weights
+		if validator.Tokens.IsNil() || !validator.Tokens.IsPositive() {
+			return weightProportionalStrategy{}.TargetWeights(validators)
+		}
+
+		maxTokens = sdk.MaxInt(maxTokens, validator.Tokens)
+	}
+
+	scores := make([]sdk.Dec, 0, len(validators))
+	for _, validator := range validators {
+		if validator.Weight.IsPositive() {
+			scores = append(scores, sdk.NewDecFromInt(maxTokens).QuoInt(validator.Tokens))
+		} else {
+			scores = append(scores, sdk.ZeroDec())
+		}
+	}
+
+	return scaleWeights(validators, scores)
+}
+
+// commissionAwareStrategy discounts the weight of each validator by the commission rate it charges.
+type commissionAwareStrategy struct{}
+
+func (s commissionAwareStrategy) TargetWeights(validators []*types.Validator) []sdk.Dec {
+	scores := make([]sdk.Dec, 0, len(validators))
+	for _, validator := range validators {
+		commission := sdk.ZeroDec()
+		if !validator.Commission.IsNil() {
+			commission = sdk.MinDec(validator.Commission, sdk.OneDec())
+		}
+
+		scores = append(scores, validator.Weight.Mul(sdk.OneDec().Sub(commission)))
+	}
+
+	return scaleWeights(validators, scores)
+}
+
+// scaleWeights turns the strategy scores into weights that add up to the total weight of the validators.
+// If no validator has a positive score, the configured weights are kept.
+func scaleWeights(validators []*types.Validator, scores []sdk.Dec) []sdk.Dec {
+	totalWeight, totalScore := sdk.ZeroDec(), sdk.ZeroDec()
+	for i, validator := range validators {
+		totalWeight = totalWeight.Add(validator.Weight)
+		totalScore = totalScore.Add(scores[i])
+	}
+
+	if !totalScore.IsPositive() {
+		return weightProportionalStrategy{}.TargetWeights(validators)
+	}
+
+	weights := make([]sdk.Dec, 0, len(validators))
+	for _, score := range scores {
+		weights = append(weights, score.Mul(totalWeight).Quo(totalScore))
+	}
+
+	return weights
+}
+
+// applyDelegationStrategy returns a copy of the validators with the target weights of the host chain strategy.
+func applyDelegationStrategy(hc *types.HostChain, validators []*types.Validator) []*types.Validator {
+	weights := NewDelegationStrategy(hc.DelegationStrategy).TargetWeights(validators)
+
+	weightedValidators := make([]*types.Validator, 0, len(validators))
+	for i, validator := range validators {
+		weightedValidator := *validator
+		weightedValidator.Weight = weights[i]
+		weightedValidators = append(weightedValidators, &weightedValidator)
+	}
+
+	return weightedValidators
+}
+
 // GenerateDelegateMessages produces the same result regardless the LSM flag on the host chain.
+// The delegations are distributed following the delegation strategy of the host chain.
 func (k *Keeper) GenerateDelegateMessages(hc *types.HostChain, depositAmount math.Int) ([]proto.Message, error) {
 	// filter out validators which are non-delegable (which reached any LSM cap)
 	delegableValidators := make([]*types.Validator, 0)
@@ -46,11 +182,113 @@ func (k *Keeper) GenerateDelegateMessages(hc *types.HostChain, depositAmount mat
 	// subtract the delegations from non-delegable validators to get the effective total delegated amount
 	effectiveTotalDelegatedAmount := hc.GetHostChainTotalDelegations().Sub(nonDelegableDelegations)
 
-	return k.generateMessages(hc, delegableValidators, effectiveTotalDelegatedAmount, depositAmount, false)
+	return k.generateMessages(
+		hc,
+		applyDelegationStrategy(hc, delegableValidators),
+		effectiveTotalDelegatedAmount,
+		depositAmount,
+		false,
+	)
 }
 
 func (k *Keeper) GenerateUndelegateMessages(hc *types.HostChain, unbondAmount math.Int) ([]proto.Message, error) {
-	return k.generateMessages(hc, hc.Validators, hc.GetHostChainTotalDelegations(), unbondAmount, true)
+	return k.generateMessages(
+		hc,
+		applyDelegationStrategy(hc, hc.Validators),
+		hc.GetHostChainTotalDelegations(),
+		unbondAmount,
+		true,
+	)
+}
+
+// GenerateRedelegations computes the redelegations that move the host chain delegations towards the target weights
+// of its delegation strategy. The active redelegations are used to respect the host chain redelegation limits: a
+// validator receiving a redelegation can't be a source until it completes, and each validator pair has a maximum
+// number of entries. The amount of redelegations is bounded and the ones below the minimum deposit are skipped.
+func (k *Keeper) GenerateRedelegations(
+	hc *types.HostChain,
+	activeRedelegations []*types.Redelegation,
+) ([]*types.Redelegation, error) {
+	maxEntries := hc.Params.MaxRedelegationEntries
+	if maxEntries == 0 {
+		maxEntries = types.DefaultMaxRedelegationEntries
+	}
+
+	// count the entries per validator pair and flag the validators that are receiving redelegations
+	entries := make(map[string]uint32)
+	receiving := make(map[string]bool)
+	for _, redelegation := range activeRedelegations {
+		entries[redelegation.SrcValidatorAddress+redelegation.DstValidatorAddress]++
+		receiving[redelegation.DstValidatorAddress] = true
+	}
+
+	// calculate how far each validator is from its target delegation
+	totalDelegatedAmount := hc.GetHostChainTotalDelegations()
+	sources := make([]DelegateAmount, 0)
+	destinations := make([]DelegateAmount, 0)
+	for _, validator := range applyDelegationStrategy(hc, hc.Validators) {
+		targetAmount := validator.Weight.MulInt(totalDelegatedAmount)
+		difference := sdk.NewDecFromInt(validator.DelegatedAmount).Sub(targetAmount)
+
+		switch {
+		case difference.IsPositive() && !receiving[validator.OperatorAddress]:
+			sources = append(sources, DelegateAmount{ValAddress: validator.OperatorAddress, Amount: difference})
+		case difference.IsNegative() && validator.Delegable && validator.Status == stakingtypes.BondStatusBonded:
+			destinations = append(destinations, DelegateAmount{ValAddress: validator.OperatorAddress, Amount: difference.Neg()})
+		}
+	}
+
+	// move the largest differences first
+	sortDelegateAmounts(sources)
+	sortDelegateAmounts(destinations)
+
+	redelegations := make([]*types.Redelegation, 0)
+	for i := range sources {
+		source := &sources[i]
+		for j := range destinations {
+			destination := &destinations[j]
+			if len(redelegations) == types.MaxRedelegationMessages {
+				break
+			}
+
+			if entries[source.ValAddress+destination.ValAddress] >= maxEntries {
+				continue
+			}
+
+			// redelegations smaller than the minimum deposit are not worth using a host chain entry
+			amount := sdk.MinDec(source.Amount, destination.Amount).TruncateInt()
+			if !amount.IsPositive() || amount.LT(hc.MinimumDeposit) {
+				continue
+			}
+
+			redelegations = append(redelegations, &types.Redelegation{
+				ChainId:             hc.ChainId,
+				SrcValidatorAddress: source.ValAddress,
+				DstValidatorAddress: destination.ValAddress,
+				Amount:              sdk.NewCoin(hc.HostDenom, amount),
+			})
+			entries[source.ValAddress+destination.ValAddress]++
+
+			source.Amount = source.Amount.Sub(sdk.NewDecFromInt(amount))
+			destination.Amount = destination.Amount.Sub(sdk.NewDecFromInt(amount))
+		}
+	}
+
+	if len(redelegations) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidMessages, "no messages to redelegate")
+	}
+
+	return redelegations, nil
+}
+
+// sortDelegateAmounts sorts the amounts in descending order, using the validator address to break ties
+func sortDelegateAmounts(amounts []DelegateAmount) {
+	sort.SliceStable(amounts, func(i, j int) bool {
+		if !amounts[i].Amount.Equal(amounts[j].Amount) {
+			return amounts[i].Amount.GT(amounts[j].Amount)
+		}
+		return amounts[i].ValAddress < amounts[j].ValAddress
+	})
 }
 
 func (k *Keeper) generateMessages(
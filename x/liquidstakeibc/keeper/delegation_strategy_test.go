@@ -6,6 +6,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/pkg/errors"
 
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 )
 
@@ -570,3 +571,222 @@ func (suite *IntegrationTestSuite) TestGenerateUndelegateMessages() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestDelegationStrategyTargetWeights() {
+	validators := []*types.Validator{
+		{
+			OperatorAddress: "val0",
+			Weight:          decFromStr("0.4"),
+			Commission:      decFromStr("0.1"),
+			Tokens:          sdk.NewInt(1000),
+		},
+		{
+			OperatorAddress: "val1",
+			Weight:          decFromStr("0.4"),
+			Commission:      decFromStr("0.5"),
+			Tokens:          sdk.NewInt(4000),
+		},
+		{
+			OperatorAddress: "val2",
+			Weight:          decFromStr("0.2"),
+			Commission:      decFromStr("0"),
+			Tokens:          sdk.NewInt(2000),
+		},
+		{
+			OperatorAddress: "val3",
+			Weight:          decFromStr("0"),
+			Commission:      decFromStr("0"),
+			Tokens:          sdk.NewInt(500),
+		},
+	}
+
+	tc := []struct {
+		name       string
+		strategy   types.HostChain_DelegationStrategy
+		validators []*types.Validator
+		expected   []sdk.Dec
+	}{
+		{
+			name:       "weight proportional",
+			strategy:   types.HostChain_DELEGATION_STRATEGY_WEIGHT_PROPORTIONAL,
+			validators: validators,
+			expected:   []sdk.Dec{decFromStr("0.4"), decFromStr("0.4"), decFromStr("0.2"), decFromStr("0")},
+		},
+		{
+			name:       "equal split",
+			strategy:   types.HostChain_DELEGATION_STRATEGY_EQUAL_SPLIT,
+			validators: validators,
+			expected: []sdk.Dec{
+				sdk.OneDec().Quo(sdk.NewDec(3)),
+				sdk.OneDec().Quo(sdk.NewDec(3)),
+				sdk.OneDec().Quo(sdk.NewDec(3)),
+				decFromStr("0"),
+			},
+		},
+		{
+			name:       "stake inverse",
+			strategy:   types.HostChain_DELEGATION_STRATEGY_STAKE_INVERSE,
+			validators: validators,
+			expected: []sdk.Dec{
+				sdk.NewDec(4).Quo(sdk.NewDec(7)),
+				sdk.NewDec(1).Quo(sdk.NewDec(7)),
+				sdk.NewDec(2).Quo(sdk.NewDec(7)),
+				decFromStr("0"),
+			},
+		},
+		{
+			name:     "stake inverse with unknown voting power",
+			strategy: types.HostChain_DELEGATION_STRATEGY_STAKE_INVERSE,
+			validators: []*types.Validator{
+				{OperatorAddress: "val0", Weight: decFromStr("0.7"), Tokens: sdk.NewInt(1000)},
+				{OperatorAddress: "val1", Weight: decFromStr("0.3")},
+			},
+			expected: []sdk.Dec{decFromStr("0.7"), decFromStr("0.3")},
+		},
+		{
+			name:       "commission aware",
+			strategy:   types.HostChain_DELEGATION_STRATEGY_COMMISSION_AWARE,
+			validators: validators,
+			expected: []sdk.Dec{
+				decFromStr("0.36").Quo(decFromStr("0.76")),
+				decFromStr("0.2").Quo(decFromStr("0.76")),
+				decFromStr("0.2").Quo(decFromStr("0.76")),
+				decFromStr("0"),
+			},
+		},
+	}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			weights := keeper.NewDelegationStrategy(t.strategy).TargetWeights(t.validators)
+
+			suite.Require().Equal(len(t.expected), len(weights))
+			for i, weight := range weights {
+				suite.Require().True(t.expected[i].Equal(weight), "expected %s, got %s", t.expected[i], weight)
+			}
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestGenerateDelegateMessagesWithStrategy() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().Equal(found, true)
+
+	hc.DelegationStrategy = types.HostChain_DELEGATION_STRATEGY_EQUAL_SPLIT
+	hc.Validators = []*types.Validator{
+		{
+			OperatorAddress: hc.Validators[0].OperatorAddress,
+			Weight:          decFromStr("0.7"),
+			DelegatedAmount: sdk.NewInt(0),
+			Status:          stakingtypes.BondStatusBonded,
+			Delegable:       true,
+		},
+		{
+			OperatorAddress: hc.Validators[1].OperatorAddress,
+			Weight:          decFromStr("0.3"),
+			DelegatedAmount: sdk.NewInt(0),
+			Status:          stakingtypes.BondStatusBonded,
+			Delegable:       true,
+		},
+	}
+
+	messages, err := suite.app.LiquidStakeIBCKeeper.GenerateDelegateMessages(hc, sdk.NewInt(100))
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, len(messages))
+	for _, message := range messages {
+		msgDelegate := message.(*stakingtypes.MsgDelegate)
+		suite.Require().Equal(int64(50), msgDelegate.Amount.Amount.Int64())
+	}
+
+	// the configured weights are not modified by the strategy
+	suite.Require().Equal(decFromStr("0.7"), hc.Validators[0].Weight)
+	suite.Require().Equal(decFromStr("0.3"), hc.Validators[1].Weight)
+}
+
+func (suite *IntegrationTestSuite) TestGenerateRedelegations() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().Equal(found, true)
+
+	validators := []*types.Validator{
+		{
+			OperatorAddress: hc.Validators[0].OperatorAddress,
+			Weight:          decFromStr("0.25"),
+			DelegatedAmount: sdk.NewInt(700),
+			Status:          stakingtypes.BondStatusBonded,
+			Delegable:       true,
+		},
+		{
+			OperatorAddress: hc.Validators[1].OperatorAddress,
+			Weight:          decFromStr("0.25"),
+			DelegatedAmount: sdk.NewInt(100),
+			Status:          stakingtypes.BondStatusBonded,
+			Delegable:       true,
+		},
+		{
+			OperatorAddress: hc.Validators[2].OperatorAddress,
+			Weight:          decFromStr("0.25"),
+			DelegatedAmount: sdk.NewInt(100),
+			Status:          stakingtypes.BondStatusBonded,
+			Delegable:       true,
+		},
+		{
+			OperatorAddress: hc.Validators[3].OperatorAddress,
+			Weight:          decFromStr("0.25"),
+			DelegatedAmount: sdk.NewInt(100),
+			Status:          stakingtypes.BondStatusBonded,
+			Delegable:       false,
+		},
+	}
+
+	tc := []struct {
+		name                string
+		maxEntries          uint32
+		activeRedelegations []*types.Redelegation
+		expected            map[string]int64
+		err                 error
+	}{
+		{
+			name: "Success",
+			expected: map[string]int64{
+				hc.Validators[1].OperatorAddress: int64(150),
+				hc.Validators[2].OperatorAddress: int64(150),
+			},
+		},
+		{
+			name:       "Max entries reached",
+			maxEntries: 1,
+			activeRedelegations: []*types.Redelegation{{
+				SrcValidatorAddress: hc.Validators[0].OperatorAddress,
+				DstValidatorAddress: hc.Validators[1].OperatorAddress,
+			}},
+			expected: map[string]int64{
+				hc.Validators[2].OperatorAddress: int64(150),
+			},
+		},
+		{
+			name: "Transitive redelegation",
+			activeRedelegations: []*types.Redelegation{{
+				SrcValidatorAddress: hc.Validators[3].OperatorAddress,
+				DstValidatorAddress: hc.Validators[0].OperatorAddress,
+			}},
+			expected: map[string]int64{},
+			err:      errorsmod.Wrap(types.ErrInvalidMessages, "no messages to redelegate"),
+		},
+	}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			hc.Validators = validators
+			hc.Params.MaxRedelegationEntries = t.maxEntries
+
+			redelegations, err := suite.app.LiquidStakeIBCKeeper.GenerateRedelegations(hc, t.activeRedelegations)
+
+			suite.Require().Equal(errors.Cause(t.err), errors.Cause(err))
+			suite.Require().Equal(len(t.expected), len(redelegations))
+			for _, redelegation := range redelegations {
+				suite.Require().Equal(hc.Validators[0].OperatorAddress, redelegation.SrcValidatorAddress)
+				suite.Require().Equal(t.expected[redelegation.DstValidatorAddress], redelegation.Amount.Amount.Int64())
+			}
+		})
+	}
+}
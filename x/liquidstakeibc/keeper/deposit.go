@@ -12,19 +12,82 @@ import (
 
 func (k *Keeper) SetDeposit(ctx sdk.Context, deposit *liquidstakeibctypes.Deposit) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositKey)
+	storeKey := liquidstakeibctypes.GetDepositStoreKey(deposit.ChainId, deposit.Epoch)
+
+	// remove the index entries of the previous version of the deposit
+	if bz := store.Get(storeKey); bz != nil {
+		previous := liquidstakeibctypes.Deposit{}
+		k.cdc.MustUnmarshal(bz, &previous)
+		k.deleteDepositIndexes(ctx, &previous, storeKey)
+	}
+
 	bytes := k.cdc.MustMarshal(deposit)
-	store.Set(liquidstakeibctypes.GetDepositStoreKey(deposit.ChainId, deposit.Epoch), bytes)
+	store.Set(storeKey, bytes)
+	k.setDepositIndexes(ctx, deposit, storeKey)
 }
 
 func (k *Keeper) DeleteDeposit(ctx sdk.Context, deposit *liquidstakeibctypes.Deposit) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositKey)
-	store.Delete(liquidstakeibctypes.GetDepositStoreKey(deposit.ChainId, deposit.Epoch))
+	storeKey := liquidstakeibctypes.GetDepositStoreKey(deposit.ChainId, deposit.Epoch)
+
+	// the indexes are built from the stored deposit, which might differ from the one provided
+	if bz := store.Get(storeKey); bz != nil {
+		stored := liquidstakeibctypes.Deposit{}
+		k.cdc.MustUnmarshal(bz, &stored)
+		k.deleteDepositIndexes(ctx, &stored, storeKey)
+	}
+
+	store.Delete(storeKey)
+}
+
+func (k *Keeper) setDepositIndexes(ctx sdk.Context, deposit *liquidstakeibctypes.Deposit, storeKey []byte) {
+	k.setSequenceIndex(ctx, liquidstakeibctypes.DepositSequenceIndexKey, deposit.IbcSequenceId, storeKey)
+	k.setStateIndex(ctx, liquidstakeibctypes.DepositStateIndexKey, deposit.ChainId, int32(deposit.State), storeKey)
+}
+
+func (k *Keeper) deleteDepositIndexes(ctx sdk.Context, deposit *liquidstakeibctypes.Deposit, storeKey []byte) {
+	k.deleteSequenceIndex(ctx, liquidstakeibctypes.DepositSequenceIndexKey, deposit.IbcSequenceId, storeKey)
+	k.deleteStateIndex(ctx, liquidstakeibctypes.DepositStateIndexKey, deposit.ChainId, int32(deposit.State), storeKey)
+}
+
+// getDepositsFromStoreKeys loads the deposits referenced by an index
+func (k *Keeper) getDepositsFromStoreKeys(ctx sdk.Context, storeKeys [][]byte) []*liquidstakeibctypes.Deposit {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositKey)
+
+	deposits := make([]*liquidstakeibctypes.Deposit, 0, len(storeKeys))
+	for _, storeKey := range storeKeys {
+		bz := store.Get(storeKey)
+		if bz == nil {
+			continue
+		}
+
+		deposit := &liquidstakeibctypes.Deposit{}
+		k.cdc.MustUnmarshal(bz, deposit)
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits
+}
+
+// GetDepositsForChainAndState returns the deposits of a host chain in a given state using the state index
+func (k *Keeper) GetDepositsForChainAndState(
+	ctx sdk.Context,
+	chainID string,
+	state liquidstakeibctypes.Deposit_DepositState,
+) []*liquidstakeibctypes.Deposit {
+	return k.getDepositsFromStoreKeys(
+		ctx,
+		k.getIndexedStoreKeys(
+			ctx,
+			liquidstakeibctypes.DepositStateIndexKey,
+			liquidstakeibctypes.GetStateIndexPrefix(chainID, int32(state)),
+		),
+	)
 }
 
 func (k *Keeper) CreateDeposits(ctx sdk.Context, epoch int64) {
 	hostChains := k.GetAllHostChains(ctx)
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositKey)
 	for _, hc := range hostChains {
 		deposit := &liquidstakeibctypes.Deposit{
 			ChainId:       hc.ChainId,
@@ -33,8 +96,7 @@ func (k *Keeper) CreateDeposits(ctx sdk.Context, epoch int64) {
 			State:         liquidstakeibctypes.Deposit_DEPOSIT_PENDING,
 			IbcSequenceId: "",
 		}
-		bytes := k.cdc.MustMarshal(deposit)
-		store.Set(liquidstakeibctypes.GetDepositStoreKey(deposit.ChainId, deposit.Epoch), bytes)
+		k.SetDeposit(ctx, deposit)
 	}
 }
 
@@ -102,20 +164,20 @@ func (k *Keeper) GetDepositForChainAndEpoch(
 	epoch int64,
 ) (*liquidstakeibctypes.Deposit, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositKey)
-	iterator := sdk.KVStorePrefixIterator(store, nil)
-	defer iterator.Close()
+	bz := store.Get(liquidstakeibctypes.GetDepositStoreKey(chainID, epoch))
+	if bz == nil {
+		return nil, false
+	}
 
-	for ; iterator.Valid(); iterator.Next() {
-		deposit := &liquidstakeibctypes.Deposit{}
-		k.cdc.MustUnmarshal(iterator.Value(), deposit)
+	deposit := &liquidstakeibctypes.Deposit{}
+	k.cdc.MustUnmarshal(bz, deposit)
 
-		if deposit.Epoch == epoch &&
-			deposit.ChainId == chainID {
-			return deposit, true
-		}
+	// store keys are not delimited, so make sure the deposit is the one requested
+	if deposit.Epoch != epoch || deposit.ChainId != chainID {
+		return nil, false
 	}
 
-	return nil, false
+	return deposit, true
 }
 
 func (k *Keeper) GetDepositsForHostChain(ctx sdk.Context, chainID string) []*liquidstakeibctypes.Deposit {
@@ -137,36 +199,23 @@ func (k *Keeper) GetDepositsForHostChain(ctx sdk.Context, chainID string) []*liq
 }
 
 func (k *Keeper) GetDepositsWithSequenceID(ctx sdk.Context, sequenceID string) []*liquidstakeibctypes.Deposit {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositKey)
-	iterator := sdk.KVStorePrefixIterator(store, nil)
-	defer iterator.Close()
-
-	deposits := make([]*liquidstakeibctypes.Deposit, 0)
-	for ; iterator.Valid(); iterator.Next() {
-		deposit := &liquidstakeibctypes.Deposit{}
-		k.cdc.MustUnmarshal(iterator.Value(), deposit)
-
-		if deposit.IbcSequenceId == sequenceID {
-			deposits = append(deposits, deposit)
-		}
-	}
-
-	return deposits
+	return k.getDepositsFromStoreKeys(
+		ctx,
+		k.getIndexedStoreKeys(
+			ctx,
+			liquidstakeibctypes.DepositSequenceIndexKey,
+			liquidstakeibctypes.GetSequenceIndexPrefix(sequenceID),
+		),
+	)
 }
 
 func (k *Keeper) GetPendingDepositsBeforeEpoch(ctx sdk.Context, epoch int64) []*liquidstakeibctypes.Deposit {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositKey)
-	iterator := sdk.KVStorePrefixIterator(store, nil)
-	defer iterator.Close()
-
 	deposits := make([]*liquidstakeibctypes.Deposit, 0)
-	for ; iterator.Valid(); iterator.Next() {
-		deposit := &liquidstakeibctypes.Deposit{}
-		k.cdc.MustUnmarshal(iterator.Value(), deposit)
-
-		if deposit.Epoch <= epoch &&
-			deposit.State == liquidstakeibctypes.Deposit_DEPOSIT_PENDING {
-			deposits = append(deposits, deposit)
+	for _, hc := range k.GetAllHostChains(ctx) {
+		for _, deposit := range k.GetDepositsForChainAndState(ctx, hc.ChainId, liquidstakeibctypes.Deposit_DEPOSIT_PENDING) {
+			if deposit.Epoch <= epoch {
+				deposits = append(deposits, deposit)
+			}
 		}
 	}
 
@@ -177,19 +226,10 @@ func (k *Keeper) GetRedeemableDepositsForHostChain(
 	ctx sdk.Context,
 	hc *liquidstakeibctypes.HostChain,
 ) ([]*liquidstakeibctypes.Deposit, math.Int) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositKey)
-	iterator := sdk.KVStorePrefixIterator(store, nil)
-	defer iterator.Close()
-
 	deposits := make([]*liquidstakeibctypes.Deposit, 0)
 	redeemableAmount := sdk.ZeroInt()
-	for ; iterator.Valid(); iterator.Next() {
-		deposit := &liquidstakeibctypes.Deposit{}
-		k.cdc.MustUnmarshal(iterator.Value(), deposit)
-
-		if deposit.ChainId == hc.ChainId &&
-			deposit.State == liquidstakeibctypes.Deposit_DEPOSIT_PENDING &&
-			!deposit.Amount.IsZero() {
+	for _, deposit := range k.GetDepositsForChainAndState(ctx, hc.ChainId, liquidstakeibctypes.Deposit_DEPOSIT_PENDING) {
+		if !deposit.Amount.IsZero() {
 			redeemableAmount = redeemableAmount.Add(deposit.Amount.Amount)
 			deposits = append(deposits, deposit)
 		}
@@ -199,76 +239,39 @@ func (k *Keeper) GetRedeemableDepositsForHostChain(
 }
 
 func (k *Keeper) GetDelegableDepositsForChain(ctx sdk.Context, chainID string) []*liquidstakeibctypes.Deposit {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositKey)
-	iterator := sdk.KVStorePrefixIterator(store, nil)
-	defer iterator.Close()
-
-	deposits := make([]*liquidstakeibctypes.Deposit, 0)
-	for ; iterator.Valid(); iterator.Next() {
-		deposit := &liquidstakeibctypes.Deposit{}
-		k.cdc.MustUnmarshal(iterator.Value(), deposit)
-
-		if deposit.ChainId == chainID &&
-			deposit.State == liquidstakeibctypes.Deposit_DEPOSIT_RECEIVED {
-			deposits = append(deposits, deposit)
-		}
-	}
-
-	return deposits
+	return k.GetDepositsForChainAndState(ctx, chainID, liquidstakeibctypes.Deposit_DEPOSIT_RECEIVED)
 }
 
 func (k *Keeper) GetDelegatingDepositsForChain(ctx sdk.Context, chainID string) []*liquidstakeibctypes.Deposit {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositKey)
-	iterator := sdk.KVStorePrefixIterator(store, nil)
-	defer iterator.Close()
-
-	deposits := make([]*liquidstakeibctypes.Deposit, 0)
-	for ; iterator.Valid(); iterator.Next() {
-		deposit := &liquidstakeibctypes.Deposit{}
-		k.cdc.MustUnmarshal(iterator.Value(), deposit)
-
-		if deposit.ChainId == chainID &&
-			deposit.State == liquidstakeibctypes.Deposit_DEPOSIT_DELEGATING {
-			deposits = append(deposits, deposit)
-		}
-	}
-
-	return deposits
+	return k.GetDepositsForChainAndState(ctx, chainID, liquidstakeibctypes.Deposit_DEPOSIT_DELEGATING)
 }
 
 func (k *Keeper) GetDepositAmountOnPersistence(ctx sdk.Context, chainID string) math.Int {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositKey)
-	iterator := sdk.KVStorePrefixIterator(store, nil)
-	defer iterator.Close()
-
-	amount := sdk.ZeroInt()
-	for ; iterator.Valid(); iterator.Next() {
-		deposit := &liquidstakeibctypes.Deposit{}
-		k.cdc.MustUnmarshal(iterator.Value(), deposit)
-
-		if deposit.ChainId == chainID &&
-			(deposit.State == liquidstakeibctypes.Deposit_DEPOSIT_PENDING ||
-				deposit.State == liquidstakeibctypes.Deposit_DEPOSIT_SENT) {
-			amount = amount.Add(deposit.Amount.Amount)
-		}
-	}
-
-	return amount
+	return k.getDepositAmountForStates(
+		ctx,
+		chainID,
+		liquidstakeibctypes.Deposit_DEPOSIT_PENDING,
+		liquidstakeibctypes.Deposit_DEPOSIT_SENT,
+	)
 }
 
 func (k *Keeper) GetDepositAmountOnHostChain(ctx sdk.Context, chainID string) math.Int {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.DepositKey)
-	iterator := sdk.KVStorePrefixIterator(store, nil)
-	defer iterator.Close()
+	return k.getDepositAmountForStates(
+		ctx,
+		chainID,
+		liquidstakeibctypes.Deposit_DEPOSIT_RECEIVED,
+		liquidstakeibctypes.Deposit_DEPOSIT_DELEGATING,
+	)
+}
 
+func (k *Keeper) getDepositAmountForStates(
+	ctx sdk.Context,
+	chainID string,
+	states ...liquidstakeibctypes.Deposit_DepositState,
+) math.Int {
 	amount := sdk.ZeroInt()
-	for ; iterator.Valid(); iterator.Next() {
-		deposit := &liquidstakeibctypes.Deposit{}
-		k.cdc.MustUnmarshal(iterator.Value(), deposit)
-
-		if deposit.ChainId == chainID &&
-			(deposit.State == liquidstakeibctypes.Deposit_DEPOSIT_RECEIVED ||
-				deposit.State == liquidstakeibctypes.Deposit_DEPOSIT_DELEGATING) {
+	for _, state := range states {
+		for _, deposit := range k.GetDepositsForChainAndState(ctx, chainID, state) {
 			amount = amount.Add(deposit.Amount.Amount)
 		}
 	}
@@ -373,6 +373,8 @@ func (suite *IntegrationTestSuite) TestGetPendingDepositsBeforeEpoch() {
 	for _, t := range tc {
 		suite.Run(t.name, func() {
 			for _, deposit := range t.deposits {
+				// pending deposits are looked up per registered host chain
+				deposit.ChainId = suite.chainB.ChainID
 				suite.app.LiquidStakeIBCKeeper.SetDeposit(suite.ctx, &deposit)
 			}
 
@@ -556,3 +558,47 @@ func (suite *IntegrationTestSuite) TestGetDepositAmountOnHostChain() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestDepositIndexes() {
+	epoch := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, types.DelegationEpoch).CurrentEpoch
+
+	deposit := &types.Deposit{
+		ChainId:       suite.chainB.ChainID,
+		Amount:        sdk.NewInt64Coin("uatom", 100),
+		Epoch:         epoch + 10,
+		State:         types.Deposit_DEPOSIT_PENDING,
+		IbcSequenceId: "",
+	}
+	suite.app.LiquidStakeIBCKeeper.SetDeposit(suite.ctx, deposit)
+
+	suite.Require().Len(suite.app.LiquidStakeIBCKeeper.GetDepositsForChainAndState(
+		suite.ctx, suite.chainB.ChainID, types.Deposit_DEPOSIT_PENDING,
+	), 1)
+
+	// moving the deposit to a new state and sequence replaces its previous index entries
+	deposit.State = types.Deposit_DEPOSIT_SENT
+	deposit.IbcSequenceId = "channel-0-sequence-10"
+	suite.app.LiquidStakeIBCKeeper.SetDeposit(suite.ctx, deposit)
+
+	suite.Require().Len(suite.app.LiquidStakeIBCKeeper.GetDepositsForChainAndState(
+		suite.ctx, suite.chainB.ChainID, types.Deposit_DEPOSIT_PENDING,
+	), 0)
+	suite.Require().Equal(
+		[]*types.Deposit{deposit},
+		suite.app.LiquidStakeIBCKeeper.GetDepositsForChainAndState(
+			suite.ctx, suite.chainB.ChainID, types.Deposit_DEPOSIT_SENT,
+		),
+	)
+	suite.Require().Equal(
+		[]*types.Deposit{deposit},
+		suite.app.LiquidStakeIBCKeeper.GetDepositsWithSequenceID(suite.ctx, "channel-0-sequence-10"),
+	)
+
+	// deleting the deposit removes the index entries of the stored version
+	suite.app.LiquidStakeIBCKeeper.DeleteDeposit(suite.ctx, &types.Deposit{ChainId: deposit.ChainId, Epoch: deposit.Epoch})
+
+	suite.Require().Len(suite.app.LiquidStakeIBCKeeper.GetDepositsForChainAndState(
+		suite.ctx, suite.chainB.ChainID, types.Deposit_DEPOSIT_SENT,
+	), 0)
+	suite.Require().Len(suite.app.LiquidStakeIBCKeeper.GetDepositsWithSequenceID(suite.ctx, "channel-0-sequence-10"), 0)
+}
@@ -0,0 +1,729 @@
+package keeper
+
+import (
+	"bytes"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// StartHostChainDeregistration disables a host chain and starts its wind-down, which is then carried out block by
+// block by DoDeregisterHostChain
+func (k *Keeper) StartHostChainDeregistration(ctx sdk.Context, hc *types.HostChain) error {
+	if hc.Deregistration != nil {
+		return types.ErrHostChainDeregistering
+	}
+
+	hc.Active = false
+	hc.Deregistration = &types.Deregistration{State: types.Deregistration_DEREGISTRATION_UNBONDING}
+	k.SetHostChain(ctx, hc)
+
+	k.emitDeregistrationEvent(ctx, hc)
+
+	return nil
+}
+
+// DoDeregisterHostChain moves forward the deregistration of a host chain. All the delegations are undelegated, then the
+// residual balances of the ICAs are returned to Persistence and the pending unbondings are settled at the final c
+// value, which the stk holders then redeem at against a redemption record. Once every unbonding has been claimed, or
+// the grace period has passed, the records of the host chain are deleted a batch per block, and the host chain last.
+func (k *Keeper) DoDeregisterHostChain(ctx sdk.Context, hc *types.HostChain) {
+	// keep the ICA channels open and the operations that were already in flight moving
+	k.DoRecreateICA(ctx, hc)
+	k.DoClaim(ctx, hc)
+	k.DoProcessMaturedUndelegations(ctx, hc)
+	if hc.Flags.Lsm {
+		k.DoTransferLSMDeposits(ctx, hc)
+		k.DoRedeemLSMTokens(ctx, hc)
+	}
+
+	switch hc.Deregistration.State {
+	case types.Deregistration_DEREGISTRATION_UNBONDING:
+		k.doDeregistrationUnbonding(ctx, hc)
+	case types.Deregistration_DEREGISTRATION_RETURNING:
+		k.doDeregistrationReturning(ctx, hc)
+	case types.Deregistration_DEREGISTRATION_REDEEMING:
+		k.doDeregistrationRedeeming(ctx, hc)
+	case types.Deregistration_DEREGISTRATION_DELETING:
+		k.doDeregistrationDeleting(ctx, hc)
+	}
+}
+
+// doDeregistrationUnbonding undelegates all the host chain delegations once no deposit, unbonding or redelegation
+// is being processed, and moves on when all the validator unbondings have been transferred back
+func (k *Keeper) doDeregistrationUnbonding(ctx sdk.Context, hc *types.HostChain) {
+	if len(k.GetDepositsForChainAndState(ctx, hc.ChainId, types.Deposit_DEPOSIT_SENT)) > 0 ||
+		len(k.GetDepositsForChainAndState(ctx, hc.ChainId, types.Deposit_DEPOSIT_DELEGATING)) > 0 ||
+		len(k.GetUnbondingsForChainAndState(ctx, hc.ChainId, types.Unbonding_UNBONDING_INITIATED)) > 0 ||
+		len(k.GetLSMDepositsForHostChain(ctx, hc.ChainId)) > 0 {
+		return
+	}
+	for _, redelegation := range k.GetRedelegationsForHostChain(ctx, hc.ChainId) {
+		if redelegation.State == types.Redelegation_REDELEGATION_INITIATED {
+			return
+		}
+	}
+
+	validatorUnbondings := k.GetValidatorUnbondingsForHostChain(ctx, hc.ChainId)
+	for _, validatorUnbonding := range validatorUnbondings {
+		// wait for the undelegations that haven't been acknowledged yet
+		if validatorUnbonding.MatureTime == (time.Time{}) {
+			return
+		}
+	}
+
+	epoch := k.GetEpochNumber(ctx, types.DelegationEpoch)
+	undelegating := false
+	for _, validator := range hc.Validators {
+		if !validator.DelegatedAmount.IsPositive() {
+			continue
+		}
+		undelegating = true
+
+		// a validator unbonding from this epoch is still maturing, undelegate on the next one
+		if _, found := k.GetValidatorUnbonding(ctx, hc.ChainId, validator.OperatorAddress, epoch); found {
+			continue
+		}
+
+		// unbond all delegated tokens from the validator
+		validatorUnbonding := &types.ValidatorUnbonding{
+			ChainId:          hc.ChainId,
+			EpochNumber:      epoch,
+			MatureTime:       time.Time{},
+			ValidatorAddress: validator.OperatorAddress,
+			Amount:           sdk.NewCoin(hc.HostDenom, validator.DelegatedAmount),
+		}
+
+		// create the MsgUndelegate
+		message := &stakingtypes.MsgUndelegate{
+			DelegatorAddress: hc.DelegationAccount.Address,
+			ValidatorAddress: validatorUnbonding.ValidatorAddress,
+			Amount:           validatorUnbonding.Amount,
+		}
+
+		// execute the ICA transaction
+		sequenceID, err := k.GenerateAndExecuteICATx(
+			ctx,
+			hc.ConnectionId,
+			hc.DelegationAccount.Owner,
+			[]proto.Message{message},
+		)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"could not send ICA deregistration undelegate txs",
+				"host_chain",
+				hc.ChainId,
+			)
+			return
+		}
+
+		// update the unbonding sequence id
+		validatorUnbonding.IbcSequenceId = sequenceID
+		k.SetValidatorUnbonding(ctx, validatorUnbonding)
+
+		telemetry.IncrCounter(float32(1), hc.ChainId, "validator_unbondings")
+
+		k.Logger(ctx).Info(
+			"Started deregistration validator unbonding.",
+			"host_chain",
+			hc.ChainId,
+			"validator",
+			validatorUnbonding.ValidatorAddress,
+			"amount",
+			validatorUnbonding.Amount,
+		)
+	}
+
+	// wait until every undelegation has matured and has been transferred back, including the user unbondings, as
+	// their tokens are also sent from the delegation account
+	if undelegating || len(validatorUnbondings) > 0 ||
+		len(k.GetUnbondingsForChainAndState(ctx, hc.ChainId, types.Unbonding_UNBONDING_MATURING)) > 0 ||
+		len(k.GetUnbondingsForChainAndState(ctx, hc.ChainId, types.Unbonding_UNBONDING_MATURED)) > 0 {
+		return
+	}
+
+	hc.Deregistration.State = types.Deregistration_DEREGISTRATION_RETURNING
+	k.queryDeregistrationBalances(ctx, hc)
+	k.SetHostChain(ctx, hc)
+
+	k.emitDeregistrationEvent(ctx, hc)
+}
+
+// doDeregistrationReturning waits until both ICA balances have been reported empty after the last transfer window
+// has passed, re-querying them otherwise, and then settles the pending unbondings at the final c value
+func (k *Keeper) doDeregistrationReturning(ctx sdk.Context, hc *types.HostChain) {
+	// give the balance queries and transfers enough time to be answered or to time out
+	if ctx.BlockHeight() <= hc.Deregistration.LastSweepHeight+int64(types.IBCTimeoutHeightIncrement) {
+		return
+	}
+
+	if hc.Deregistration.PendingBalanceQueries > 0 ||
+		!icaBalanceEmpty(hc.DelegationAccount.Balance) || !icaBalanceEmpty(hc.RewardsAccount.Balance) {
+		k.queryDeregistrationBalances(ctx, hc)
+		k.SetHostChain(ctx, hc)
+		return
+	}
+
+	// the unbondings that are being processed need to finish before the final c value is computed
+	for _, state := range []types.Unbonding_UnbondingState{
+		types.Unbonding_UNBONDING_INITIATED,
+		types.Unbonding_UNBONDING_MATURING,
+		types.Unbonding_UNBONDING_MATURED,
+		types.Unbonding_UNBONDING_FAILED,
+	} {
+		if len(k.GetUnbondingsForChainAndState(ctx, hc.ChainId, state)) > 0 {
+			return
+		}
+	}
+
+	// the unbondings are settled atomically, nothing is written if it fails
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.SettleHostChainUnbondings(cacheCtx, hc); err != nil {
+		k.Logger(ctx).Error(
+			"could not settle the host chain unbondings",
+			"host_chain",
+			hc.ChainId,
+			"error",
+			err,
+		)
+		return
+	}
+	write()
+
+	hc.Deregistration.State = types.Deregistration_DEREGISTRATION_REDEEMING
+	hc.Deregistration.RedeemingEpoch = k.GetEpochNumber(ctx, types.DelegationEpoch)
+	k.SetHostChain(ctx, hc)
+
+	k.emitDeregistrationEvent(ctx, hc)
+}
+
+// doDeregistrationRedeeming waits until every settled unbonding has been claimed, or for the grace period at most,
+// then sends the tokens not owed to the stk holders to the module fee address and moves on to the deletion of the
+// host chain records
+func (k *Keeper) doDeregistrationRedeeming(ctx sdk.Context, hc *types.HostChain) {
+	graceEnded := k.GetEpochNumber(ctx, types.DelegationEpoch) >=
+		hc.Deregistration.RedeemingEpoch+types.DeregistrationGraceEpochs
+	if !graceEnded && len(k.GetUnbondingsForChainAndState(ctx, hc.ChainId, types.Unbonding_UNBONDING_CLAIMABLE)) > 0 {
+		return
+	}
+
+	// the tokens owed to the stk holders stay in the deposit module account, the rest is rounding dust, and the
+	// undelegation module account holds the unbondings left unclaimed
+	owed := sdk.ZeroInt()
+	if record, found := k.GetRedemptionRecord(ctx, hc.MintDenom()); found {
+		owed = record.Amount
+	}
+	depositBalance := k.bankKeeper.GetBalance(ctx, k.GetDepositModuleAccount(ctx).GetAddress(), hc.IBCDenom())
+	unclaimedBalance := k.bankKeeper.GetBalance(ctx, k.GetUndelegationModuleAccount(ctx).GetAddress(), hc.IBCDenom())
+	for _, leftover := range []struct {
+		moduleAccount string
+		amount        math.Int
+	}{
+		{types.DepositModuleAccount, depositBalance.Amount.Sub(owed)},
+		{types.UndelegationModuleAccount, unclaimedBalance.Amount},
+	} {
+		if !leftover.amount.IsPositive() {
+			continue
+		}
+
+		if err := k.SendProtocolFee(
+			ctx,
+			sdk.NewCoins(sdk.NewCoin(hc.IBCDenom(), leftover.amount)),
+			leftover.moduleAccount,
+			k.GetParams(ctx).FeeAddress,
+		); err != nil {
+			k.Logger(ctx).Error(
+				"could not send the deregistration leftovers to the fee address",
+				"host_chain",
+				hc.ChainId,
+				"error",
+				err,
+			)
+			return
+		}
+	}
+
+	hc.Deregistration.State = types.Deregistration_DEREGISTRATION_DELETING
+	k.SetHostChain(ctx, hc)
+
+	k.emitDeregistrationEvent(ctx, hc)
+}
+
+// doDeregistrationDeleting deletes a batch of the host chain records, and the host chain itself once none is left
+func (k *Keeper) doDeregistrationDeleting(ctx sdk.Context, hc *types.HostChain) {
+	if !k.DeleteHostChainRecordsBatch(ctx, hc.ChainId, k.GetParams(ctx).MaxRecordsPerBlock) {
+		return
+	}
+
+	k.DeleteHostChain(ctx, hc.ChainId)
+
+	k.Logger(ctx).Info("Host chain deregistered.", "host_chain", hc.ChainId)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHostChainDeregistration,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+		),
+	)
+}
+
+// queryDeregistrationBalances queries the balances of both host chain ICAs, which are returned to Persistence by the
+// balance callbacks
+func (k *Keeper) queryDeregistrationBalances(ctx sdk.Context, hc *types.HostChain) {
+	hc.Deregistration.LastSweepHeight = ctx.BlockHeight()
+	hc.Deregistration.PendingBalanceQueries = 0
+
+	if err := k.QueryDelegationHostChainAccountBalance(ctx, hc); err != nil {
+		k.Logger(ctx).Error("could not query the delegation account balance", "host_chain", hc.ChainId)
+	} else {
+		hc.Deregistration.PendingBalanceQueries++
+	}
+
+	if err := k.QueryRewardsHostChainAccountBalance(ctx, hc); err != nil {
+		k.Logger(ctx).Error("could not query the rewards account balance", "host_chain", hc.ChainId)
+	} else {
+		hc.Deregistration.PendingBalanceQueries++
+	}
+}
+
+// ReturnDeregistrationBalance transfers back to the deposit module account the balance of a host chain ICA, to be
+// distributed among the stk holders of the host chain being deregistered
+func (k *Keeper) ReturnDeregistrationBalance(ctx sdk.Context, hc *types.HostChain, account *types.ICAAccount) error {
+	if hc.Deregistration.PendingBalanceQueries > 0 {
+		hc.Deregistration.PendingBalanceQueries--
+	}
+
+	if !account.Balance.IsPositive() {
+		return nil
+	}
+
+	_, err := k.SendICATransfer(
+		ctx,
+		hc,
+		account.Balance,
+		account.Address,
+		authtypes.NewModuleAddress(types.DepositModuleAccount).String(),
+		account.Owner,
+	)
+	if err != nil {
+		return err
+	}
+
+	hc.Deregistration.LastSweepHeight = ctx.BlockHeight()
+
+	return nil
+}
+
+// SettleHostChainUnbondings prices every pending unbonding of a host chain at the final c value, which is the ratio
+// between the stk supply and the tokens held by the module for the host chain. The unbondings become claimable right
+// away, and the tokens left in the deposit module account are snapshotted into a redemption record the stk holders
+// redeem against at the same c value.
+func (k *Keeper) SettleHostChainUnbondings(ctx sdk.Context, hc *types.HostChain) error {
+	pool := sdk.ZeroInt()
+	for _, deposit := range k.GetDepositsForChainAndState(ctx, hc.ChainId, types.Deposit_DEPOSIT_PENDING) {
+		pool = pool.Add(deposit.Amount.Amount)
+	}
+	mintedAmount := k.bankKeeper.GetSupply(ctx, hc.MintDenom()).Amount
+
+	distributed := sdk.ZeroInt()
+	for _, unbonding := range k.GetUnbondingsForChainAndState(ctx, hc.ChainId, types.Unbonding_UNBONDING_PENDING) {
+		userUnbondings := k.GetUserUnbondingsForEpoch(ctx, hc.ChainId, unbonding.EpochNumber)
+
+		unbondAmount := sdk.ZeroInt()
+		for _, userUnbonding := range userUnbondings {
+			amount := sdk.ZeroInt()
+			if mintedAmount.IsPositive() {
+				amount = userUnbonding.StkAmount.Amount.Mul(pool).Quo(mintedAmount)
+			}
+
+			userUnbonding.UnbondAmount = sdk.NewCoin(hc.HostDenom, amount)
+			k.SetUserUnbonding(ctx, userUnbonding)
+			unbondAmount = unbondAmount.Add(amount)
+		}
+
+		if err := k.bankKeeper.BurnCoins(
+			ctx,
+			types.UndelegationModuleAccount,
+			sdk.NewCoins(unbonding.BurnAmount),
+		); err != nil {
+			return err
+		}
+
+		unbonding.UnbondAmount = sdk.NewCoin(hc.HostDenom, unbondAmount)
+		unbonding.State = types.Unbonding_UNBONDING_CLAIMABLE
+		k.SetUnbonding(ctx, unbonding)
+
+		distributed = distributed.Add(unbondAmount)
+	}
+
+	// move the distributed tokens to the undelegation module account so they can be claimed
+	if distributed.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			types.DepositModuleAccount,
+			types.UndelegationModuleAccount,
+			sdk.NewCoins(sdk.NewCoin(hc.IBCDenom(), distributed)),
+		); err != nil {
+			return err
+		}
+	}
+
+	for _, deposit := range k.GetDepositsForHostChain(ctx, hc.ChainId) {
+		k.DeleteDeposit(ctx, deposit)
+	}
+
+	hc.LastCValue = hc.CValue
+	if pool.IsPositive() && mintedAmount.IsPositive() {
+		hc.CValue = sdk.NewDecFromInt(mintedAmount).Quo(sdk.NewDecFromInt(pool))
+	}
+
+	// the stk tokens left are redeemed against the record, which outlives the host chain
+	if stkAmount := k.bankKeeper.GetSupply(ctx, hc.MintDenom()).Amount; stkAmount.IsPositive() {
+		k.SetRedemptionRecord(ctx, &types.RedemptionRecord{
+			ChainId:   hc.ChainId,
+			MintDenom: hc.MintDenom(),
+			IbcDenom:  hc.IBCDenom(),
+			CValue:    hc.CValue,
+			Amount:    pool.Sub(distributed),
+			StkAmount: stkAmount,
+		})
+	}
+
+	k.Logger(ctx).Info(
+		"Settled host chain unbondings.",
+		"host_chain",
+		hc.ChainId,
+		"distributed",
+		distributed,
+		"c_value",
+		hc.CValue,
+	)
+
+	return nil
+}
+
+// RedeemFromRedemptionRecord burns the stk tokens of a deregistered host chain and sends the redeemer their share of
+// the tokens left in the deposit module account, at the final c value of the host chain. There is no fee and no cap.
+// The record is deleted once every stk token has been redeemed.
+func (k *Keeper) RedeemFromRedemptionRecord(
+	ctx sdk.Context,
+	record *types.RedemptionRecord,
+	redeemer sdk.AccAddress,
+	amount sdk.Coin,
+) (sdk.Coin, error) {
+	if err := validateRedemption(record, amount); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	redeemToken := redemptionAmount(record, amount)
+
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, redeemer, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			types.ErrRedeemFailed,
+			"failed to send redeemed coins from account %s to module %s: %s",
+			redeemer.String(),
+			types.ModuleName,
+			err.Error(),
+		)
+	}
+
+	if err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			types.ErrBurnFailed,
+			"failed to burn redeemed coins on module %s: %s",
+			types.ModuleName,
+			err.Error(),
+		)
+	}
+
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.DepositModuleAccount,
+		redeemer,
+		sdk.NewCoins(redeemToken),
+	); err != nil {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			types.ErrRedeemFailed,
+			"failed to send redeemed coins from module %s to account %s: %s",
+			types.DepositModuleAccount,
+			redeemer.String(),
+			err.Error(),
+		)
+	}
+
+	// the last stk tokens are redeemed for all the tokens left, the record has no dust
+	record.Amount = record.Amount.Sub(redeemToken.Amount)
+	record.StkAmount = record.StkAmount.Sub(amount.Amount)
+	if record.StkAmount.IsZero() {
+		k.DeleteRedemptionRecord(ctx, record.MintDenom)
+	} else {
+		k.SetRedemptionRecord(ctx, record)
+	}
+
+	return redeemToken, nil
+}
+
+// hostChainRecords is a store of host chain records deleted by DeleteHostChainRecordsBatch
+type hostChainRecords struct {
+	// workflow names the cursor of the deletion pass over the store
+	workflow    string
+	storePrefix []byte
+	keyPrefix   []byte
+	// deleteRecord removes an entry together with its indexes if it belongs to the host chain, the entries are
+	// removed as they are otherwise
+	deleteRecord func(value []byte)
+}
+
+// hostChainRecordStores returns the stores holding the records of a host chain. The records keyed by the raw chain
+// id are unmarshalled to check their chain, as the key prefix might be shared with a longer chain id.
+func (k *Keeper) hostChainRecordStores(ctx sdk.Context, chainID string) []hostChainRecords {
+	return []hostChainRecords{
+		{
+			workflow:    "deregistration_deposits",
+			storePrefix: types.DepositKey,
+			keyPrefix:   []byte(chainID),
+			deleteRecord: func(value []byte) {
+				deposit := types.Deposit{}
+				k.cdc.MustUnmarshal(value, &deposit)
+				if deposit.ChainId == chainID {
+					k.DeleteDeposit(ctx, &deposit)
+				}
+			},
+		},
+		{
+			workflow:    "deregistration_lsm_deposits",
+			storePrefix: types.LSMDepositKey,
+			keyPrefix:   []byte(chainID),
+			deleteRecord: func(value []byte) {
+				deposit := types.LSMDeposit{}
+				k.cdc.MustUnmarshal(value, &deposit)
+				if deposit.ChainId == chainID {
+					k.DeleteLSMDeposit(ctx, &deposit)
+				}
+			},
+		},
+		{
+			workflow:    "deregistration_unbondings",
+			storePrefix: types.UnbondingKey,
+			keyPrefix:   []byte(chainID),
+			deleteRecord: func(value []byte) {
+				unbonding := types.Unbonding{}
+				k.cdc.MustUnmarshal(value, &unbonding)
+				if unbonding.ChainId == chainID {
+					k.DeleteUnbonding(ctx, &unbonding)
+				}
+			},
+		},
+		{
+			workflow:    "deregistration_user_unbondings",
+			storePrefix: types.UserUnbondingKey,
+			keyPrefix:   []byte(chainID),
+			deleteRecord: func(value []byte) {
+				userUnbonding := types.UserUnbonding{}
+				k.cdc.MustUnmarshal(value, &userUnbonding)
+				if userUnbonding.ChainId == chainID {
+					k.DeleteUserUnbonding(ctx, &userUnbonding)
+				}
+			},
+		},
+		{
+			workflow:    "deregistration_validator_unbondings",
+			storePrefix: types.ValidatorUnbondingKey,
+			keyPrefix:   []byte(chainID),
+			deleteRecord: func(value []byte) {
+				validatorUnbonding := types.ValidatorUnbonding{}
+				k.cdc.MustUnmarshal(value, &validatorUnbonding)
+				if validatorUnbonding.ChainId == chainID {
+					k.DeleteValidatorUnbonding(ctx, &validatorUnbonding)
+				}
+			},
+		},
+		{
+			workflow:    "deregistration_redelegations",
+			storePrefix: types.RedelegationKey,
+			keyPrefix:   []byte(chainID),
+			deleteRecord: func(value []byte) {
+				redelegation := types.Redelegation{}
+				k.cdc.MustUnmarshal(value, &redelegation)
+				if redelegation.ChainId == chainID {
+					k.DeleteRedelegation(ctx, &redelegation)
+				}
+			},
+		},
+		{
+			workflow:    "deregistration_validator_set_candidates",
+			storePrefix: types.ValidatorSetCandidateKey,
+			keyPrefix:   types.GetValidatorSetCandidateChainPrefix(chainID),
+		},
+		{
+			workflow:    "deregistration_validator_set_cons_index",
+			storePrefix: types.ValidatorSetCandidateConsIndexKey,
+			keyPrefix:   types.GetValidatorSetCandidateChainPrefix(chainID),
+		},
+		{
+			workflow:    "deregistration_inflows",
+			storePrefix: types.InflowKey,
+			keyPrefix:   types.GetInflowChainPrefix(chainID),
+		},
+		{
+			workflow:    "deregistration_redeem_outflows",
+			storePrefix: types.RedeemOutflowKey,
+			keyPrefix:   types.GetRedeemOutflowChainPrefix(chainID),
+		},
+		{
+			workflow:    "deregistration_slash_records",
+			storePrefix: types.SlashRecordKey,
+			keyPrefix:   types.GetSlashRecordChainPrefix(chainID),
+		},
+		{
+			workflow:    "deregistration_host_chain_votes",
+			storePrefix: types.HostChainVoteKey,
+			keyPrefix:   types.GetHostChainVoteChainPrefix(chainID),
+		},
+		{
+			workflow:    "deregistration_vote_signalings",
+			storePrefix: types.VoteSignalingKey,
+			keyPrefix:   types.GetHostChainVoteChainPrefix(chainID),
+		},
+		{
+			workflow:    "deregistration_vote_signals",
+			storePrefix: types.VoteSignalKey,
+			keyPrefix:   types.GetHostChainVoteChainPrefix(chainID),
+			deleteRecord: func(value []byte) {
+				signal := types.VoteSignal{}
+				k.cdc.MustUnmarshal(value, &signal)
+				if hc, found := k.GetHostChain(ctx, chainID); found {
+					k.refundVoteSignal(ctx, hc, &signal)
+				}
+				k.DeleteVoteSignal(ctx, &signal)
+			},
+		},
+		{
+			workflow:    "deregistration_vote_signaling_end_times",
+			storePrefix: types.VoteSignalingEndTimeIndexKey,
+			keyPrefix:   types.GetVoteSignalingChainPrefix(chainID),
+		},
+		{
+			workflow:    "deregistration_closed_vote_signalings",
+			storePrefix: types.VoteSignalingClosedIndexKey,
+			keyPrefix:   types.GetVoteSignalingChainPrefix(chainID),
+		},
+		{
+			workflow:    "deregistration_timelocked_updates",
+			storePrefix: types.TimelockedUpdateKey,
+			keyPrefix:   types.GetTimelockedUpdateChainPrefix(chainID),
+		},
+		{
+			workflow:    "deregistration_c_value_records",
+			storePrefix: types.CValueRecordKey,
+			keyPrefix:   types.GetCValueRecordChainPrefix(chainID),
+		},
+		{
+			workflow:    "deregistration_reward_records",
+			storePrefix: types.RewardRecordKey,
+			keyPrefix:   types.GetRewardRecordChainPrefix(chainID),
+		},
+	}
+}
+
+// DeleteHostChainRecordsBatch removes up to limit records of a host chain and returns whether every record has been
+// removed. The stores are gone through in order, a workflow cursor per store keeping track of the pass across blocks.
+func (k *Keeper) DeleteHostChainRecordsBatch(ctx sdk.Context, chainID string, limit uint64) bool {
+	remaining := limit
+	for _, records := range k.hostChainRecordStores(ctx, chainID) {
+		if remaining == 0 {
+			telemetry.IncrCounter(float32(1), chainID, "deregistration", "budget_exhausted")
+			return false
+		}
+
+		visited, done := k.deleteHostChainRecords(ctx, chainID, records, remaining)
+		if !done {
+			return false
+		}
+		remaining -= visited
+	}
+
+	// the single entry records go last, the validator set candidates are already gone, together with the cursors of
+	// the deletion passes and of the other workflows
+	k.DeleteValidatorSetProposal(ctx, chainID)
+	k.DeleteValidatorSetRound(ctx, chainID)
+	k.deletePrefixedRecords(ctx, types.WorkflowCursorKey, types.GetWorkflowCursorChainPrefix(chainID))
+
+	return true
+}
+
+// deleteHostChainRecords deletes the next batch of up to limit entries of a host chain records store. It returns the
+// number of entries visited and whether the pass over the store is over.
+func (k *Keeper) deleteHostChainRecords(
+	ctx sdk.Context,
+	chainID string,
+	records hostChainRecords,
+	limit uint64,
+) (uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), records.storePrefix)
+	end := sdk.PrefixEndBytes(records.keyPrefix)
+
+	// a finished pass leaves its cursor at the end of the prefix, so the entries of other chain ids sharing the
+	// prefix are not visited again
+	if cursor, found := k.GetWorkflowCursor(ctx, chainID, records.workflow); found && bytes.Equal(cursor, end) {
+		return 0, true
+	}
+
+	batch, done := k.nextWorkflowBatchWithLimit(ctx, chainID, records.workflow, store, records.keyPrefix, limit)
+	for _, pair := range batch.Pairs {
+		if records.deleteRecord != nil {
+			records.deleteRecord(pair.Value)
+		} else {
+			store.Delete(pair.Key)
+		}
+	}
+
+	if done {
+		k.SetWorkflowCursor(ctx, chainID, records.workflow, end)
+	}
+
+	return uint64(len(batch.Pairs)), done
+}
+
+// deletePrefixedRecords removes all the entries of a store under a key prefix, it is meant for the prefixes holding
+// a bounded number of entries
+func (k *Keeper) deletePrefixedRecords(ctx sdk.Context, storePrefix []byte, keyPrefix []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k *Keeper) emitDeregistrationEvent(ctx sdk.Context, hc *types.HostChain) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHostChainDeregistration,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeState, hc.Deregistration.State.String()),
+			sdk.NewAttribute(types.AttributeCValue, hc.CValue.String()),
+		),
+	)
+}
+
+// icaBalanceEmpty returns whether an ICA balance has been reported as empty, an account which balance has never been
+// queried is not considered empty
+func icaBalanceEmpty(balance sdk.Coin) bool {
+	return !balance.Amount.IsNil() && balance.IsZero()
+}
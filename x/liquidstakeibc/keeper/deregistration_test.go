@@ -0,0 +1,398 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestDoDeregisterHostChainUnbonding() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	suite.Require().NoError(k.StartHostChainDeregistration(ctx, hc))
+	suite.Require().ErrorIs(k.StartHostChainDeregistration(ctx, hc), types.ErrHostChainDeregistering)
+
+	for _, deposit := range k.GetDepositsForHostChain(ctx, hc.ChainId) {
+		k.DeleteDeposit(ctx, deposit)
+	}
+
+	chainValidatorUnbondings := func() []*types.ValidatorUnbonding {
+		return k.FilterValidatorUnbondings(
+			ctx,
+			func(u types.ValidatorUnbonding) bool { return u.ChainId == hc.ChainId },
+		)
+	}
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	validator := hc.Validators[0]
+	validator.DelegatedAmount = sdk.NewInt(1000)
+	k.SetHostChainValidator(ctx, hc, validator)
+
+	// a deposit in flight holds the undelegations back
+	deposit := &types.Deposit{
+		ChainId:       hc.ChainId,
+		Amount:        sdk.NewCoin(hc.IBCDenom(), sdk.NewInt(100)),
+		Epoch:         1,
+		State:         types.Deposit_DEPOSIT_SENT,
+		IbcSequenceId: "channel-0-sequence-1",
+	}
+	k.SetDeposit(ctx, deposit)
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	k.DoDeregisterHostChain(ctx, hc)
+	suite.Require().Empty(chainValidatorUnbondings())
+
+	// every delegated validator is undelegated
+	k.DeleteDeposit(ctx, deposit)
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	k.DoDeregisterHostChain(ctx, hc)
+
+	validatorUnbondings := chainValidatorUnbondings()
+	suite.Require().Len(validatorUnbondings, 1)
+	suite.Require().Equal(validator.OperatorAddress, validatorUnbondings[0].ValidatorAddress)
+	suite.Require().Equal(sdk.NewInt(1000), validatorUnbondings[0].Amount.Amount)
+	suite.Require().NotEmpty(validatorUnbondings[0].IbcSequenceId)
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(types.Deregistration_DEREGISTRATION_UNBONDING, hc.Deregistration.State)
+
+	// once the undelegated tokens are back, the residual ICA balances are queried
+	validator.DelegatedAmount = sdk.ZeroInt()
+	k.SetHostChainValidator(ctx, hc, validator)
+	k.DeleteValidatorUnbonding(ctx, validatorUnbondings[0])
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	k.DoDeregisterHostChain(ctx, hc)
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(types.Deregistration_DEREGISTRATION_RETURNING, hc.Deregistration.State)
+	suite.Require().Equal(ctx.BlockHeight(), hc.Deregistration.LastSweepHeight)
+	suite.Require().Equal(uint32(2), hc.Deregistration.PendingBalanceQueries)
+}
+
+func (suite *IntegrationTestSuite) TestDoDeregisterHostChainReturning() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	hc.Active = false
+	hc.Deregistration = &types.Deregistration{
+		State:           types.Deregistration_DEREGISTRATION_RETURNING,
+		LastSweepHeight: ctx.BlockHeight(),
+	}
+	hc.RewardsAccount.Balance = sdk.NewCoin(hc.HostDenom, sdk.NewInt(50))
+	k.SetHostChain(ctx, hc)
+
+	// nothing happens until the transfer window has passed
+	k.DoDeregisterHostChain(ctx, hc)
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(uint32(0), hc.Deregistration.PendingBalanceQueries)
+
+	// a non-empty balance is queried again
+	hc.Deregistration.LastSweepHeight = ctx.BlockHeight() - int64(types.IBCTimeoutHeightIncrement) - 1
+	k.SetHostChain(ctx, hc)
+	k.DoDeregisterHostChain(ctx, hc)
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(types.Deregistration_DEREGISTRATION_RETURNING, hc.Deregistration.State)
+	suite.Require().Equal(ctx.BlockHeight(), hc.Deregistration.LastSweepHeight)
+	suite.Require().Equal(uint32(2), hc.Deregistration.PendingBalanceQueries)
+
+	// the queried balance is transferred back
+	suite.Require().NoError(k.ReturnDeregistrationBalance(ctx, hc, hc.RewardsAccount))
+	suite.Require().Equal(uint32(1), hc.Deregistration.PendingBalanceQueries)
+}
+
+func (suite *IntegrationTestSuite) TestDoDeregisterHostChainRedeeming() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	for _, deposit := range k.GetDepositsForHostChain(ctx, hc.ChainId) {
+		k.DeleteDeposit(ctx, deposit)
+	}
+
+	// the tokens returned from the host chain
+	k.SetDeposit(ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewCoin(hc.IBCDenom(), sdk.NewInt(2001)),
+		Epoch:   1,
+		State:   types.Deposit_DEPOSIT_PENDING,
+	})
+	suite.Require().NoError(testutil.FundModuleAccount(
+		suite.app.BankKeeper,
+		ctx,
+		types.DepositModuleAccount,
+		sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 2001)),
+	))
+
+	// two holders and an unstaker that hasn't been undelegated yet
+	holders := []sdk.AccAddress{authtypes.NewModuleAddress("holder-a"), authtypes.NewModuleAddress("holder-b")}
+	for i, amount := range []int64{600, 300} {
+		suite.Require().NoError(testutil.FundAccount(
+			suite.app.BankKeeper,
+			ctx,
+			holders[i],
+			sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), amount)),
+		))
+	}
+	unstaker := authtypes.NewModuleAddress("unstaker")
+	stkAmount := sdk.NewInt64Coin(hc.MintDenom(), 100)
+	suite.Require().NoError(testutil.FundModuleAccount(
+		suite.app.BankKeeper,
+		ctx,
+		types.UndelegationModuleAccount,
+		sdk.NewCoins(stkAmount),
+	))
+	k.IncreaseUserUnbondingAmountForEpoch(ctx, hc.ChainId, unstaker.String(), 100, stkAmount, sdk.NewInt64Coin(hc.HostDenom, 95))
+	k.IncreaseUndelegatingAmountForEpoch(ctx, hc.ChainId, 100, stkAmount, sdk.NewInt64Coin(hc.HostDenom, 95))
+	suite.Require().Equal(sdk.NewInt(1000), suite.app.BankKeeper.GetSupply(ctx, hc.MintDenom()).Amount)
+
+	feeAddress := sdk.MustAccAddressFromBech32(k.GetParams(ctx).FeeAddress)
+	feeBalance := suite.app.BankKeeper.GetBalance(ctx, feeAddress, hc.IBCDenom())
+
+	hc.Active = false
+	hc.Deregistration = &types.Deregistration{
+		State:           types.Deregistration_DEREGISTRATION_RETURNING,
+		LastSweepHeight: ctx.BlockHeight() - int64(types.IBCTimeoutHeightIncrement) - 1,
+	}
+	k.SetHostChain(ctx, hc)
+
+	// the holders can't redeem before the final c value is known
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err := msgServer.Redeem(sdk.WrapSDKContext(ctx), &types.MsgRedeem{
+		DelegatorAddress: holders[0].String(),
+		Amount:           sdk.NewInt64Coin(hc.MintDenom(), 600),
+	})
+	suite.Require().ErrorIs(err, types.ErrHostChainDeregistering)
+
+	// the pending unbondings are settled at the final c value
+	k.DoDeregisterHostChain(ctx, hc)
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(types.Deregistration_DEREGISTRATION_REDEEMING, hc.Deregistration.State)
+	suite.Require().Equal(k.GetEpochNumber(ctx, types.DelegationEpoch), hc.Deregistration.RedeemingEpoch)
+	suite.Require().Equal(sdk.NewDec(1000).QuoInt64(2001), hc.CValue)
+	suite.Require().Equal(sdk.NewInt(900), suite.app.BankKeeper.GetSupply(ctx, hc.MintDenom()).Amount)
+	suite.Require().Empty(k.GetDepositsForHostChain(ctx, hc.ChainId))
+	suite.Require().Equal(
+		sdk.NewInt(1801),
+		suite.app.BankKeeper.GetBalance(ctx, k.GetDepositModuleAccount(ctx).GetAddress(), hc.IBCDenom()).Amount,
+	)
+
+	record, found := k.GetRedemptionRecord(ctx, hc.MintDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(&types.RedemptionRecord{
+		ChainId:   hc.ChainId,
+		MintDenom: hc.MintDenom(),
+		IbcDenom:  hc.IBCDenom(),
+		CValue:    hc.CValue,
+		Amount:    sdk.NewInt(1801),
+		StkAmount: sdk.NewInt(900),
+	}, record)
+
+	for _, unbonding := range k.FilterUnbondings(ctx, func(u types.Unbonding) bool { return u.ChainId == hc.ChainId }) {
+		suite.Require().Equal(types.Unbonding_UNBONDING_CLAIMABLE, unbonding.State)
+	}
+
+	// the unbonding is claimed, the host chain doesn't wait for the holders to redeem
+	k.DoDeregisterHostChain(ctx, hc)
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(types.Deregistration_DEREGISTRATION_DELETING, hc.Deregistration.State)
+	suite.Require().Equal(sdk.NewInt(200), suite.app.BankKeeper.GetBalance(ctx, unstaker, hc.IBCDenom()).Amount)
+	suite.Require().Empty(k.FilterUnbondings(ctx, func(u types.Unbonding) bool { return u.ChainId == hc.ChainId }))
+	suite.Require().Equal(feeBalance, suite.app.BankKeeper.GetBalance(ctx, feeAddress, hc.IBCDenom()))
+
+	// the holders redeem at the final c value, without any fee
+	_, err = msgServer.Redeem(sdk.WrapSDKContext(ctx), &types.MsgRedeem{
+		DelegatorAddress: holders[0].String(),
+		Amount:           sdk.NewInt64Coin(hc.MintDenom(), 600),
+	})
+	suite.Require().NoError(err)
+
+	// the records are deleted and the host chain last
+	k.DoDeregisterHostChain(ctx, hc)
+
+	_, found = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().False(found)
+	suite.Require().Empty(k.FilterUserUnbondings(ctx, func(u types.UserUnbonding) bool { return u.ChainId == hc.ChainId }))
+
+	// the redemption record outlives the host chain
+	simulated, err := k.SimulateRedeem(sdk.WrapSDKContext(ctx), &types.QuerySimulateRedeemRequest{
+		Amount: sdk.NewInt64Coin(hc.MintDenom(), 300),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.IBCDenom(), 601), simulated.RedeemAmount)
+	suite.Require().Empty(simulated.Error)
+
+	_, err = msgServer.Redeem(sdk.WrapSDKContext(ctx), &types.MsgRedeem{
+		DelegatorAddress: holders[1].String(),
+		Amount:           sdk.NewInt64Coin(hc.MintDenom(), 300),
+	})
+	suite.Require().NoError(err)
+
+	for i, amount := range []int64{1200, 601} {
+		suite.Require().Equal(
+			sdk.NewInt(amount),
+			suite.app.BankKeeper.GetBalance(ctx, holders[i], hc.IBCDenom()).Amount,
+		)
+	}
+	suite.Require().True(suite.app.BankKeeper.GetSupply(ctx, hc.MintDenom()).IsZero())
+	suite.Require().True(
+		suite.app.BankKeeper.GetBalance(ctx, k.GetDepositModuleAccount(ctx).GetAddress(), hc.IBCDenom()).IsZero(),
+	)
+
+	// every stk token is redeemed
+	_, found = k.GetRedemptionRecord(ctx, hc.MintDenom())
+	suite.Require().False(found)
+}
+
+func (suite *IntegrationTestSuite) TestDoDeregisterHostChainRedeemingGracePeriod() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	epoch := k.GetEpochNumber(ctx, types.DelegationEpoch)
+	hc.Active = false
+	hc.Deregistration = &types.Deregistration{
+		State:          types.Deregistration_DEREGISTRATION_REDEEMING,
+		RedeemingEpoch: epoch,
+	}
+	k.SetHostChain(ctx, hc)
+
+	// an unbonding that can't be claimed, and the tokens owed to the stk holders with some rounding dust
+	k.SetUnbonding(ctx, &types.Unbonding{
+		ChainId:      hc.ChainId,
+		EpochNumber:  epoch,
+		BurnAmount:   sdk.NewInt64Coin(hc.MintDenom(), 50),
+		UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 50),
+		State:        types.Unbonding_UNBONDING_CLAIMABLE,
+	})
+	k.SetUserUnbonding(ctx, &types.UserUnbonding{
+		ChainId:      hc.ChainId,
+		EpochNumber:  epoch,
+		Address:      "invalid",
+		StkAmount:    sdk.NewInt64Coin(hc.MintDenom(), 50),
+		UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 50),
+	})
+	suite.Require().NoError(testutil.FundModuleAccount(
+		suite.app.BankKeeper,
+		ctx,
+		types.UndelegationModuleAccount,
+		sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 50)),
+	))
+	suite.Require().NoError(testutil.FundModuleAccount(
+		suite.app.BankKeeper,
+		ctx,
+		types.DepositModuleAccount,
+		sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 110)),
+	))
+	record := &types.RedemptionRecord{
+		ChainId:   hc.ChainId,
+		MintDenom: hc.MintDenom(),
+		IbcDenom:  hc.IBCDenom(),
+		CValue:    sdk.OneDec(),
+		Amount:    sdk.NewInt(100),
+		StkAmount: sdk.NewInt(100),
+	}
+	k.SetRedemptionRecord(ctx, record)
+
+	feeAddress := sdk.MustAccAddressFromBech32(k.GetParams(ctx).FeeAddress)
+	feeBalance := suite.app.BankKeeper.GetBalance(ctx, feeAddress, hc.IBCDenom())
+
+	// the host chain waits for the unbonding to be claimed during the grace period
+	k.DoDeregisterHostChain(ctx, hc)
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(types.Deregistration_DEREGISTRATION_REDEEMING, hc.Deregistration.State)
+
+	// once it ends, the unclaimed tokens and the dust go to the fee address and the host chain is deleted
+	hc.Deregistration.RedeemingEpoch = epoch - types.DeregistrationGraceEpochs
+	k.SetHostChain(ctx, hc)
+	k.DoDeregisterHostChain(ctx, hc)
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(types.Deregistration_DEREGISTRATION_DELETING, hc.Deregistration.State)
+	suite.Require().Equal(
+		feeBalance.AddAmount(sdk.NewInt(60)),
+		suite.app.BankKeeper.GetBalance(ctx, feeAddress, hc.IBCDenom()),
+	)
+	suite.Require().Equal(
+		sdk.NewInt(100),
+		suite.app.BankKeeper.GetBalance(ctx, k.GetDepositModuleAccount(ctx).GetAddress(), hc.IBCDenom()).Amount,
+	)
+
+	k.DoDeregisterHostChain(ctx, hc)
+
+	_, found = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().False(found)
+	stored, found := k.GetRedemptionRecord(ctx, hc.MintDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(record, stored)
+}
+
+func (suite *IntegrationTestSuite) TestDeleteHostChainRecordsBatch() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	for _, deposit := range k.GetDepositsForHostChain(ctx, hc.ChainId) {
+		k.DeleteDeposit(ctx, deposit)
+	}
+
+	// three deposits and two c value records of the host chain, and a deposit of a chain id sharing its prefix
+	for epoch := int64(1); epoch <= 3; epoch++ {
+		k.SetDeposit(ctx, &types.Deposit{
+			ChainId: hc.ChainId,
+			Amount:  sdk.NewInt64Coin(hc.IBCDenom(), 100),
+			Epoch:   epoch,
+			State:   types.Deposit_DEPOSIT_PENDING,
+		})
+	}
+	for epoch := int64(1); epoch <= 2; epoch++ {
+		k.SetCValueRecord(ctx, &types.CValueRecord{ChainId: hc.ChainId, Epoch: epoch, CValue: sdk.OneDec()})
+	}
+	otherChain := &types.Deposit{
+		ChainId: hc.ChainId + "0",
+		Amount:  sdk.NewInt64Coin(hc.IBCDenom(), 100),
+		Epoch:   1,
+		State:   types.Deposit_DEPOSIT_PENDING,
+	}
+	k.SetDeposit(ctx, otherChain)
+
+	// the deposits take two blocks, as the other chain deposit is visited too, then the c value records use up a
+	// whole block
+	suite.Require().False(k.DeleteHostChainRecordsBatch(ctx, hc.ChainId, 2))
+	suite.Require().Len(k.GetDepositsForHostChain(ctx, hc.ChainId), 2)
+
+	suite.Require().False(k.DeleteHostChainRecordsBatch(ctx, hc.ChainId, 2))
+	suite.Require().Empty(k.GetDepositsForHostChain(ctx, hc.ChainId))
+	suite.Require().Len(k.GetCValueRecords(ctx, hc.ChainId), 2)
+
+	suite.Require().False(k.DeleteHostChainRecordsBatch(ctx, hc.ChainId, 2))
+	suite.Require().Empty(k.GetCValueRecords(ctx, hc.ChainId))
+
+	suite.Require().True(k.DeleteHostChainRecordsBatch(ctx, hc.ChainId, 2))
+	_, found = k.GetWorkflowCursor(ctx, hc.ChainId, "deregistration_deposits")
+	suite.Require().False(found)
+
+	_, found = k.GetDepositForChainAndEpoch(ctx, otherChain.ChainId, otherChain.Epoch)
+	suite.Require().True(found)
+}
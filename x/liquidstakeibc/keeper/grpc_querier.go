@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -72,7 +74,44 @@ func (k *Keeper) Deposits(
 		return nil, sdkerrors.ErrKeyNotFound
 	}
 
-	return &types.QueryDepositsResponse{Deposits: k.GetDepositsForHostChain(ctx, hc.ChainId)}, nil
+	if err := validateEpochRange(request.StartEpoch, request.EndEpoch); err != nil {
+		return nil, err
+	}
+
+	state, filterState := types.Deposit_DepositState_value[request.State]
+	if request.State != "" && !filterState {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid deposit state %s", request.State)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.DepositKey, []byte(hc.ChainId)...))
+
+	deposits := make([]*types.Deposit, 0)
+	pageRes, err := query.FilteredPaginate(
+		store,
+		request.Pagination,
+		func(key []byte, value []byte, accumulate bool) (bool, error) {
+			var deposit types.Deposit
+			if err := k.cdc.Unmarshal(value, &deposit); err != nil {
+				return false, err
+			}
+
+			if deposit.ChainId != hc.ChainId ||
+				(filterState && deposit.State != types.Deposit_DepositState(state)) ||
+				!epochInRange(deposit.Epoch, request.StartEpoch, request.EndEpoch) {
+				return false, nil
+			}
+
+			if accumulate {
+				deposits = append(deposits, &deposit)
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDepositsResponse{Deposits: deposits, Pagination: pageRes}, nil
 }
 
 func (k *Keeper) LSMDeposits(
@@ -90,14 +129,39 @@ func (k *Keeper) LSMDeposits(
 		return nil, sdkerrors.ErrKeyNotFound
 	}
 
-	deposits := k.FilterLSMDeposits(
-		ctx,
-		func(d types.LSMDeposit) bool {
-			return d.ChainId == hc.ChainId
+	state, filterState := types.LSMDeposit_LSMDepositState_value[request.State]
+	if request.State != "" && !filterState {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid lsm deposit state %s", request.State)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.LSMDepositKey, []byte(hc.ChainId)...))
+
+	deposits := make([]*types.LSMDeposit, 0)
+	pageRes, err := query.FilteredPaginate(
+		store,
+		request.Pagination,
+		func(key []byte, value []byte, accumulate bool) (bool, error) {
+			var deposit types.LSMDeposit
+			if err := k.cdc.Unmarshal(value, &deposit); err != nil {
+				return false, err
+			}
+
+			if deposit.ChainId != hc.ChainId ||
+				(filterState && deposit.State != types.LSMDeposit_LSMDepositState(state)) {
+				return false, nil
+			}
+
+			if accumulate {
+				deposits = append(deposits, &deposit)
+			}
+			return true, nil
 		},
 	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &types.QueryLSMDepositsResponse{Deposits: deposits}, nil
+	return &types.QueryLSMDepositsResponse{Deposits: deposits, Pagination: pageRes}, nil
 }
 
 func (k *Keeper) Unbondings(
@@ -111,16 +175,46 @@ func (k *Keeper) Unbondings(
 		return nil, status.Error(codes.InvalidArgument, "chain_id cannot be empty")
 	}
 
+	if err := validateEpochRange(request.StartEpoch, request.EndEpoch); err != nil {
+		return nil, err
+	}
+
+	state, filterState := types.Unbonding_UnbondingState_value[request.State]
+	if request.State != "" && !filterState {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid unbonding state %s", request.State)
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	unbondings := k.FilterUnbondings(
-		ctx,
-		func(u types.Unbonding) bool {
-			return u.ChainId == request.ChainId
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.UnbondingKey, []byte(request.ChainId)...))
+
+	unbondings := make([]*types.Unbonding, 0)
+	pageRes, err := query.FilteredPaginate(
+		store,
+		request.Pagination,
+		func(key []byte, value []byte, accumulate bool) (bool, error) {
+			var unbonding types.Unbonding
+			if err := k.cdc.Unmarshal(value, &unbonding); err != nil {
+				return false, err
+			}
+
+			if unbonding.ChainId != request.ChainId ||
+				(filterState && unbonding.State != types.Unbonding_UnbondingState(state)) ||
+				!epochInRange(unbonding.EpochNumber, request.StartEpoch, request.EndEpoch) {
+				return false, nil
+			}
+
+			if accumulate {
+				unbondings = append(unbondings, &unbonding)
+			}
+			return true, nil
 		},
 	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &types.QueryUnbondingsResponse{Unbondings: unbondings}, nil
+	return &types.QueryUnbondingsResponse{Unbondings: unbondings, Pagination: pageRes}, nil
 }
 
 func (k *Keeper) Unbonding(
@@ -168,14 +262,39 @@ func (k *Keeper) UserUnbondings(
 		return nil, sdkerrors.ErrKeyNotFound
 	}
 
-	userUnbondings := k.FilterUserUnbondings(
-		ctx,
-		func(u types.UserUnbonding) bool {
-			return u.Address == address.String()
+	if err := validateEpochRange(request.StartEpoch, request.EndEpoch); err != nil {
+		return nil, err
+	}
+
+	// user unbondings are keyed by chain id first, so the whole store needs to be filtered
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserUnbondingKey)
+
+	userUnbondings := make([]*types.UserUnbonding, 0)
+	pageRes, err := query.FilteredPaginate(
+		store,
+		request.Pagination,
+		func(key []byte, value []byte, accumulate bool) (bool, error) {
+			var userUnbonding types.UserUnbonding
+			if err := k.cdc.Unmarshal(value, &userUnbonding); err != nil {
+				return false, err
+			}
+
+			if userUnbonding.Address != address.String() ||
+				!epochInRange(userUnbonding.EpochNumber, request.StartEpoch, request.EndEpoch) {
+				return false, nil
+			}
+
+			if accumulate {
+				userUnbondings = append(userUnbondings, &userUnbonding)
+			}
+			return true, nil
 		},
 	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &types.QueryUserUnbondingsResponse{UserUnbondings: userUnbondings}, nil
+	return &types.QueryUserUnbondingsResponse{UserUnbondings: userUnbondings, Pagination: pageRes}, nil
 }
 
 func (k *Keeper) ValidatorUnbondings(
@@ -189,14 +308,43 @@ func (k *Keeper) ValidatorUnbondings(
 		return nil, status.Error(codes.InvalidArgument, "chain_id cannot be empty")
 	}
 
+	if err := validateEpochRange(request.StartEpoch, request.EndEpoch); err != nil {
+		return nil, err
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	validatorUnbondings := k.FilterValidatorUnbondings(
-		ctx,
-		func(u types.ValidatorUnbonding) bool { return u.ChainId == request.ChainId },
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.ValidatorUnbondingKey, []byte(request.ChainId)...))
+
+	validatorUnbondings := make([]*types.ValidatorUnbonding, 0)
+	pageRes, err := query.FilteredPaginate(
+		store,
+		request.Pagination,
+		func(key []byte, value []byte, accumulate bool) (bool, error) {
+			var validatorUnbonding types.ValidatorUnbonding
+			if err := k.cdc.Unmarshal(value, &validatorUnbonding); err != nil {
+				return false, err
+			}
+
+			if validatorUnbonding.ChainId != request.ChainId ||
+				!epochInRange(validatorUnbonding.EpochNumber, request.StartEpoch, request.EndEpoch) {
+				return false, nil
+			}
+
+			if accumulate {
+				validatorUnbondings = append(validatorUnbondings, &validatorUnbonding)
+			}
+			return true, nil
+		},
 	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &types.QueryValidatorUnbondingResponse{ValidatorUnbondings: validatorUnbondings}, nil
+	return &types.QueryValidatorUnbondingResponse{
+		ValidatorUnbondings: validatorUnbondings,
+		Pagination:          pageRes,
+	}, nil
 }
 
 func (k *Keeper) DepositAccountBalance(
@@ -238,3 +386,567 @@ func (k *Keeper) ExchangeRate(
 
 	return &types.QueryExchangeRateResponse{Rate: hc.CValue}, nil
 }
+
+func (k *Keeper) LiquidStakeCapacity(
+	goCtx context.Context,
+	request *types.QueryLiquidStakeCapacityRequest,
+) (*types.QueryLiquidStakeCapacityResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if request.Address != "" {
+		if _, err := sdk.AccAddressFromBech32(request.Address); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address %s", request.Address)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return k.GetLiquidStakeCapacity(ctx, hc, request.Address), nil
+}
+
+func (k *Keeper) OutflowQuota(
+	goCtx context.Context,
+	request *types.QueryOutflowQuotaRequest,
+) (*types.QueryOutflowQuotaResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return k.GetOutflowQuota(ctx, hc), nil
+}
+
+func (k *Keeper) SlashRecords(
+	goCtx context.Context,
+	request *types.QuerySlashRecordsRequest,
+) (*types.QuerySlashRecordsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "chain_id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storePrefix := types.GetSlashRecordChainPrefix(request.ChainId)
+	if request.ValidatorAddress != "" {
+		storePrefix = types.GetSlashRecordValidatorPrefix(request.ChainId, request.ValidatorAddress)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.SlashRecordKey, storePrefix...))
+
+	slashRecords := make([]*types.SlashRecord, 0)
+	pageRes, err := query.Paginate(
+		store,
+		request.Pagination,
+		func(key []byte, value []byte) error {
+			var slashRecord types.SlashRecord
+			if err := k.cdc.Unmarshal(value, &slashRecord); err != nil {
+				return err
+			}
+
+			slashRecords = append(slashRecords, &slashRecord)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashRecordsResponse{SlashRecords: slashRecords, Pagination: pageRes}, nil
+}
+
+func (k *Keeper) ValidatorSetProposal(
+	goCtx context.Context,
+	request *types.QueryValidatorSetProposalRequest,
+) (*types.QueryValidatorSetProposalResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposal, found := k.GetValidatorSetProposal(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryValidatorSetProposalResponse{Proposal: *proposal}, nil
+}
+
+// validateEpochRange checks that the epoch range filter of a query is well-formed
+func validateEpochRange(startEpoch, endEpoch int64) error {
+	if startEpoch < 0 || endEpoch < 0 {
+		return status.Error(codes.InvalidArgument, "epochs cannot be negative")
+	}
+	if endEpoch != 0 && endEpoch < startEpoch {
+		return status.Error(codes.InvalidArgument, "end_epoch cannot be lower than start_epoch")
+	}
+	return nil
+}
+
+// epochInRange returns if the epoch is inside the query epoch range, an end epoch of 0 means no upper bound
+func epochInRange(epoch, startEpoch, endEpoch int64) bool {
+	return epoch >= startEpoch && (endEpoch == 0 || epoch <= endEpoch)
+}
+
+func (k *Keeper) HostChainVotes(
+	goCtx context.Context,
+	request *types.QueryHostChainVotesRequest,
+) (*types.QueryHostChainVotesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "chain_id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.HostChainVoteKey, types.GetHostChainVoteChainPrefix(request.ChainId)...),
+	)
+
+	votes := make([]*types.HostChainVote, 0)
+	pageRes, err := query.Paginate(
+		store,
+		request.Pagination,
+		func(key []byte, value []byte) error {
+			var vote types.HostChainVote
+			if err := k.cdc.Unmarshal(value, &vote); err != nil {
+				return err
+			}
+
+			votes = append(votes, &vote)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHostChainVotesResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
+func (k *Keeper) VoteSignaling(
+	goCtx context.Context,
+	request *types.QueryVoteSignalingRequest,
+) (*types.QueryVoteSignalingResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "chain_id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	signaling, found := k.GetVoteSignaling(ctx, hc.ChainId, request.ProposalId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.VoteSignalKey, types.GetVoteSignalPrefix(hc.ChainId, request.ProposalId)...),
+	)
+
+	signals := make([]*types.VoteSignal, 0)
+	pageRes, err := query.Paginate(
+		store,
+		request.Pagination,
+		func(key []byte, value []byte) error {
+			var signal types.VoteSignal
+			if err := k.cdc.Unmarshal(value, &signal); err != nil {
+				return err
+			}
+
+			signals = append(signals, &signal)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	totalPower := signaling.TotalPower
+	if totalPower.IsNil() {
+		totalPower = sdk.ZeroInt()
+	}
+
+	return &types.QueryVoteSignalingResponse{
+		Signaling:    *signaling,
+		CurrentTally: signaling.CurrentTally(),
+		TotalPower:   totalPower,
+		Signals:      signals,
+		Pagination:   pageRes,
+	}, nil
+}
+
+func (k *Keeper) TimelockedUpdates(
+	goCtx context.Context,
+	request *types.QueryTimelockedUpdatesRequest,
+) (*types.QueryTimelockedUpdatesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "chain_id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.TimelockedUpdateKey, types.GetTimelockedUpdateChainPrefix(hc.ChainId)...),
+	)
+
+	updates := make([]*types.TimelockedUpdate, 0)
+	pageRes, err := query.Paginate(
+		store,
+		request.Pagination,
+		func(key []byte, value []byte) error {
+			var update types.TimelockedUpdate
+			if err := k.cdc.Unmarshal(value, &update); err != nil {
+				return err
+			}
+
+			updates = append(updates, &update)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	timelockEpochsUpdate, found := k.GetTimelockEpochsUpdate(ctx)
+	if !found {
+		timelockEpochsUpdate = nil
+	}
+
+	return &types.QueryTimelockedUpdatesResponse{
+		Updates:              updates,
+		Pagination:           pageRes,
+		TimelockEpochsUpdate: timelockEpochsUpdate,
+	}, nil
+}
+
+func (k *Keeper) CValueHistory(
+	goCtx context.Context,
+	request *types.QueryCValueHistoryRequest,
+) (*types.QueryCValueHistoryResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "chain_id cannot be empty")
+	}
+	if err := validateEpochRange(request.StartEpoch, request.EndEpoch); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.CValueRecordKey, types.GetCValueRecordChainPrefix(request.ChainId)...),
+	)
+
+	records := make([]*types.CValueRecord, 0)
+	pageRes, err := query.FilteredPaginate(
+		store,
+		request.Pagination,
+		func(key []byte, value []byte, accumulate bool) (bool, error) {
+			var record types.CValueRecord
+			if err := k.cdc.Unmarshal(value, &record); err != nil {
+				return false, err
+			}
+
+			if !epochInRange(record.Epoch, request.StartEpoch, request.EndEpoch) {
+				return false, nil
+			}
+
+			if accumulate {
+				records = append(records, &record)
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCValueHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+func (k *Keeper) RewardRate(
+	goCtx context.Context,
+	request *types.QueryRewardRateRequest,
+) (*types.QueryRewardRateResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "chain_id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryRewardRateResponse{
+		RewardRates: []types.RewardRateWindow{
+			k.GetRewardRate(ctx, hc, types.RewardRateShortWindow),
+			k.GetRewardRate(ctx, hc, types.RewardRateLongWindow),
+		},
+	}, nil
+}
+
+func (k *Keeper) SimulateLiquidStake(
+	goCtx context.Context,
+	request *types.QuerySimulateLiquidStakeRequest,
+) (*types.QuerySimulateLiquidStakeResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.DelegatorAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(request.DelegatorAddress); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address: %s", err)
+		}
+	}
+	if err := request.Amount.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChainFromIbcDenom(ctx, request.Amount.Denom)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	mintToken, protocolFee := liquidStakeAmounts(hc, request.Amount.Amount)
+	response := &types.QuerySimulateLiquidStakeResponse{
+		MintAmount: mintToken.Sub(protocolFee),
+		Fee:        protocolFee,
+	}
+
+	if err := k.validateLiquidStake(ctx, hc, request.DelegatorAddress, request.Amount.Amount); err != nil {
+		response.Error = err.Error()
+	}
+
+	return response, nil
+}
+
+func (k *Keeper) SimulateLiquidStakeLSM(
+	goCtx context.Context,
+	request *types.QuerySimulateLiquidStakeLSMRequest,
+) (*types.QuerySimulateLiquidStakeLSMResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	delegator, err := sdk.AccAddressFromBech32(request.DelegatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address: %s", err)
+	}
+	if err = request.Delegation.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegation: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the host chain and validator are resolved from the LSM denom, so failures there are simulation results
+	hc, validator, denomTrace, err := k.validateLiquidStakeLSMDeposit(ctx, delegator, request.Delegation)
+	if err != nil {
+		return &types.QuerySimulateLiquidStakeLSMResponse{Error: err.Error()}, nil
+	}
+
+	deposit := newLSMDeposit(hc, validator, denomTrace, request.DelegatorAddress, request.Delegation)
+	mintToken, protocolFee := liquidStakeAmounts(hc, deposit.Amount)
+	response := &types.QuerySimulateLiquidStakeLSMResponse{
+		Amount:     sdk.NewCoin(hc.HostDenom, deposit.Amount),
+		MintAmount: mintToken.Sub(protocolFee),
+		Fee:        protocolFee,
+	}
+
+	if err = k.validateLSMDeposit(ctx, hc, deposit); err != nil {
+		response.Error = err.Error()
+	}
+
+	return response, nil
+}
+
+func (k *Keeper) SimulateLiquidUnstake(
+	goCtx context.Context,
+	request *types.QuerySimulateLiquidUnstakeRequest,
+) (*types.QuerySimulateLiquidUnstakeResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := request.Amount.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.getHostChainFromMintDenom(ctx, request.Amount.Denom)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	fee, _, unbondAmount := liquidUnstakeAmounts(hc, request.Amount)
+	unbondingEpoch := types.CurrentUnbondingEpoch(
+		hc.UnbondingFactor,
+		k.epochsKeeper.GetEpochInfo(ctx, types.UndelegationEpoch).CurrentEpoch,
+	)
+	undelegationTime, matureTime := k.EstimateUnbondingTimes(ctx, hc, unbondingEpoch)
+
+	response := &types.QuerySimulateLiquidUnstakeResponse{
+		Fee:              fee,
+		UnbondAmount:     unbondAmount,
+		UnbondingEpoch:   unbondingEpoch,
+		UndelegationTime: undelegationTime,
+		MatureTime:       matureTime,
+	}
+
+	err := k.validateLiquidUnstake(hc, request.Amount)
+	if err == nil {
+		err = k.validateUnbondAmount(ctx, hc, unbondingEpoch, unbondAmount.Amount)
+	}
+	if err != nil {
+		response.Error = err.Error()
+	}
+
+	return response, nil
+}
+
+func (k *Keeper) SimulateRedeem(
+	goCtx context.Context,
+	request *types.QuerySimulateRedeemRequest,
+) (*types.QuerySimulateRedeemResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := request.Amount.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the stk tokens of a deregistered host chain are redeemed against its redemption record, without a fee
+	if record, found := k.GetRedemptionRecord(ctx, request.Amount.Denom); found {
+		response := &types.QuerySimulateRedeemResponse{
+			Fee:          sdk.NewCoin(record.MintDenom, sdk.ZeroInt()),
+			RedeemAmount: redemptionAmount(record, request.Amount),
+		}
+		if err := validateRedemption(record, request.Amount); err != nil {
+			response.Error = err.Error()
+		}
+
+		return response, nil
+	}
+
+	hc, found := k.getHostChainFromMintDenom(ctx, request.Amount.Denom)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	fee, _, redeemToken := redeemAmounts(hc, request.Amount)
+	response := &types.QuerySimulateRedeemResponse{
+		Fee:          fee,
+		RedeemAmount: redeemToken,
+	}
+
+	err := k.validateRedeem(hc, request.Amount)
+	if err == nil {
+		err = k.validateRedeemAmount(ctx, hc, redeemToken)
+	}
+	if err != nil {
+		response.Error = err.Error()
+	}
+
+	return response, nil
+}
+
+func (k *Keeper) UnbondingEstimates(
+	goCtx context.Context,
+	request *types.QueryUnbondingEstimatesRequest,
+) (*types.QueryUnbondingEstimatesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "chain_id cannot be empty")
+	}
+	if request.Address == "" && request.Epoch <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "either address or epoch must be set")
+	}
+	if request.Address != "" {
+		if _, err := sdk.AccAddressFromBech32(request.Address); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	// without an address, only the module unbonding of the epoch is estimated
+	if request.Address == "" {
+		return &types.QueryUnbondingEstimatesResponse{
+			Estimates: []types.UnbondingEstimate{k.EstimateUnbonding(ctx, hc, request.Epoch)},
+		}, nil
+	}
+
+	userUnbondings := k.FilterUserUnbondings(
+		ctx,
+		func(u types.UserUnbonding) bool {
+			return u.ChainId == hc.ChainId && u.Address == request.Address &&
+				(request.Epoch <= 0 || u.EpochNumber == request.Epoch)
+		},
+	)
+
+	estimates := make([]types.UnbondingEstimate, 0, len(userUnbondings))
+	for _, userUnbonding := range userUnbondings {
+		estimate := k.EstimateUnbonding(ctx, hc, userUnbonding.EpochNumber)
+		estimate.UserUnbonding = userUnbonding
+		estimates = append(estimates, estimate)
+	}
+
+	return &types.QueryUnbondingEstimatesResponse{Estimates: estimates}, nil
+}
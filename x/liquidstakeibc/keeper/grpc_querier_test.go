@@ -4,7 +4,9 @@ import (
 	"strconv"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"google.golang.org/grpc/codes"
@@ -37,6 +39,10 @@ func (suite *IntegrationTestSuite) TestQueryParams() {
 					FeeAddress:       "persistence1gztc3y3k52hjds5nqvl7h9jvfnc33spz47zcjy",
 					UpperCValueLimit: decFromStr("1.1"),
 					LowerCValueLimit: decFromStr("0.85"),
+
+					UpdateTimelockEpochs: types.DefaultUpdateTimelockEpochs,
+					CValueHistoryLength:  types.DefaultCValueHistoryLength,
+					MaxRecordsPerBlock:   types.DefaultMaxRecordsPerBlock,
 				},
 			},
 		},
@@ -135,10 +141,17 @@ func (suite *IntegrationTestSuite) TestQueryDeposits() {
 		deposit := &types.Deposit{
 			ChainId: suite.chainB.ChainID,
 			Epoch:   int64(i),
+			State:   types.Deposit_DepositState(i % 2),
 		}
 		suite.app.LiquidStakeIBCKeeper.SetDeposit(suite.ctx, deposit)
 		deposits = append(deposits, deposit)
 	}
+	sentDeposits := make([]*types.Deposit, 0)
+	for _, deposit := range deposits {
+		if deposit.State == types.Deposit_DEPOSIT_SENT {
+			sentDeposits = append(sentDeposits, deposit)
+		}
+	}
 
 	tc := []struct {
 		name string
@@ -149,7 +162,47 @@ func (suite *IntegrationTestSuite) TestQueryDeposits() {
 		{
 			name: "Success",
 			req:  &types.QueryDepositsRequest{ChainId: suite.chainB.ChainID},
-			resp: &types.QueryDepositsResponse{Deposits: deposits},
+			resp: &types.QueryDepositsResponse{
+				Deposits:   deposits,
+				Pagination: &query.PageResponse{Total: uint64(MultipleTestSize)},
+			},
+		},
+		{
+			name: "Paginated",
+			req: &types.QueryDepositsRequest{
+				ChainId:    suite.chainB.ChainID,
+				Pagination: &query.PageRequest{Limit: 2},
+			},
+			resp: &types.QueryDepositsResponse{
+				Deposits:   deposits[:2],
+				Pagination: &query.PageResponse{NextKey: []byte("2")},
+			},
+		},
+		{
+			name: "FilteredByState",
+			req:  &types.QueryDepositsRequest{ChainId: suite.chainB.ChainID, State: "DEPOSIT_SENT"},
+			resp: &types.QueryDepositsResponse{
+				Deposits:   sentDeposits,
+				Pagination: &query.PageResponse{Total: uint64(len(sentDeposits))},
+			},
+		},
+		{
+			name: "FilteredByEpoch",
+			req:  &types.QueryDepositsRequest{ChainId: suite.chainB.ChainID, StartEpoch: 2, EndEpoch: 4},
+			resp: &types.QueryDepositsResponse{
+				Deposits:   deposits[2:5],
+				Pagination: &query.PageResponse{Total: 3},
+			},
+		},
+		{
+			name: "InvalidState",
+			req:  &types.QueryDepositsRequest{ChainId: suite.chainB.ChainID, State: "DEPOSIT_UNKNOWN"},
+			err:  status.Errorf(codes.InvalidArgument, "invalid deposit state %s", "DEPOSIT_UNKNOWN"),
+		},
+		{
+			name: "InvalidEpochRange",
+			req:  &types.QueryDepositsRequest{ChainId: suite.chainB.ChainID, StartEpoch: 4, EndEpoch: 2},
+			err:  status.Error(codes.InvalidArgument, "end_epoch cannot be lower than start_epoch"),
 		},
 		{
 			name: "NotFound",
@@ -195,7 +248,23 @@ func (suite *IntegrationTestSuite) TestQueryLSMDeposits() {
 		{
 			name: "Success",
 			req:  &types.QueryLSMDepositsRequest{ChainId: suite.chainB.ChainID},
-			resp: &types.QueryLSMDepositsResponse{Deposits: deposits},
+			resp: &types.QueryLSMDepositsResponse{
+				Deposits:   deposits,
+				Pagination: &query.PageResponse{Total: uint64(MultipleTestSize)},
+			},
+		},
+		{
+			name: "FilteredByState",
+			req:  &types.QueryLSMDepositsRequest{ChainId: suite.chainB.ChainID, State: "DEPOSIT_RECEIVED"},
+			resp: &types.QueryLSMDepositsResponse{
+				Deposits:   make([]*types.LSMDeposit, 0),
+				Pagination: &query.PageResponse{Total: 0},
+			},
+		},
+		{
+			name: "InvalidState",
+			req:  &types.QueryLSMDepositsRequest{ChainId: suite.chainB.ChainID, State: "DEPOSIT_UNKNOWN"},
+			err:  status.Errorf(codes.InvalidArgument, "invalid lsm deposit state %s", "DEPOSIT_UNKNOWN"),
 		},
 		{
 			name: "NotFound",
@@ -222,10 +291,20 @@ func (suite *IntegrationTestSuite) TestQueryLSMDeposits() {
 func (suite *IntegrationTestSuite) TestQueryUnbondings() {
 	unbondings := make([]*types.Unbonding, 0)
 	for i := 0; i < MultipleTestSize; i += 1 {
-		unbonding := &types.Unbonding{ChainId: suite.chainB.ChainID, EpochNumber: int64(i)}
+		unbonding := &types.Unbonding{
+			ChainId:     suite.chainB.ChainID,
+			EpochNumber: int64(i),
+			State:       types.Unbonding_UnbondingState(i % 3),
+		}
 		suite.app.LiquidStakeIBCKeeper.SetUnbonding(suite.ctx, unbonding)
 		unbondings = append(unbondings, unbonding)
 	}
+	maturingUnbondings := make([]*types.Unbonding, 0)
+	for _, unbonding := range unbondings {
+		if unbonding.State == types.Unbonding_UNBONDING_MATURING {
+			maturingUnbondings = append(maturingUnbondings, unbonding)
+		}
+	}
 
 	tc := []struct {
 		name string
@@ -236,12 +315,46 @@ func (suite *IntegrationTestSuite) TestQueryUnbondings() {
 		{
 			name: "Success",
 			req:  &types.QueryUnbondingsRequest{ChainId: suite.chainB.ChainID},
-			resp: &types.QueryUnbondingsResponse{Unbondings: unbondings},
+			resp: &types.QueryUnbondingsResponse{
+				Unbondings: unbondings,
+				Pagination: &query.PageResponse{Total: uint64(MultipleTestSize)},
+			},
+		},
+		{
+			name: "Paginated",
+			req: &types.QueryUnbondingsRequest{
+				ChainId:    suite.chainB.ChainID,
+				Pagination: &query.PageRequest{Offset: 8, Limit: 5, CountTotal: true},
+			},
+			resp: &types.QueryUnbondingsResponse{
+				Unbondings: unbondings[8:],
+				Pagination: &query.PageResponse{Total: uint64(MultipleTestSize)},
+			},
+		},
+		{
+			name: "FilteredByStateAndEpoch",
+			req: &types.QueryUnbondingsRequest{
+				ChainId:    suite.chainB.ChainID,
+				State:      "UNBONDING_MATURING",
+				StartEpoch: 3,
+			},
+			resp: &types.QueryUnbondingsResponse{
+				Unbondings: maturingUnbondings[1:],
+				Pagination: &query.PageResponse{Total: uint64(len(maturingUnbondings) - 1)},
+			},
+		},
+		{
+			name: "InvalidState",
+			req:  &types.QueryUnbondingsRequest{ChainId: suite.chainB.ChainID, State: "UNBONDING_UNKNOWN"},
+			err:  status.Errorf(codes.InvalidArgument, "invalid unbonding state %s", "UNBONDING_UNKNOWN"),
 		},
 		{
 			name: "NotFound",
 			req:  &types.QueryUnbondingsRequest{ChainId: "chain-1"},
-			resp: &types.QueryUnbondingsResponse{Unbondings: make([]*types.Unbonding, 0)},
+			resp: &types.QueryUnbondingsResponse{
+				Unbondings: make([]*types.Unbonding, 0),
+				Pagination: &query.PageResponse{Total: 0},
+			},
 		},
 		{
 			name: "InvalidRequest",
@@ -286,7 +399,23 @@ func (suite *IntegrationTestSuite) TestQueryUserUnbondings() {
 		{
 			name: "Success",
 			req:  &types.QueryUserUnbondingsRequest{Address: TestAddress},
-			resp: &types.QueryUserUnbondingsResponse{UserUnbondings: userUnbondings},
+			resp: &types.QueryUserUnbondingsResponse{
+				UserUnbondings: userUnbondings,
+				Pagination:     &query.PageResponse{Total: uint64(MultipleTestSize)},
+			},
+		},
+		{
+			name: "FilteredByEpoch",
+			req:  &types.QueryUserUnbondingsRequest{Address: TestAddress, StartEpoch: 7},
+			resp: &types.QueryUserUnbondingsResponse{
+				UserUnbondings: userUnbondings[7:],
+				Pagination:     &query.PageResponse{Total: 3},
+			},
+		},
+		{
+			name: "InvalidEpochRange",
+			req:  &types.QueryUserUnbondingsRequest{Address: TestAddress, StartEpoch: -1},
+			err:  status.Error(codes.InvalidArgument, "epochs cannot be negative"),
 		},
 		{
 			name: "NotFound",
@@ -336,12 +465,26 @@ func (suite *IntegrationTestSuite) TestQueryValidatorUnbondings() {
 		{
 			name: "Success",
 			req:  &types.QueryValidatorUnbondingRequest{ChainId: suite.chainB.ChainID},
-			resp: &types.QueryValidatorUnbondingResponse{ValidatorUnbondings: validatorUnbondings},
+			resp: &types.QueryValidatorUnbondingResponse{
+				ValidatorUnbondings: validatorUnbondings,
+				Pagination:          &query.PageResponse{Total: uint64(MultipleTestSize)},
+			},
+		},
+		{
+			name: "FilteredByEpoch",
+			req:  &types.QueryValidatorUnbondingRequest{ChainId: suite.chainB.ChainID, StartEpoch: 1, EndEpoch: 1},
+			resp: &types.QueryValidatorUnbondingResponse{
+				ValidatorUnbondings: validatorUnbondings[1:2],
+				Pagination:          &query.PageResponse{Total: 1},
+			},
 		},
 		{
 			name: "NotFound",
 			req:  &types.QueryValidatorUnbondingRequest{ChainId: "chain-1"},
-			resp: &types.QueryValidatorUnbondingResponse{ValidatorUnbondings: make([]*types.ValidatorUnbonding, 0)},
+			resp: &types.QueryValidatorUnbondingResponse{
+				ValidatorUnbondings: make([]*types.ValidatorUnbonding, 0),
+				Pagination:          &query.PageResponse{Total: 0},
+			},
 		},
 		{
 			name: "InvalidRequest",
@@ -474,3 +617,886 @@ func (suite *IntegrationTestSuite) TestQueryExchangeRate() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestQueryLiquidStakeCapacity() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	hc.Params.MaxTvl = sdktypes.ZeroInt()
+	hc.Params.MaxEpochInflow = sdktypes.NewInt(1000)
+	hc.Params.MaxAddressEpochInflow = sdktypes.NewInt(300)
+	suite.app.LiquidStakeIBCKeeper.SetHostChain(suite.ctx, hc)
+
+	address := suite.chainA.SenderAccount.GetAddress().String()
+	epoch := suite.app.LiquidStakeIBCKeeper.GetEpochNumber(suite.ctx, types.DelegationEpoch)
+	suite.app.LiquidStakeIBCKeeper.AddInflow(suite.ctx, hc.ChainId, epoch, address, sdktypes.NewInt(100))
+	suite.app.LiquidStakeIBCKeeper.AddInflow(suite.ctx, hc.ChainId, epoch, "other", sdktypes.NewInt(850))
+
+	tvl := suite.app.LiquidStakeIBCKeeper.GetLiquidStakedAmount(suite.ctx, hc)
+	remainingEpochInflow := sdktypes.NewInt(50)
+	remainingAddressEpochInflow := sdktypes.NewInt(200)
+
+	tc := []struct {
+		name string
+		req  *types.QueryLiquidStakeCapacityRequest
+		resp *types.QueryLiquidStakeCapacityResponse
+		err  error
+	}{{
+		name: "Valid",
+		req:  &types.QueryLiquidStakeCapacityRequest{ChainId: suite.chainB.ChainID},
+		resp: &types.QueryLiquidStakeCapacityResponse{
+			Epoch:                epoch,
+			Tvl:                  tvl,
+			EpochInflow:          sdktypes.NewInt(950),
+			AddressEpochInflow:   sdktypes.ZeroInt(),
+			RemainingEpochInflow: &remainingEpochInflow,
+			Remaining:            &remainingEpochInflow,
+		},
+		err: nil,
+	}, {
+		name: "ValidWithAddress",
+		req:  &types.QueryLiquidStakeCapacityRequest{ChainId: suite.chainB.ChainID, Address: address},
+		resp: &types.QueryLiquidStakeCapacityResponse{
+			Epoch:                       epoch,
+			Tvl:                         tvl,
+			EpochInflow:                 sdktypes.NewInt(950),
+			AddressEpochInflow:          sdktypes.NewInt(100),
+			RemainingEpochInflow:        &remainingEpochInflow,
+			RemainingAddressEpochInflow: &remainingAddressEpochInflow,
+			Remaining:                   &remainingEpochInflow,
+		},
+		err: nil,
+	}, {
+		name: "NotFound",
+		req:  &types.QueryLiquidStakeCapacityRequest{ChainId: "chain-1"},
+		err:  sdkerrors.ErrKeyNotFound,
+	}, {
+		name: "InvalidAddress",
+		req:  &types.QueryLiquidStakeCapacityRequest{ChainId: suite.chainB.ChainID, Address: "invalid"},
+		err:  status.Errorf(codes.InvalidArgument, "invalid address %s", "invalid"),
+	}, {
+		name: "InvalidRequest",
+		err:  status.Error(codes.InvalidArgument, "empty request"),
+	}}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+
+			resp, err := suite.app.LiquidStakeIBCKeeper.LiquidStakeCapacity(suite.ctx, t.req)
+
+			suite.Require().Equal(err, t.err)
+			suite.Require().Equal(resp, t.resp)
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestQueryOutflowQuota() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	hc.Params.MaxEpochUnstake = sdktypes.NewInt(1000)
+	suite.app.LiquidStakeIBCKeeper.SetHostChain(suite.ctx, hc)
+
+	unbondingEpoch := types.CurrentUnbondingEpoch(
+		hc.UnbondingFactor,
+		suite.app.LiquidStakeIBCKeeper.GetEpochNumber(suite.ctx, types.UndelegationEpoch),
+	)
+	epoch := suite.app.LiquidStakeIBCKeeper.GetEpochNumber(suite.ctx, types.DelegationEpoch)
+	suite.app.LiquidStakeIBCKeeper.AddRedeemOutflow(suite.ctx, hc.ChainId, epoch, sdktypes.NewInt(25))
+
+	remainingEpochUnstake := sdktypes.NewInt(1000)
+
+	tc := []struct {
+		name string
+		req  *types.QueryOutflowQuotaRequest
+		resp *types.QueryOutflowQuotaResponse
+		err  error
+	}{{
+		name: "Valid",
+		req:  &types.QueryOutflowQuotaRequest{ChainId: suite.chainB.ChainID},
+		resp: &types.QueryOutflowQuotaResponse{
+			UnbondingEpoch:        unbondingEpoch,
+			EpochUnstake:          sdktypes.ZeroInt(),
+			RemainingEpochUnstake: &remainingEpochUnstake,
+			Epoch:                 epoch,
+			EpochRedeem:           sdktypes.NewInt(25),
+		},
+		err: nil,
+	}, {
+		name: "NotFound",
+		req:  &types.QueryOutflowQuotaRequest{ChainId: "chain-1"},
+		err:  sdkerrors.ErrKeyNotFound,
+	}, {
+		name: "InvalidRequest",
+		err:  status.Error(codes.InvalidArgument, "empty request"),
+	}}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+
+			resp, err := suite.app.LiquidStakeIBCKeeper.OutflowQuota(suite.ctx, t.req)
+
+			suite.Require().Equal(err, t.err)
+			suite.Require().Equal(resp, t.resp)
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestQuerySlashRecords() {
+	slashRecords := make([]*types.SlashRecord, 0)
+	for i, validatorAddress := range []string{"val0", "val0", "val1"} {
+		slashRecords = append(slashRecords, &types.SlashRecord{
+			ChainId:            suite.chainB.ChainID,
+			ValidatorAddress:   validatorAddress,
+			Height:             int64(i),
+			Time:               suite.ctx.BlockTime(),
+			SlashedAmount:      sdktypes.NewInt(int64(i + 1)),
+			ExchangeRateBefore: sdktypes.OneDec(),
+			ExchangeRateAfter:  sdktypes.MustNewDecFromStr("0.9"),
+		})
+	}
+	for _, slashRecord := range slashRecords {
+		suite.app.LiquidStakeIBCKeeper.SetSlashRecord(suite.ctx, slashRecord)
+	}
+
+	tc := []struct {
+		name    string
+		req     *types.QuerySlashRecordsRequest
+		records []*types.SlashRecord
+		err     error
+	}{{
+		name:    "Chain",
+		req:     &types.QuerySlashRecordsRequest{ChainId: suite.chainB.ChainID},
+		records: slashRecords,
+	}, {
+		name:    "Validator",
+		req:     &types.QuerySlashRecordsRequest{ChainId: suite.chainB.ChainID, ValidatorAddress: "val1"},
+		records: slashRecords[2:],
+	}, {
+		name:    "Paginated",
+		req:     &types.QuerySlashRecordsRequest{ChainId: suite.chainB.ChainID, Pagination: &query.PageRequest{Limit: 1}},
+		records: slashRecords[:1],
+	}, {
+		name:    "NoRecords",
+		req:     &types.QuerySlashRecordsRequest{ChainId: "chain-1"},
+		records: []*types.SlashRecord{},
+	}, {
+		name: "EmptyChainID",
+		req:  &types.QuerySlashRecordsRequest{},
+		err:  status.Error(codes.InvalidArgument, "chain_id cannot be empty"),
+	}, {
+		name: "InvalidRequest",
+		err:  status.Error(codes.InvalidArgument, "empty request"),
+	}}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			resp, err := suite.app.LiquidStakeIBCKeeper.SlashRecords(suite.ctx, t.req)
+
+			suite.Require().Equal(t.err, err)
+			if t.err == nil {
+				suite.Require().Equal(t.records, resp.SlashRecords)
+			}
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestQueryValidatorSetProposal() {
+	proposal := types.ValidatorSetProposal{
+		ChainId: suite.chainB.ChainID,
+		Height:  1,
+		Validators: []*types.ValidatorScore{{
+			OperatorAddress: sdktypes.ValAddress("validatorA").String(),
+			Score:           sdktypes.OneDec(),
+			Weight:          sdktypes.OneDec(),
+		}},
+	}
+	suite.app.LiquidStakeIBCKeeper.SetValidatorSetProposal(suite.ctx, &proposal)
+
+	tc := []struct {
+		name string
+		req  *types.QueryValidatorSetProposalRequest
+		resp *types.QueryValidatorSetProposalResponse
+		err  error
+	}{{
+		name: "Valid",
+		req:  &types.QueryValidatorSetProposalRequest{ChainId: suite.chainB.ChainID},
+		resp: &types.QueryValidatorSetProposalResponse{Proposal: proposal},
+		err:  nil,
+	}, {
+		name: "NotFound",
+		req:  &types.QueryValidatorSetProposalRequest{ChainId: "chain-1"},
+		err:  sdkerrors.ErrKeyNotFound,
+	}, {
+		name: "InvalidRequest",
+		err:  status.Error(codes.InvalidArgument, "empty request"),
+	}}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			resp, err := suite.app.LiquidStakeIBCKeeper.ValidatorSetProposal(suite.ctx, t.req)
+
+			suite.Require().Equal(err, t.err)
+			suite.Require().Equal(resp, t.resp)
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestQueryHostChainVotes() {
+	votes := make([]*types.HostChainVote, 0)
+	for i := uint64(1); i <= 2; i++ {
+		votes = append(votes, &types.HostChainVote{
+			ChainId:    suite.chainB.ChainID,
+			ProposalId: i,
+			Options:    govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes),
+			Time:       suite.ctx.BlockTime(),
+			State:      types.HostChainVote_VOTE_SUCCEEDED,
+		})
+	}
+	for _, vote := range votes {
+		suite.app.LiquidStakeIBCKeeper.SetHostChainVote(suite.ctx, vote)
+	}
+
+	tc := []struct {
+		name  string
+		req   *types.QueryHostChainVotesRequest
+		votes []*types.HostChainVote
+		err   error
+	}{{
+		name:  "Chain",
+		req:   &types.QueryHostChainVotesRequest{ChainId: suite.chainB.ChainID},
+		votes: votes,
+	}, {
+		name:  "Paginated",
+		req:   &types.QueryHostChainVotesRequest{ChainId: suite.chainB.ChainID, Pagination: &query.PageRequest{Limit: 1}},
+		votes: votes[:1],
+	}, {
+		name:  "NoVotes",
+		req:   &types.QueryHostChainVotesRequest{ChainId: "chain-1"},
+		votes: []*types.HostChainVote{},
+	}, {
+		name: "EmptyChainID",
+		req:  &types.QueryHostChainVotesRequest{},
+		err:  status.Error(codes.InvalidArgument, "chain_id cannot be empty"),
+	}, {
+		name: "InvalidRequest",
+		err:  status.Error(codes.InvalidArgument, "empty request"),
+	}}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			resp, err := suite.app.LiquidStakeIBCKeeper.HostChainVotes(suite.ctx, t.req)
+
+			suite.Require().Equal(t.err, err)
+			if t.err == nil {
+				suite.Require().Equal(t.votes, resp.Votes)
+			}
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestQueryVoteSignaling() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	signaler := authtypes.NewModuleAddress("signaler")
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx,
+		signaler,
+		sdktypes.NewCoins(sdktypes.NewInt64Coin(hc.MintDenom(), 1000))))
+
+	signal := &types.VoteSignal{
+		ChainId:    hc.ChainId,
+		ProposalId: 1,
+		Signaler:   signaler.String(),
+		Options:    govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes),
+		Power:      sdktypes.NewInt(1000),
+	}
+	signaling := &types.VoteSignaling{ChainId: hc.ChainId, ProposalId: 1, EndTime: suite.ctx.BlockTime()}
+	signaling.AddSignal(signal)
+	suite.app.LiquidStakeIBCKeeper.SetVoteSignaling(suite.ctx, signaling)
+	suite.app.LiquidStakeIBCKeeper.SetVoteSignal(suite.ctx, signal)
+
+	tc := []struct {
+		name string
+		req  *types.QueryVoteSignalingRequest
+		resp *types.QueryVoteSignalingResponse
+		err  error
+	}{{
+		name: "Case",
+		req:  &types.QueryVoteSignalingRequest{ChainId: hc.ChainId, ProposalId: 1},
+		resp: &types.QueryVoteSignalingResponse{
+			Signaling:    *signaling,
+			CurrentTally: govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes),
+			TotalPower:   sdktypes.NewInt(1000),
+			Signals:      []*types.VoteSignal{signal},
+			Pagination:   &query.PageResponse{Total: 1},
+		},
+	}, {
+		name: "NotFound",
+		req:  &types.QueryVoteSignalingRequest{ChainId: hc.ChainId, ProposalId: 2},
+		err:  sdkerrors.ErrKeyNotFound,
+	}, {
+		name: "ChainNotFound",
+		req:  &types.QueryVoteSignalingRequest{ChainId: "chain-1", ProposalId: 1},
+		err:  sdkerrors.ErrKeyNotFound,
+	}, {
+		name: "EmptyChainID",
+		req:  &types.QueryVoteSignalingRequest{},
+		err:  status.Error(codes.InvalidArgument, "chain_id cannot be empty"),
+	}, {
+		name: "InvalidRequest",
+		err:  status.Error(codes.InvalidArgument, "empty request"),
+	}}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			resp, err := suite.app.LiquidStakeIBCKeeper.VoteSignaling(suite.ctx, t.req)
+
+			suite.Require().Equal(t.err, err)
+			suite.Require().Equal(t.resp, resp)
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestQueryTimelockedUpdates() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	updates := make([]*types.TimelockedUpdate, 0)
+	for _, fee := range []string{"0.01", "0.02"} {
+		updates = append(updates, suite.app.LiquidStakeIBCKeeper.QueueTimelockedUpdate(
+			suite.ctx,
+			hc.ChainId,
+			suite.chainA.SenderAccount.GetAddress().String(),
+			[]*types.KVUpdate{{Key: types.KeyUnstakeFee, Value: fee}},
+		))
+	}
+	timelockEpochsUpdate := suite.app.LiquidStakeIBCKeeper.QueueTimelockEpochsUpdate(
+		suite.ctx,
+		suite.chainA.SenderAccount.GetAddress().String(),
+		0,
+	)
+
+	tc := []struct {
+		name string
+		req  *types.QueryTimelockedUpdatesRequest
+		resp *types.QueryTimelockedUpdatesResponse
+		err  error
+	}{{
+		name: "Case",
+		req:  &types.QueryTimelockedUpdatesRequest{ChainId: hc.ChainId},
+		resp: &types.QueryTimelockedUpdatesResponse{
+			Updates:              updates,
+			Pagination:           &query.PageResponse{Total: 2},
+			TimelockEpochsUpdate: timelockEpochsUpdate,
+		},
+	}, {
+		name: "Paginated",
+		req: &types.QueryTimelockedUpdatesRequest{
+			ChainId:    hc.ChainId,
+			Pagination: &query.PageRequest{Limit: 1},
+		},
+		resp: &types.QueryTimelockedUpdatesResponse{
+			Updates: updates[:1],
+			Pagination: &query.PageResponse{
+				NextKey: sdktypes.Uint64ToBigEndian(updates[1].Id),
+			},
+			TimelockEpochsUpdate: timelockEpochsUpdate,
+		},
+	}, {
+		name: "ChainNotFound",
+		req:  &types.QueryTimelockedUpdatesRequest{ChainId: "chain-1"},
+		err:  sdkerrors.ErrKeyNotFound,
+	}, {
+		name: "EmptyChainID",
+		req:  &types.QueryTimelockedUpdatesRequest{},
+		err:  status.Error(codes.InvalidArgument, "chain_id cannot be empty"),
+	}, {
+		name: "InvalidRequest",
+		err:  status.Error(codes.InvalidArgument, "empty request"),
+	}}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			resp, err := suite.app.LiquidStakeIBCKeeper.TimelockedUpdates(suite.ctx, t.req)
+
+			suite.Require().Equal(t.err, err)
+			suite.Require().Equal(t.resp, resp)
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestQueryCValueHistory() {
+	k := suite.app.LiquidStakeIBCKeeper
+	for _, epoch := range []int64{1000, 1001, 1002} {
+		k.SetCValueRecord(suite.ctx, newCValueRecord(suite.chainB.ChainID, epoch))
+	}
+
+	tc := []struct {
+		name    string
+		req     *types.QueryCValueHistoryRequest
+		records []*types.CValueRecord
+		err     error
+	}{{
+		name: "Case",
+		req:  &types.QueryCValueHistoryRequest{ChainId: suite.chainB.ChainID, StartEpoch: 1001},
+		records: []*types.CValueRecord{
+			newCValueRecord(suite.chainB.ChainID, 1001),
+			newCValueRecord(suite.chainB.ChainID, 1002),
+		},
+	}, {
+		name: "EpochRange",
+		req:  &types.QueryCValueHistoryRequest{ChainId: suite.chainB.ChainID, StartEpoch: 1000, EndEpoch: 1000},
+		records: []*types.CValueRecord{
+			newCValueRecord(suite.chainB.ChainID, 1000),
+		},
+	}, {
+		name: "InvalidEpochRange",
+		req:  &types.QueryCValueHistoryRequest{ChainId: suite.chainB.ChainID, StartEpoch: 1001, EndEpoch: 1000},
+		err:  status.Error(codes.InvalidArgument, "end_epoch cannot be lower than start_epoch"),
+	}, {
+		name: "ChainNotFound",
+		req:  &types.QueryCValueHistoryRequest{ChainId: "chain-1"},
+		err:  sdkerrors.ErrKeyNotFound,
+	}, {
+		name: "EmptyChainID",
+		req:  &types.QueryCValueHistoryRequest{},
+		err:  status.Error(codes.InvalidArgument, "chain_id cannot be empty"),
+	}, {
+		name: "InvalidRequest",
+		err:  status.Error(codes.InvalidArgument, "empty request"),
+	}}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			resp, err := k.CValueHistory(suite.ctx, t.req)
+
+			suite.Require().Equal(t.err, err)
+			if t.err == nil {
+				suite.Require().Equal(t.records, resp.Records)
+			}
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestQueryRewardRate() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	// use a chain without history so the setup c value computations don't interfere, registered 10 epochs ago
+	epoch := suite.setDelegationEpoch(40)
+	hc.ChainId = "reward-chain"
+	hc.RegistrationEpoch = epoch - 9
+	k.SetHostChain(suite.ctx, hc)
+
+	record := newCValueRecord(hc.ChainId, 1)
+	record.Time = suite.ctx.BlockTime()
+	record.StakedAmount = sdktypes.NewInt(1000000)
+	k.SetCValueRecord(suite.ctx, record)
+
+	k.AddRewardRecord(suite.ctx, hc.ChainId, epoch-8, sdktypes.NewInt(400), sdktypes.ZeroInt())
+	k.AddRewardRecord(suite.ctx, hc.ChainId, epoch-1, sdktypes.NewInt(110), sdktypes.NewInt(10))
+	k.AddRewardRecord(suite.ctx, hc.ChainId, epoch, sdktypes.NewInt(110), sdktypes.NewInt(10))
+
+	tc := []struct {
+		name string
+		req  *types.QueryRewardRateRequest
+		resp *types.QueryRewardRateResponse
+		err  error
+	}{{
+		// 200 net rewards over the 7 epochs of the short window, 600 over the 10 epochs since the registration
+		name: "Case",
+		req:  &types.QueryRewardRateRequest{ChainId: hc.ChainId},
+		resp: &types.QueryRewardRateResponse{
+			RewardRates: []types.RewardRateWindow{{
+				Epochs:              types.RewardRateShortWindow,
+				Apr:                 sdktypes.MustNewDecFromStr("0.010428571428571428"),
+				Apy:                 sdktypes.MustNewDecFromStr("0.010482987961679137"),
+				Rewards:             sdktypes.NewInt(200),
+				AverageStakedAmount: sdktypes.NewInt(1000000),
+			}, {
+				Epochs:              types.RewardRateLongWindow,
+				Apr:                 sdktypes.MustNewDecFromStr("0.0219"),
+				Apy:                 sdktypes.MustNewDecFromStr("0.022140893683112650"),
+				Rewards:             sdktypes.NewInt(600),
+				AverageStakedAmount: sdktypes.NewInt(1000000),
+			}},
+		},
+	}, {
+		name: "ChainNotFound",
+		req:  &types.QueryRewardRateRequest{ChainId: "chain-1"},
+		err:  sdkerrors.ErrKeyNotFound,
+	}, {
+		name: "EmptyChainID",
+		req:  &types.QueryRewardRateRequest{},
+		err:  status.Error(codes.InvalidArgument, "chain_id cannot be empty"),
+	}, {
+		name: "InvalidRequest",
+		err:  status.Error(codes.InvalidArgument, "empty request"),
+	}}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			resp, err := k.RewardRate(suite.ctx, t.req)
+
+			suite.Require().Equal(t.err, err)
+			suite.Require().Equal(t.resp, resp)
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestQuerySimulateLiquidStake() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	hc.CValue = sdktypes.MustNewDecFromStr("0.5")
+	hc.Params.DepositFee = sdktypes.MustNewDecFromStr("0.1")
+	k.SetHostChain(suite.ctx, hc)
+
+	paused := *hc
+	paused.ChainId = "paused-chain"
+	paused.HostDenom = "upaused"
+	paused.ConnectionId = "connection-paused"
+	paused.ChannelId = "channel-paused"
+	paused.Pauses = &types.HostChainPauses{LiquidStake: true}
+	k.SetHostChain(suite.ctx, &paused)
+
+	tc := []struct {
+		name  string
+		req   *types.QuerySimulateLiquidStakeRequest
+		resp  *types.QuerySimulateLiquidStakeResponse
+		error string
+		err   error
+	}{{
+		name: "Valid",
+		req: &types.QuerySimulateLiquidStakeRequest{
+			DelegatorAddress: TestAddress,
+			Amount:           sdktypes.NewInt64Coin(hc.IBCDenom(), 1000),
+		},
+		// 1000 at a 0.5 c value mints 500, minus the 10% deposit fee
+		resp: &types.QuerySimulateLiquidStakeResponse{
+			MintAmount: sdktypes.NewInt64Coin(hc.MintDenom(), 450),
+			Fee:        sdktypes.NewInt64Coin(hc.MintDenom(), 50),
+		},
+	}, {
+		name: "MinimumDeposit",
+		req:  &types.QuerySimulateLiquidStakeRequest{Amount: sdktypes.NewInt64Coin(hc.IBCDenom(), 0)},
+		resp: &types.QuerySimulateLiquidStakeResponse{
+			MintAmount: sdktypes.NewInt64Coin(hc.MintDenom(), 0),
+			Fee:        sdktypes.NewInt64Coin(hc.MintDenom(), 0),
+		},
+		error: types.ErrMinDeposit.Error(),
+	}, {
+		name: "Paused",
+		req:  &types.QuerySimulateLiquidStakeRequest{Amount: sdktypes.NewInt64Coin(paused.IBCDenom(), 1000)},
+		resp: &types.QuerySimulateLiquidStakeResponse{
+			MintAmount: sdktypes.NewInt64Coin(paused.MintDenom(), 450),
+			Fee:        sdktypes.NewInt64Coin(paused.MintDenom(), 50),
+		},
+		error: types.ErrOperationPaused.Error(),
+	}, {
+		name: "NotFound",
+		req:  &types.QuerySimulateLiquidStakeRequest{Amount: sdktypes.NewInt64Coin("ibc/denom", 1000)},
+		err:  sdkerrors.ErrKeyNotFound,
+	}, {
+		name: "InvalidAddress",
+		req: &types.QuerySimulateLiquidStakeRequest{
+			DelegatorAddress: "address",
+			Amount:           sdktypes.NewInt64Coin(hc.IBCDenom(), 1000),
+		},
+		err: status.Error(codes.InvalidArgument, "invalid delegator address: decoding bech32 failed: invalid bech32 string length 7"),
+	}, {
+		name: "InvalidRequest",
+		err:  status.Error(codes.InvalidArgument, "empty request"),
+	}}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			resp, err := k.SimulateLiquidStake(suite.ctx, t.req)
+
+			suite.Require().Equal(t.err, err)
+			if t.resp == nil {
+				suite.Require().Nil(resp)
+				return
+			}
+
+			if t.error == "" {
+				suite.Require().Empty(resp.Error)
+			} else {
+				suite.Require().Contains(resp.Error, t.error)
+			}
+			resp.Error = ""
+			suite.Require().Equal(t.resp, resp)
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestQuerySimulateLiquidStakeLSM() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	tc := []struct {
+		name string
+		req  *types.QuerySimulateLiquidStakeLSMRequest
+		resp *types.QuerySimulateLiquidStakeLSMResponse
+		err  error
+	}{{
+		name: "InvalidDenom",
+		req: &types.QuerySimulateLiquidStakeLSMRequest{
+			DelegatorAddress: TestAddress,
+			Delegation:       sdktypes.NewInt64Coin("ibc/denom", 1000),
+		},
+		resp: &types.QuerySimulateLiquidStakeLSMResponse{
+			Error: "IBC denom ibc/denom doesn't belong to a LSM token: invalid lsm token denom",
+		},
+	}, {
+		name: "InvalidAddress",
+		req: &types.QuerySimulateLiquidStakeLSMRequest{
+			Delegation: sdktypes.NewInt64Coin("ibc/denom", 1000),
+		},
+		err: status.Error(codes.InvalidArgument, "invalid delegator address: empty address string is not allowed"),
+	}, {
+		name: "InvalidRequest",
+		err:  status.Error(codes.InvalidArgument, "empty request"),
+	}}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			resp, err := k.SimulateLiquidStakeLSM(suite.ctx, t.req)
+
+			suite.Require().Equal(t.err, err)
+			suite.Require().Equal(t.resp, resp)
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestQuerySimulateLiquidUnstake() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	suite.Require().NotEmpty(hc.Validators)
+
+	hc.CValue = sdktypes.MustNewDecFromStr("0.5")
+	hc.Params.UnstakeFee = sdktypes.MustNewDecFromStr("0.1")
+	hc.Params.MaxEpochUnstake = sdktypes.ZeroInt()
+	hc.Validators[0].DelegatedAmount = sdktypes.NewInt(1000)
+	k.SetHostChain(suite.ctx, hc)
+
+	epoch := k.GetEpochNumber(suite.ctx, types.UndelegationEpoch)
+	unbondingEpoch := types.CurrentUnbondingEpoch(hc.UnbondingFactor, epoch)
+	undelegationTime, matureTime := k.EstimateUnbondingTimes(suite.ctx, hc, unbondingEpoch)
+	suite.Require().True(undelegationTime.After(suite.ctx.BlockTime()))
+	suite.Require().Equal(undelegationTime.Add(hc.HostUnbondingPeriod()), matureTime)
+
+	tc := []struct {
+		name  string
+		req   *types.QuerySimulateLiquidUnstakeRequest
+		resp  *types.QuerySimulateLiquidUnstakeResponse
+		error string
+		err   error
+	}{{
+		name: "Valid",
+		req:  &types.QuerySimulateLiquidUnstakeRequest{Amount: sdktypes.NewInt64Coin(hc.MintDenom(), 100)},
+		resp: &types.QuerySimulateLiquidUnstakeResponse{
+			Fee:              sdktypes.NewInt64Coin(hc.MintDenom(), 10),
+			UnbondAmount:     sdktypes.NewInt64Coin(hc.HostDenom, 180),
+			UnbondingEpoch:   unbondingEpoch,
+			UndelegationTime: undelegationTime,
+			MatureTime:       matureTime,
+		},
+	}, {
+		name: "NotEnoughDelegations",
+		req:  &types.QuerySimulateLiquidUnstakeRequest{Amount: sdktypes.NewInt64Coin(hc.MintDenom(), 1000)},
+		resp: &types.QuerySimulateLiquidUnstakeResponse{
+			Fee:              sdktypes.NewInt64Coin(hc.MintDenom(), 100),
+			UnbondAmount:     sdktypes.NewInt64Coin(hc.HostDenom, 1800),
+			UnbondingEpoch:   unbondingEpoch,
+			UndelegationTime: undelegationTime,
+			MatureTime:       matureTime,
+		},
+		error: types.ErrNotEnoughDelegations.Error(),
+	}, {
+		name: "NotFound",
+		req:  &types.QuerySimulateLiquidUnstakeRequest{Amount: sdktypes.NewInt64Coin("stk/denom", 100)},
+		err:  sdkerrors.ErrKeyNotFound,
+	}, {
+		name: "InvalidRequest",
+		err:  status.Error(codes.InvalidArgument, "empty request"),
+	}}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			resp, err := k.SimulateLiquidUnstake(suite.ctx, t.req)
+
+			suite.Require().Equal(t.err, err)
+			if t.resp == nil {
+				suite.Require().Nil(resp)
+				return
+			}
+
+			if t.error == "" {
+				suite.Require().Empty(resp.Error)
+			} else {
+				suite.Require().Contains(resp.Error, t.error)
+			}
+			resp.Error = ""
+			suite.Require().Equal(t.resp, resp)
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestQuerySimulateRedeem() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	hc.CValue = sdktypes.MustNewDecFromStr("0.5")
+	hc.Params.RedemptionFee = sdktypes.MustNewDecFromStr("0.1")
+	hc.Params.MaxEpochRedeemRatio = sdktypes.ZeroDec()
+	k.SetHostChain(suite.ctx, hc)
+
+	suite.Require().NoError(testutil.FundModuleAccount(
+		suite.app.BankKeeper,
+		suite.ctx,
+		types.DepositModuleAccount,
+		sdktypes.NewCoins(sdktypes.NewInt64Coin(hc.IBCDenom(), 1000)),
+	))
+
+	tc := []struct {
+		name  string
+		req   *types.QuerySimulateRedeemRequest
+		resp  *types.QuerySimulateRedeemResponse
+		error string
+		err   error
+	}{{
+		name: "Valid",
+		req:  &types.QuerySimulateRedeemRequest{Amount: sdktypes.NewInt64Coin(hc.MintDenom(), 100)},
+		resp: &types.QuerySimulateRedeemResponse{
+			Fee:          sdktypes.NewInt64Coin(hc.MintDenom(), 10),
+			RedeemAmount: sdktypes.NewInt64Coin(hc.IBCDenom(), 180),
+		},
+	}, {
+		name: "InsufficientDeposits",
+		req:  &types.QuerySimulateRedeemRequest{Amount: sdktypes.NewInt64Coin(hc.MintDenom(), 1000)},
+		resp: &types.QuerySimulateRedeemResponse{
+			Fee:          sdktypes.NewInt64Coin(hc.MintDenom(), 100),
+			RedeemAmount: sdktypes.NewInt64Coin(hc.IBCDenom(), 1800),
+		},
+		error: "can't instant redeem",
+	}, {
+		name: "NotFound",
+		req:  &types.QuerySimulateRedeemRequest{Amount: sdktypes.NewInt64Coin("stk/denom", 100)},
+		err:  sdkerrors.ErrKeyNotFound,
+	}, {
+		name: "InvalidRequest",
+		err:  status.Error(codes.InvalidArgument, "empty request"),
+	}}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			resp, err := k.SimulateRedeem(suite.ctx, t.req)
+
+			suite.Require().Equal(t.err, err)
+			if t.resp == nil {
+				suite.Require().Nil(resp)
+				return
+			}
+
+			if t.error == "" {
+				suite.Require().Empty(resp.Error)
+			} else {
+				suite.Require().Contains(resp.Error, t.error)
+			}
+			resp.Error = ""
+			suite.Require().Equal(t.resp, resp)
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestQueryUnbondingEstimates() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	epoch := types.CurrentUnbondingEpoch(hc.UnbondingFactor, k.GetEpochNumber(suite.ctx, types.UndelegationEpoch))
+	nextEpoch := epoch + hc.UnbondingFactor
+
+	userUnbondings := []*types.UserUnbonding{{
+		ChainId:      hc.ChainId,
+		EpochNumber:  epoch,
+		Address:      TestAddress,
+		StkAmount:    sdktypes.NewInt64Coin(hc.MintDenom(), 100),
+		UnbondAmount: sdktypes.NewInt64Coin(hc.HostDenom, 100),
+	}, {
+		ChainId:      hc.ChainId,
+		EpochNumber:  nextEpoch,
+		Address:      TestAddress,
+		StkAmount:    sdktypes.NewInt64Coin(hc.MintDenom(), 200),
+		UnbondAmount: sdktypes.NewInt64Coin(hc.HostDenom, 200),
+	}}
+	for _, userUnbonding := range userUnbondings {
+		k.SetUserUnbonding(suite.ctx, userUnbonding)
+	}
+
+	estimate := k.EstimateUnbonding(suite.ctx, hc, epoch)
+	nextEstimate := k.EstimateUnbonding(suite.ctx, hc, nextEpoch)
+	userEstimate := estimate
+	userEstimate.UserUnbonding = userUnbondings[0]
+	nextUserEstimate := nextEstimate
+	nextUserEstimate.UserUnbonding = userUnbondings[1]
+
+	tc := []struct {
+		name string
+		req  *types.QueryUnbondingEstimatesRequest
+		resp *types.QueryUnbondingEstimatesResponse
+		err  error
+	}{{
+		name: "Address",
+		req:  &types.QueryUnbondingEstimatesRequest{ChainId: hc.ChainId, Address: TestAddress},
+		resp: &types.QueryUnbondingEstimatesResponse{
+			Estimates: []types.UnbondingEstimate{userEstimate, nextUserEstimate},
+		},
+	}, {
+		name: "AddressAndEpoch",
+		req:  &types.QueryUnbondingEstimatesRequest{ChainId: hc.ChainId, Address: TestAddress, Epoch: nextEpoch},
+		resp: &types.QueryUnbondingEstimatesResponse{
+			Estimates: []types.UnbondingEstimate{nextUserEstimate},
+		},
+	}, {
+		name: "Epoch",
+		req:  &types.QueryUnbondingEstimatesRequest{ChainId: hc.ChainId, Epoch: epoch},
+		resp: &types.QueryUnbondingEstimatesResponse{
+			Estimates: []types.UnbondingEstimate{estimate},
+		},
+	}, {
+		name: "NoAddressOrEpoch",
+		req:  &types.QueryUnbondingEstimatesRequest{ChainId: hc.ChainId},
+		err:  status.Error(codes.InvalidArgument, "either address or epoch must be set"),
+	}, {
+		name: "NotFound",
+		req:  &types.QueryUnbondingEstimatesRequest{ChainId: "chain-1", Epoch: epoch},
+		err:  sdkerrors.ErrKeyNotFound,
+	}, {
+		name: "EmptyChainID",
+		req:  &types.QueryUnbondingEstimatesRequest{},
+		err:  status.Error(codes.InvalidArgument, "chain_id cannot be empty"),
+	}, {
+		name: "InvalidRequest",
+		err:  status.Error(codes.InvalidArgument, "empty request"),
+	}}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			resp, err := k.UnbondingEstimates(suite.ctx, t.req)
+
+			suite.Require().Equal(t.err, err)
+			suite.Require().Equal(t.resp, resp)
+		})
+	}
+}
@@ -83,6 +83,13 @@ func (k *Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epoch
 	// create a batch of user deposits for the new deposit epoch
 	if epochIdentifier == liquidstakeibctypes.DelegationEpoch {
 		k.CreateDeposits(ctx, epochNumber)
+
+		// the inflow and redeem caps are enforced per epoch, so the previous flows are not needed anymore
+		for _, hc := range k.GetAllHostChains(ctx) {
+			k.DeleteInflowsBeforeEpoch(ctx, hc.ChainId, epochNumber)
+			k.DeleteRedeemOutflowsBeforeEpoch(ctx, hc.ChainId, epochNumber)
+			k.DeleteRewardRecordsBeforeEpoch(ctx, hc.ChainId, epochNumber-liquidstakeibctypes.RewardRateLongWindow+1)
+		}
 	}
 
 	// update the c value for each registered host chain
@@ -95,9 +102,17 @@ func (k *Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epoch
 
 func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	if epochIdentifier == liquidstakeibctypes.DelegationEpoch {
+		k.TimelockWorkflow(ctx, epochNumber)
+
 		k.DepositWorkflow(ctx, epochNumber)
 
 		k.LSMWorkflow(ctx)
+
+		k.RebalanceWorkflow(ctx, epochNumber)
+
+		k.ValidatorSetWorkflow(ctx, epochNumber)
+
+		k.StakingParamsWorkflow(ctx, epochNumber)
 	}
 
 	if epochIdentifier == liquidstakeibctypes.UndelegationEpoch {
@@ -166,12 +181,7 @@ func (k *Keeper) OnRecvIBCTransferPacket(
 		)
 
 		// get all the unbondings for that ibc sequence id
-		unbondings := k.FilterUnbondings(
-			ctx,
-			func(u liquidstakeibctypes.Unbonding) bool {
-				return u.ChainId == hc.ChainId && u.State == liquidstakeibctypes.Unbonding_UNBONDING_MATURED
-			},
-		)
+		unbondings := k.GetUnbondingsForChainAndState(ctx, hc.ChainId, liquidstakeibctypes.Unbonding_UNBONDING_MATURED)
 
 		// update the unbonding states
 		for _, unbonding := range unbondings {
@@ -248,8 +258,11 @@ func (k *Keeper) OnRecvIBCTransferPacket(
 			)
 		}
 
-		// calculate protocol fee
-		feeAmount := hc.Params.RestakeFee.MulInt(transferAmount)
+		// calculate protocol fee, the residual rewards returned by a deregistration belong to the stk holders
+		feeAmount := sdk.ZeroDec()
+		if hc.Deregistration == nil {
+			feeAmount = hc.Params.RestakeFee.MulInt(transferAmount)
+		}
 		fee, _ := sdk.NewDecCoinFromDec(hc.IBCDenom(), feeAmount).TruncateDecimal()
 
 		// send the protocol fee
@@ -283,6 +296,11 @@ func (k *Keeper) OnRecvIBCTransferPacket(
 		// update the deposit
 		deposit.Amount.Amount = deposit.Amount.Amount.Add(transferAmount.Sub(feeAmount.TruncateInt()))
 		k.SetDeposit(ctx, deposit)
+
+		// keep track of the rewards to compute the host chain reward rate
+		if hc.Deregistration == nil {
+			k.AddRewardRecord(ctx, hc.ChainId, currentEpoch, transferAmount, feeAmount.TruncateInt())
+		}
 	}
 
 	return nil
@@ -389,7 +407,7 @@ func (k *Keeper) OnTimeoutIBCTransferPacket(
 		// revert the state of the LSM deposits that timed out
 		k.RevertLSMDepositsState(
 			ctx,
-			k.GetLSMDepositsFromIbcDenom(ctx, k.GetTransactionSequenceID(packet.SourceChannel, packet.Sequence)),
+			k.GetLSMDepositsFromIbcSequenceID(ctx, k.GetTransactionSequenceID(packet.SourceChannel, packet.Sequence)),
 		)
 	}
 
@@ -491,21 +509,11 @@ func (k *Keeper) UndelegationWorkflow(ctx sdk.Context, epoch int64) {
 			continue
 		}
 
-		// retrieve the unbonding for the current epoch
-		unbonding, found := k.GetUnbonding(
-			ctx,
-			hc.ChainId,
-			liquidstakeibctypes.CurrentUnbondingEpoch(hc.UnbondingFactor, epoch),
-		)
-		if !found {
-			// nothing to unbond for this epoch
-			continue
-		}
-
-		// check if there is anything to unbond
-		if !unbonding.UnbondAmount.Amount.GT(sdk.ZeroInt()) {
+		// the unbondings stay pending while ICA transactions are paused, they are sent on the first unbonding epoch
+		// after the pause is lifted
+		if hc.GetPauses().GetIcaSends() {
 			k.Logger(ctx).Info(
-				"No tokens to unbond.",
+				"ICA transactions are paused, postponing unbondings.",
 				"host_chain",
 				hc.ChainId,
 				"epoch",
@@ -514,37 +522,61 @@ func (k *Keeper) UndelegationWorkflow(ctx sdk.Context, epoch int64) {
 			continue
 		}
 
-		// generate the undelegation messages based on the total unbonding amount for the epoch
-		messages, err := k.GenerateUndelegateMessages(hc, unbonding.UnbondAmount.Amount)
-		if err != nil {
-			k.Logger(ctx).Error(
-				"could not generate undelegate messages",
-				"host_chain",
-				hc.ChainId,
-			)
-			return
-		}
-
-		// execute the ICA transactions
-		sequenceID, err := k.GenerateAndExecuteICATx(
+		// retrieve the pending unbondings up to the current epoch, including the ones postponed by a pause
+		currentEpoch := liquidstakeibctypes.CurrentUnbondingEpoch(hc.UnbondingFactor, epoch)
+		for _, unbonding := range k.GetUnbondingsForChainAndState(
 			ctx,
-			hc.ConnectionId,
-			hc.DelegationAccount.Owner,
-			messages,
-		)
-		if err != nil {
-			k.Logger(ctx).Error(
-				"could not send ICA undelegate txs",
-				"host_chain",
-				hc.ChainId,
+			hc.ChainId,
+			liquidstakeibctypes.Unbonding_UNBONDING_PENDING,
+		) {
+			if unbonding.EpochNumber > currentEpoch {
+				continue
+			}
+
+			// check if there is anything to unbond
+			if !unbonding.UnbondAmount.Amount.GT(sdk.ZeroInt()) {
+				k.Logger(ctx).Info(
+					"No tokens to unbond.",
+					"host_chain",
+					hc.ChainId,
+					"epoch",
+					unbonding.EpochNumber,
+				)
+				continue
+			}
+
+			// generate the undelegation messages based on the total unbonding amount for the epoch
+			messages, err := k.GenerateUndelegateMessages(hc, unbonding.UnbondAmount.Amount)
+			if err != nil {
+				k.Logger(ctx).Error(
+					"could not generate undelegate messages",
+					"host_chain",
+					hc.ChainId,
+				)
+				return
+			}
+
+			// execute the ICA transactions
+			sequenceID, err := k.GenerateAndExecuteICATx(
+				ctx,
+				hc.ConnectionId,
+				hc.DelegationAccount.Owner,
+				messages,
 			)
-			return
-		}
+			if err != nil {
+				k.Logger(ctx).Error(
+					"could not send ICA undelegate txs",
+					"host_chain",
+					hc.ChainId,
+				)
+				return
+			}
 
-		// update the unbonding ibc sequence id and state
-		unbonding.IbcSequenceId = sequenceID
-		unbonding.State = liquidstakeibctypes.Unbonding_UNBONDING_INITIATED
-		k.SetUnbonding(ctx, unbonding)
+			// update the unbonding ibc sequence id and state
+			unbonding.IbcSequenceId = sequenceID
+			unbonding.State = liquidstakeibctypes.Unbonding_UNBONDING_INITIATED
+			k.SetUnbonding(ctx, unbonding)
+		}
 	}
 }
 
@@ -552,8 +584,8 @@ func (k *Keeper) ValidatorUndelegationWorkflow(ctx sdk.Context, epoch int64) {
 	k.Logger(ctx).Info("Running validator undelegation workflow.", "epoch", epoch)
 
 	for _, hc := range k.GetAllHostChains(ctx) {
-		// don't do anything if the chain is not active
-		if !hc.Active {
+		// don't do anything if the chain is not active or its ICA transactions are paused
+		if !hc.Active || hc.GetPauses().GetIcaSends() {
 			continue
 		}
 
@@ -628,8 +660,8 @@ func (k *Keeper) RewardsWorkflow(ctx sdk.Context, epoch int64) {
 	k.Logger(ctx).Info("Running rewards workflow.", "epoch", epoch)
 
 	for _, hc := range k.GetAllHostChains(ctx) {
-		// don't do anything if the chain is not active
-		if !hc.Active {
+		// don't do anything if the chain is not active, or its autocompounding or ICA transactions are paused
+		if !hc.Active || hc.GetPauses().GetAutocompound() || hc.GetPauses().GetIcaSends() {
 			continue
 		}
 
@@ -677,6 +709,27 @@ func (k *Keeper) RewardsWorkflow(ctx sdk.Context, epoch int64) {
 	}
 }
 
+func (k *Keeper) RebalanceWorkflow(ctx sdk.Context, epoch int64) {
+	k.Logger(ctx).Info("Running rebalance workflow.", "epoch", epoch)
+
+	for _, hc := range k.GetAllHostChains(ctx) {
+		// only rebalance active chains that have the rebalance flag enabled and ICA transactions unpaused
+		if !hc.Active || !hc.Flags.Rebalance || hc.GetPauses().GetIcaSends() {
+			continue
+		}
+
+		if err := k.RebalanceHostChain(ctx, hc); err != nil {
+			k.Logger(ctx).Info(
+				"Could not rebalance host chain.",
+				"host_chain",
+				hc.ChainId,
+				"reason",
+				err.Error(),
+			)
+		}
+	}
+}
+
 func (k *Keeper) LSMWorkflow(ctx sdk.Context) {
 	for _, hc := range k.GetAllHostChains(ctx) {
 		if !hc.Active || !hc.Flags.Lsm {
@@ -685,53 +738,58 @@ func (k *Keeper) LSMWorkflow(ctx sdk.Context) {
 		}
 
 		// attempt to transfer all available LSM deposits
-		for _, deposit := range k.GetTransferableLSMDeposits(ctx, hc.ChainId) {
-			clientState, err := k.GetClientState(ctx, hc.ConnectionId)
-			if err != nil {
-				// we can't error out here as all the deposits need to be executed
-				continue
-			}
+		k.DoTransferLSMDeposits(ctx, hc)
+	}
+}
 
-			timeoutHeight := clienttypes.NewHeight(
-				clientState.GetLatestHeight().GetRevisionNumber(),
-				clientState.GetLatestHeight().GetRevisionHeight()+liquidstakeibctypes.IBCTimeoutHeightIncrement,
-			)
+// DoTransferLSMDeposits transfers the LSM deposits of a host chain to its delegation account
+func (k *Keeper) DoTransferLSMDeposits(ctx sdk.Context, hc *liquidstakeibctypes.HostChain) {
+	for _, deposit := range k.GetTransferableLSMDeposits(ctx, hc.ChainId) {
+		clientState, err := k.GetClientState(ctx, hc.ConnectionId)
+		if err != nil {
+			// we can't error out here as all the deposits need to be executed
+			continue
+		}
 
-			// craft the IBC message
-			msg := ibctransfertypes.NewMsgTransfer(
-				ibctransfertypes.PortID,
-				hc.ChannelId,
-				sdk.NewCoin(deposit.IbcDenom, deposit.Shares.TruncateInt()),
-				authtypes.NewModuleAddress(liquidstakeibctypes.DepositModuleAccount).String(),
-				hc.DelegationAccount.Address,
-				timeoutHeight,
-				0,
-				"",
-			)
+		timeoutHeight := clienttypes.NewHeight(
+			clientState.GetLatestHeight().GetRevisionNumber(),
+			clientState.GetLatestHeight().GetRevisionHeight()+liquidstakeibctypes.IBCTimeoutHeightIncrement,
+		)
 
-			// send the message
-			handler := k.msgRouter.Handler(msg)
-			res, err := handler(ctx, msg)
-			if err != nil {
-				k.Logger(ctx).Error(fmt.Sprintf("could not send transfer msg via MsgServiceRouter, error: %s", err))
-				// we can't error out here as all the deposits need to be executed
-				continue
-			}
-			ctx.EventManager().EmitEvents(res.GetEvents())
+		// craft the IBC message
+		msg := ibctransfertypes.NewMsgTransfer(
+			ibctransfertypes.PortID,
+			hc.ChannelId,
+			sdk.NewCoin(deposit.IbcDenom, deposit.Shares.TruncateInt()),
+			authtypes.NewModuleAddress(liquidstakeibctypes.DepositModuleAccount).String(),
+			hc.DelegationAccount.Address,
+			timeoutHeight,
+			0,
+			"",
+		)
 
-			var msgTransferResponse ibctransfertypes.MsgTransferResponse
-			if err = k.cdc.Unmarshal(res.MsgResponses[0].Value, &msgTransferResponse); err != nil {
-				// we can't error out here as all the deposits need to be executed
-				continue
-			}
+		// send the message
+		handler := k.msgRouter.Handler(msg)
+		res, err := handler(ctx, msg)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("could not send transfer msg via MsgServiceRouter, error: %s", err))
+			// we can't error out here as all the deposits need to be executed
+			continue
+		}
+		ctx.EventManager().EmitEvents(res.GetEvents())
 
-			// update the deposit state and add the IBC sequence id
-			k.UpdateLSMDepositsStateAndSequence(
-				ctx,
-				[]*liquidstakeibctypes.LSMDeposit{deposit},
-				liquidstakeibctypes.LSMDeposit_DEPOSIT_SENT,
-				k.GetTransactionSequenceID(hc.ChannelId, msgTransferResponse.Sequence),
-			)
+		var msgTransferResponse ibctransfertypes.MsgTransferResponse
+		if err = k.cdc.Unmarshal(res.MsgResponses[0].Value, &msgTransferResponse); err != nil {
+			// we can't error out here as all the deposits need to be executed
+			continue
 		}
+
+		// update the deposit state and add the IBC sequence id
+		k.UpdateLSMDepositsStateAndSequence(
+			ctx,
+			[]*liquidstakeibctypes.LSMDeposit{deposit},
+			liquidstakeibctypes.LSMDeposit_DEPOSIT_SENT,
+			k.GetTransactionSequenceID(hc.ChannelId, msgTransferResponse.Sequence),
+		)
 	}
 }
@@ -1,10 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
@@ -17,6 +21,12 @@ func (k *Keeper) SetHostChain(ctx sdk.Context, hc *types.HostChain) {
 	store.Set([]byte(hc.ChainId), bytes)
 }
 
+// DeleteHostChain removes a host chain from the store
+func (k *Keeper) DeleteHostChain(ctx sdk.Context, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostChainKey)
+	store.Delete([]byte(chainID))
+}
+
 // SetHostChainValidator sets a validator on the target host chain
 func (k *Keeper) SetHostChainValidator(
 	ctx sdk.Context,
@@ -67,12 +77,7 @@ func (k *Keeper) ProcessHostChainValidatorUpdates(
 	}
 
 	// process exchange rate update
-	var exchangeRate sdk.Dec
-	if validator.DelegatorShares.IsZero() {
-		exchangeRate = sdk.OneDec()
-	} else {
-		exchangeRate = sdk.NewDecFromInt(validator.Tokens).Quo(validator.DelegatorShares)
-	}
+	exchangeRate := validatorExchangeRate(validator)
 	if !exchangeRate.Equal(val.ExchangeRate) {
 		if val.DelegatedAmount.GT(sdk.ZeroInt()) {
 			if err := k.QueryValidatorDelegation(ctx, hc, val); err != nil {
@@ -88,28 +93,58 @@ func (k *Keeper) ProcessHostChainValidatorUpdates(
 		k.SetHostChainValidator(ctx, hc, val)
 	}
 
-	// process LSM cap updates
-	if hc.Flags.Lsm {
-		// check if the validator has reached the LSM validator bond
-		var validatorHasRoomForDelegations bool
-		if validator.DelegatorShares.IsZero() {
-			validatorHasRoomForDelegations = true // if no shares are issued yet, the validator can accept more delegations
-		} else {
-			validatorHasRoomForDelegations = validator.LiquidShares.Quo(validator.DelegatorShares).LT(hc.Params.LsmValidatorCap)
+	// process commission and voting power updates, used by the delegation strategies
+	if !validator.Commission.Rate.IsNil() &&
+		(val.Commission.IsNil() || !validator.Commission.Rate.Equal(val.Commission)) {
+		if !hc.Params.MaxValidatorCommission.IsNil() && hc.Params.MaxValidatorCommission.IsPositive() &&
+			validator.Commission.Rate.GT(hc.Params.MaxValidatorCommission) {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeValidatorCommissionExceeded,
+					sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+					sdk.NewAttribute(types.AttributeValidatorAddress, val.OperatorAddress),
+					sdk.NewAttribute(types.AttributeCommission, validator.Commission.Rate.String()),
+					sdk.NewAttribute(types.AttributeMaxCommission, hc.Params.MaxValidatorCommission.String()),
+				),
+			)
 		}
 
-		// check if the validator has reached the bonded shares cap
-		var validatorHasEnoughBond bool
-		// this is the default value for the bond factor, which disables the functionality
-		// https://github.com/cosmos/cosmos-sdk/blob/0af2f4da004cbea6414a8bad56e8bdcd45badf1e/x/staking/types/params.go#L36-L73
-		if hc.Params.LsmBondFactor.Equal(sdk.NewDecFromInt(sdk.NewInt(-1))) {
-			validatorHasEnoughBond = true
-		} else {
-			validatorHasEnoughBond = validator.LiquidShares.LT(validator.ValidatorBondShares.Mul(hc.Params.LsmBondFactor))
+		val.Commission = validator.Commission.Rate
+		k.SetHostChainValidator(ctx, hc, val)
+	}
+	if !validator.Tokens.IsNil() && (val.Tokens.IsNil() || !validator.Tokens.Equal(val.Tokens)) {
+		val.Tokens = validator.Tokens
+		k.SetHostChainValidator(ctx, hc, val)
+	}
+
+	// process jailing updates, a jailed validator could have been tombstoned, which is only known by its signing info
+	if validator.Jailed != val.Jailed {
+		val.Jailed = validator.Jailed
+		k.SetHostChainValidator(ctx, hc, val)
+	}
+	if validator.Jailed && !val.Tombstoned {
+		if err := k.queryJailedValidatorSigningInfo(ctx, hc, val, validator); err != nil {
+			k.Logger(ctx).Error(
+				"could not query jailed validator signing info",
+				"host_chain",
+				hc.ChainId,
+				"validator",
+				val.OperatorAddress,
+				"reason",
+				err.Error(),
+			)
 		}
+	}
+
+	// validators with a commission above the host chain max or tombstoned can't receive delegations
+	validatorIsEligible := !val.Tombstoned && !validatorCommissionExceeded(hc, val)
+
+	// process LSM cap updates
+	if hc.Flags.Lsm {
+		validatorHasRoomForDelegations, validatorHasEnoughBond := validatorLSMRoom(hc, validator)
 
 		// update the validator if its delegable status has changed
-		val.Delegable = validatorHasRoomForDelegations && validatorHasEnoughBond
+		val.Delegable = validatorIsEligible && validatorHasRoomForDelegations && validatorHasEnoughBond
 		k.SetHostChainValidator(ctx, hc, val)
 
 		// this part of the code checks whether there is actually room to delegate on the validator.
@@ -171,13 +206,134 @@ func (k *Keeper) ProcessHostChainValidatorUpdates(
 		}
 
 		// recalculate the delegable state of the validator with the new flag
-		val.Delegable = validatorHasRoomForDelegations && validatorHasEnoughBond && validatorHasEnoughRoom
+		val.Delegable = validatorIsEligible && validatorHasRoomForDelegations && validatorHasEnoughBond &&
+			validatorHasEnoughRoom
+		k.SetHostChainValidator(ctx, hc, val)
+	} else if val.Delegable != validatorIsEligible {
+		val.Delegable = validatorIsEligible
 		k.SetHostChainValidator(ctx, hc, val)
 	}
 
 	return nil
 }
 
+// ProcessHostChainValidatorSigningInfo updates the tombstone status of a host chain validator out of its signing
+// info, a tombstoned validator is not delegable anymore
+func (k *Keeper) ProcessHostChainValidatorSigningInfo(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	signingInfo slashingtypes.ValidatorSigningInfo,
+) error {
+	_, consAddr, err := bech32.DecodeAndConvert(signingInfo.Address)
+	if err != nil {
+		return fmt.Errorf("invalid validator consensus address %s: %s", signingInfo.Address, err.Error())
+	}
+
+	for _, val := range hc.Validators {
+		if val.ConsensusAddress == "" {
+			continue
+		}
+
+		_, valConsAddr, err := bech32.DecodeAndConvert(val.ConsensusAddress)
+		if err != nil || !bytes.Equal(valConsAddr, consAddr) {
+			continue
+		}
+
+		if signingInfo.Tombstoned != val.Tombstoned {
+			val.Tombstoned = signingInfo.Tombstoned
+			val.Delegable = val.Delegable && !val.Tombstoned
+			k.SetHostChainValidator(ctx, hc, val)
+
+			k.Logger(ctx).Info(
+				"Updated host chain validator tombstone status.",
+				"host_chain",
+				hc.ChainId,
+				"validator",
+				val.OperatorAddress,
+				"tombstoned",
+				val.Tombstoned,
+			)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("validator with consensus address %s not registered", signingInfo.Address)
+}
+
+// validatorExchangeRate returns the host chain validator token exchange rate, total bonded tokens divided by total
+// shares issued
+func validatorExchangeRate(validator stakingtypes.Validator) sdk.Dec {
+	if validator.DelegatorShares.IsZero() {
+		return sdk.OneDec()
+	}
+	return sdk.NewDecFromInt(validator.Tokens).Quo(validator.DelegatorShares)
+}
+
+// queryJailedValidatorSigningInfo stores the consensus address of a jailed host chain validator and queries its
+// signing info to find out if it has been tombstoned
+func (k *Keeper) queryJailedValidatorSigningInfo(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	val *types.Validator,
+	validator stakingtypes.Validator,
+) error {
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	val.ConsensusAddress, err = validatorConsensusAddress(val.OperatorAddress, consAddr)
+	if err != nil {
+		return err
+	}
+	k.SetHostChainValidator(ctx, hc, val)
+
+	return k.QueryValidatorSigningInfo(ctx, hc, consAddr)
+}
+
+// validatorCommissionExceeded returns whether a host chain validator commission is above the host chain max
+func validatorCommissionExceeded(hc *types.HostChain, validator *types.Validator) bool {
+	maxCommission := hc.Params.MaxValidatorCommission
+	if maxCommission.IsNil() || !maxCommission.IsPositive() || validator.Commission.IsNil() {
+		return false
+	}
+	return validator.Commission.GT(maxCommission)
+}
+
+// validatorConsensusAddress encodes a validator consensus address with the host chain consensus address prefix,
+// derived from the validator operator address prefix
+func validatorConsensusAddress(operatorAddress string, consAddr sdk.ConsAddress) (string, error) {
+	hrp, _, err := bech32.DecodeAndConvert(operatorAddress)
+	if err != nil {
+		return "", err
+	}
+	return bech32.ConvertAndEncode(strings.TrimSuffix(hrp, "valoper")+"valcons", consAddr)
+}
+
+// validatorLSMRoom returns whether a host chain validator is below its LSM validator cap and its LSM bond factor cap
+func validatorLSMRoom(hc *types.HostChain, validator stakingtypes.Validator) (bool, bool) {
+	// check if the validator has reached the LSM validator bond
+	var validatorHasRoomForDelegations bool
+	if validator.DelegatorShares.IsZero() {
+		validatorHasRoomForDelegations = true // if no shares are issued yet, the validator can accept more delegations
+	} else {
+		validatorHasRoomForDelegations = validator.LiquidShares.Quo(validator.DelegatorShares).LT(hc.Params.LsmValidatorCap)
+	}
+
+	// check if the validator has reached the bonded shares cap
+	var validatorHasEnoughBond bool
+	// this is the default value for the bond factor, which disables the functionality
+	// https://github.com/cosmos/cosmos-sdk/blob/0af2f4da004cbea6414a8bad56e8bdcd45badf1e/x/staking/types/params.go#L36-L73
+	if hc.Params.LsmBondFactor.Equal(sdk.NewDecFromInt(sdk.NewInt(-1))) {
+		validatorHasEnoughBond = true
+	} else {
+		validatorHasEnoughBond = validator.LiquidShares.LT(validator.ValidatorBondShares.Mul(hc.Params.LsmBondFactor))
+	}
+
+	return validatorHasRoomForDelegations, validatorHasEnoughBond
+}
+
 func (k *Keeper) RedistributeValidatorWeight(ctx sdk.Context, hc *types.HostChain, validator *types.Validator) {
 	validatorsWithWeight := make([]*types.Validator, 0)
 	for _, val := range hc.Validators {
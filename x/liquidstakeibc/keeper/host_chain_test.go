@@ -5,7 +5,9 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -965,6 +967,59 @@ func (suite *IntegrationTestSuite) TestProcessHostChainValidatorUpdates() {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestProcessHostChainValidatorCommissionAndJailing() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	hc.Flags = &types.HostChainFlags{}
+	hc.Params.MaxValidatorCommission = sdk.MustNewDecFromStr("0.2")
+	k.SetHostChain(suite.ctx, hc)
+
+	val := hc.Validators[0]
+	_, valAddr, err := bech32.DecodeAndConvert(val.OperatorAddress)
+	suite.Require().NoError(err)
+	pubKey := ed25519.GenPrivKey().PubKey()
+	validator, err := stakingtypes.NewValidator(valAddr, pubKey, stakingtypes.Description{})
+	suite.Require().NoError(err)
+	validator.OperatorAddress = val.OperatorAddress
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = sdk.NewInt(100)
+	validator.DelegatorShares = sdk.NewDec(100)
+	validator.Commission.Rate = sdk.OneDec()
+	validator.Jailed = true
+
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(k.ProcessHostChainValidatorUpdates(ctx, hc, validator))
+
+	updated, _ := hc.GetValidator(val.OperatorAddress)
+	suite.Require().Equal(sdk.OneDec(), updated.Commission)
+	suite.Require().True(updated.Jailed)
+	suite.Require().False(updated.Tombstoned)
+	suite.Require().False(updated.Delegable)
+
+	_, valConsAddr, err := bech32.DecodeAndConvert(updated.ConsensusAddress)
+	suite.Require().NoError(err)
+	suite.Require().Equal(pubKey.Address().Bytes(), valConsAddr)
+
+	eventFound := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeValidatorCommissionExceeded {
+			eventFound = true
+		}
+	}
+	suite.Require().True(eventFound)
+
+	// the validator becomes delegable again once its commission is back under the max
+	validator.Commission.Rate = sdk.MustNewDecFromStr("0.1")
+	validator.Jailed = false
+	suite.Require().NoError(k.ProcessHostChainValidatorUpdates(suite.ctx, hc, validator))
+
+	updated, _ = hc.GetValidator(val.OperatorAddress)
+	suite.Require().False(updated.Jailed)
+	suite.Require().True(updated.Delegable)
+}
+
 func (suite *IntegrationTestSuite) TestRedistributeValidatorWeight() {
 	hcs := suite.app.LiquidStakeIBCKeeper.GetAllHostChains(suite.ctx)
 
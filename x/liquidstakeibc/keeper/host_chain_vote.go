@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetHostChainVote(ctx sdk.Context, vote *types.HostChainVote) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostChainVoteKey)
+	bytes := k.cdc.MustMarshal(vote)
+	store.Set(types.GetHostChainVoteStoreKey(vote.ChainId, vote.ProposalId), bytes)
+}
+
+func (k *Keeper) GetHostChainVote(ctx sdk.Context, chainID string, proposalID uint64) (*types.HostChainVote, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostChainVoteKey)
+	bz := store.Get(types.GetHostChainVoteStoreKey(chainID, proposalID))
+	if bz == nil {
+		return &types.HostChainVote{}, false
+	}
+
+	var vote types.HostChainVote
+	k.cdc.MustUnmarshal(bz, &vote)
+	return &vote, true
+}
+
+func (k *Keeper) FilterHostChainVotes(
+	ctx sdk.Context,
+	filter func(v types.HostChainVote) bool,
+) []*types.HostChainVote {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostChainVoteKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	votes := make([]*types.HostChainVote, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		vote := types.HostChainVote{}
+		k.cdc.MustUnmarshal(iterator.Value(), &vote)
+		if filter(vote) {
+			votes = append(votes, &vote)
+		}
+	}
+
+	return votes
+}
+
+// FailHostChainVotesForSequenceID marks as failed the votes sent in an ICA transaction that didn't succeed
+func (k *Keeper) FailHostChainVotesForSequenceID(ctx sdk.Context, sequenceID string) {
+	votes := k.FilterHostChainVotes(
+		ctx,
+		func(v types.HostChainVote) bool {
+			return v.IbcSequenceId == sequenceID
+		},
+	)
+
+	for _, vote := range votes {
+		vote.State = types.HostChainVote_VOTE_FAILED
+		k.SetHostChainVote(ctx, vote)
+
+		// the signaling voted by the failed transaction is not retried
+		k.CloseVoteSignalingForSequenceID(ctx, vote.ChainId, vote.ProposalId, sequenceID)
+	}
+}
+
+// VoteOnHostChainProposal casts a governance vote on a host chain proposal with the stake of the delegation account.
+// A single option is sent as a MsgVote, several options as a MsgVoteWeighted.
+func (k *Keeper) VoteOnHostChainProposal(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	proposalID uint64,
+	options []govv1beta1.WeightedVoteOption,
+) error {
+	if hc.DelegationAccount == nil || hc.DelegationAccount.ChannelState != types.ICAAccount_ICA_CHANNEL_CREATED {
+		return errorsmod.Wrapf(types.ErrICATxFailure, "host chain %s delegation account is not ready", hc.ChainId)
+	}
+
+	var message proto.Message
+	if len(options) == 1 {
+		message = &govv1beta1.MsgVote{
+			ProposalId: proposalID,
+			Voter:      hc.DelegationAccount.Address,
+			Option:     options[0].Option,
+		}
+	} else {
+		message = &govv1beta1.MsgVoteWeighted{
+			ProposalId: proposalID,
+			Voter:      hc.DelegationAccount.Address,
+			Options:    options,
+		}
+	}
+
+	sequenceID, err := k.GenerateAndExecuteICATx(
+		ctx,
+		hc.ConnectionId,
+		hc.DelegationAccount.Owner,
+		[]proto.Message{message},
+	)
+	if err != nil {
+		return err
+	}
+
+	// a new vote on the same proposal replaces the previous one, as it does on the host chain
+	k.SetHostChainVote(ctx, &types.HostChainVote{
+		ChainId:       hc.ChainId,
+		ProposalId:    proposalID,
+		Options:       options,
+		Height:        ctx.BlockHeight(),
+		Time:          ctx.BlockTime(),
+		IbcSequenceId: sequenceID,
+		State:         types.HostChainVote_VOTE_INITIATED,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHostChainVote,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeVoteOptions, govv1beta1.WeightedVoteOptions(options).String()),
+		),
+	)
+
+	return nil
+}
+
+func (k *Keeper) HandleVoteResponse(ctx sdk.Context, msg sdk.Msg, channel string, sequence uint64) error {
+	var voter string
+	var proposalID uint64
+	switch parsedMsg := msg.(type) {
+	case *govv1beta1.MsgVote:
+		voter, proposalID = parsedMsg.Voter, parsedMsg.ProposalId
+	case *govv1beta1.MsgVoteWeighted:
+		voter, proposalID = parsedMsg.Voter, parsedMsg.ProposalId
+	default:
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidType,
+			"unable to cast msg of type %s to MsgVote or MsgVoteWeighted",
+			sdk.MsgTypeURL(msg),
+		)
+	}
+
+	// get the host chain of the vote using its voter address
+	hc, found := k.GetHostChainFromDelegatorAddress(ctx, voter)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrInvalidHostChain,
+			"host chain with delegator address %s not registered, or account not associated",
+			voter,
+		)
+	}
+
+	// the vote could have been replaced by a newer one while this one was in flight
+	sequenceID := k.GetTransactionSequenceID(channel, sequence)
+	vote, found := k.GetHostChainVote(ctx, hc.ChainId, proposalID)
+	if found && vote.IbcSequenceId == sequenceID {
+		vote.State = types.HostChainVote_VOTE_SUCCEEDED
+		k.SetHostChainVote(ctx, vote)
+	}
+
+	// the signals of the signaling voted by the transaction can be pruned now
+	k.CloseVoteSignalingForSequenceID(ctx, hc.ChainId, proposalID, sequenceID)
+
+	k.Logger(ctx).Info(
+		"Received vote acknowledgement",
+		"host_chain",
+		hc.ChainId,
+		"proposal",
+		proposalID,
+	)
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestGetSetHostChainVote() {
+	vote := &types.HostChainVote{
+		ChainId:       suite.chainB.ChainID,
+		ProposalId:    1,
+		Options:       govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes),
+		Time:          suite.ctx.BlockTime(),
+		IbcSequenceId: "channel-1-sequence-1",
+	}
+	suite.app.LiquidStakeIBCKeeper.SetHostChainVote(suite.ctx, vote)
+
+	found, ok := suite.app.LiquidStakeIBCKeeper.GetHostChainVote(suite.ctx, suite.chainB.ChainID, 1)
+	suite.Require().True(ok)
+	suite.Require().Equal(vote, found)
+
+	_, ok = suite.app.LiquidStakeIBCKeeper.GetHostChainVote(suite.ctx, suite.chainB.ChainID, 2)
+	suite.Require().False(ok)
+}
+
+func (suite *IntegrationTestSuite) TestVoteOnHostChainProposal() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	options := govv1beta1.WeightedVoteOptions{
+		{Option: govv1beta1.OptionYes, Weight: sdk.MustNewDecFromStr("0.7")},
+		{Option: govv1beta1.OptionNo, Weight: sdk.MustNewDecFromStr("0.3")},
+	}
+	suite.Require().NoError(k.VoteOnHostChainProposal(suite.ctx, hc, 1, options))
+
+	vote, found := k.GetHostChainVote(suite.ctx, hc.ChainId, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.HostChainVote_VOTE_INITIATED, vote.State)
+	suite.Require().Equal([]govv1beta1.WeightedVoteOption(options), vote.Options)
+	suite.Require().NotEqual("", vote.IbcSequenceId)
+
+	// a new vote replaces the previous one
+	suite.Require().NoError(
+		k.VoteOnHostChainProposal(suite.ctx, hc, 1, govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionAbstain)),
+	)
+	revote, _ := k.GetHostChainVote(suite.ctx, hc.ChainId, 1)
+	suite.Require().NotEqual(vote.IbcSequenceId, revote.IbcSequenceId)
+	suite.Require().Equal(govv1beta1.OptionAbstain, revote.Options[0].Option)
+
+	hc.DelegationAccount.ChannelState = types.ICAAccount_ICA_CHANNEL_CREATING
+	suite.Require().ErrorIs(
+		k.VoteOnHostChainProposal(suite.ctx, hc, 2, govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes)),
+		types.ErrICATxFailure,
+	)
+}
+
+func (suite *IntegrationTestSuite) TestHandleVoteResponse() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	sequenceID := k.GetTransactionSequenceID("channel-1", 1)
+	k.SetHostChainVote(suite.ctx, &types.HostChainVote{
+		ChainId:       hc.ChainId,
+		ProposalId:    1,
+		Options:       govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes),
+		IbcSequenceId: sequenceID,
+		State:         types.HostChainVote_VOTE_INITIATED,
+	})
+	k.SetVoteSignaling(suite.ctx, &types.VoteSignaling{
+		ChainId:       hc.ChainId,
+		ProposalId:    1,
+		EndTime:       suite.ctx.BlockTime(),
+		Tallied:       true,
+		Tally:         govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes),
+		IbcSequenceId: sequenceID,
+	})
+
+	err := k.HandleVoteResponse(
+		suite.ctx,
+		&govv1beta1.MsgVote{ProposalId: 1, Voter: hc.DelegationAccount.Address, Option: govv1beta1.OptionYes},
+		"channel-1",
+		1,
+	)
+	suite.Require().NoError(err)
+
+	vote, _ := k.GetHostChainVote(suite.ctx, hc.ChainId, 1)
+	suite.Require().Equal(types.HostChainVote_VOTE_SUCCEEDED, vote.State)
+
+	// the signaling voted by the transaction is closed to be pruned
+	signaling, _ := k.GetVoteSignaling(suite.ctx, hc.ChainId, 1)
+	suite.Require().True(signaling.Closed)
+
+	// the ack of a replaced vote doesn't update the latest one
+	vote.State = types.HostChainVote_VOTE_INITIATED
+	vote.IbcSequenceId = k.GetTransactionSequenceID("channel-1", 2)
+	k.SetHostChainVote(suite.ctx, vote)
+
+	err = k.HandleVoteResponse(
+		suite.ctx,
+		&govv1beta1.MsgVoteWeighted{
+			ProposalId: 1,
+			Voter:      hc.DelegationAccount.Address,
+			Options:    govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionNo),
+		},
+		"channel-1",
+		1,
+	)
+	suite.Require().NoError(err)
+
+	vote, _ = k.GetHostChainVote(suite.ctx, hc.ChainId, 1)
+	suite.Require().Equal(types.HostChainVote_VOTE_INITIATED, vote.State)
+
+	// a wrong message type is rejected
+	err = k.HandleVoteResponse(suite.ctx, &stakingtypes.MsgDelegate{}, "channel-1", 1)
+	suite.Require().Error(err)
+
+	// a vote from an unknown account is rejected
+	err = k.HandleVoteResponse(suite.ctx, &govv1beta1.MsgVote{ProposalId: 1, Voter: "unknown"}, "channel-1", 1)
+	suite.Require().ErrorIs(err, types.ErrInvalidHostChain)
+}
+
+func (suite *IntegrationTestSuite) TestFailHostChainVotesForSequenceID() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	votes := []*types.HostChainVote{
+		{ChainId: suite.chainB.ChainID, ProposalId: 1, IbcSequenceId: "1"},
+		{ChainId: suite.chainB.ChainID, ProposalId: 2, IbcSequenceId: "2"},
+	}
+	for _, vote := range votes {
+		k.SetHostChainVote(suite.ctx, vote)
+		k.SetVoteSignaling(suite.ctx, &types.VoteSignaling{
+			ChainId:       vote.ChainId,
+			ProposalId:    vote.ProposalId,
+			EndTime:       suite.ctx.BlockTime(),
+			Tallied:       true,
+			IbcSequenceId: vote.IbcSequenceId,
+		})
+	}
+
+	k.FailHostChainVotesForSequenceID(suite.ctx, "1")
+
+	failed, _ := k.GetHostChainVote(suite.ctx, suite.chainB.ChainID, 1)
+	suite.Require().Equal(types.HostChainVote_VOTE_FAILED, failed.State)
+	initiated, _ := k.GetHostChainVote(suite.ctx, suite.chainB.ChainID, 2)
+	suite.Require().Equal(types.HostChainVote_VOTE_INITIATED, initiated.State)
+
+	// the signaling voted by the failed transaction is closed instead of retried
+	signaling, _ := k.GetVoteSignaling(suite.ctx, suite.chainB.ChainID, 1)
+	suite.Require().True(signaling.Closed)
+	signaling, _ = k.GetVoteSignaling(suite.ctx, suite.chainB.ChainID, 2)
+	suite.Require().False(signaling.Closed)
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
@@ -221,23 +222,19 @@ func (k *Keeper) handleUnsuccessfulAck(
 			k.FailAllUnbondingsForSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence))
 			// delete all validator unbondings so they can be picked up again
 			k.DeleteValidatorUnbondingsForSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence))
+		case sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}):
+			// delete the redelegations, the delegations haven't moved so the next rebalance will retry them
+			k.DeleteRedelegationsForSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence))
+		case sdk.MsgTypeURL(&govv1beta1.MsgVote{}), sdk.MsgTypeURL(&govv1beta1.MsgVoteWeighted{}):
+			// keep the votes as failed, so it is known the host chain proposal was not voted
+			k.FailHostChainVotesForSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence))
 		case sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}):
-			unbondings := k.FilterUnbondings(
-				ctx,
-				func(u types.Unbonding) bool {
-					return u.IbcSequenceId == k.GetTransactionSequenceID(channel, sequence)
-				},
-			)
+			unbondings := k.GetUnbondingsWithSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence))
 			// revert unbonding state so it can be picked up again
 			// this won't conflict with failed rewards transfers since the transaction sequence id won't match
 			k.RevertUnbondingsState(ctx, unbondings)
 
-			validatorUnbondings := k.FilterValidatorUnbondings(
-				ctx,
-				func(u types.ValidatorUnbonding) bool {
-					return u.IbcSequenceId == k.GetTransactionSequenceID(channel, sequence)
-				},
-			)
+			validatorUnbondings := k.GetValidatorUnbondingsWithSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence))
 
 			// empty the ibc sequence id, so they will be picked up again while processing mature delegations
 			for _, validatorUnbonding := range validatorUnbondings {
@@ -245,12 +242,7 @@ func (k *Keeper) handleUnsuccessfulAck(
 				k.SetValidatorUnbonding(ctx, validatorUnbonding)
 			}
 		case sdk.MsgTypeURL(&stakingtypes.MsgRedeemTokensForShares{}):
-			deposits := k.FilterLSMDeposits(
-				ctx,
-				func(d types.LSMDeposit) bool {
-					return d.IbcSequenceId == k.GetTransactionSequenceID(channel, sequence)
-				},
-			)
+			deposits := k.GetLSMDepositsFromIbcSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence))
 
 			// revert the state of the deposit, so it will be retried
 			k.RevertLSMDepositsState(ctx, deposits)
@@ -302,6 +294,25 @@ func (k *Keeper) handleSuccessfulAck(
 			if err = k.HandleUndelegateResponse(ctx, msg, msgResponse, channel, sequence); err != nil {
 				return err
 			}
+		case sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}):
+			var data []byte
+			if len(txMsgData.Data) == 0 {
+				data = txMsgData.GetMsgResponses()[i].Value
+			} else {
+				data = txMsgData.Data[i].Data
+			}
+
+			var msgResponse stakingtypes.MsgBeginRedelegateResponse
+			if err := k.cdc.Unmarshal(data, &msgResponse); err != nil {
+				return errorsmod.Wrapf(
+					sdkerrors.ErrJSONUnmarshal, "cannot unmarshal redelegate response message: %s",
+					err.Error(),
+				)
+			}
+
+			if err = k.HandleRedelegateResponse(ctx, msg, msgResponse, channel, sequence); err != nil {
+				return err
+			}
 		case sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}):
 			var data []byte
 			if len(txMsgData.Data) == 0 {
@@ -340,6 +351,10 @@ func (k *Keeper) handleSuccessfulAck(
 			if err = k.HandleMsgRedeemTokensForShares(ctx, msg, msgResponse, channel, sequence); err != nil {
 				return err
 			}
+		case sdk.MsgTypeURL(&govv1beta1.MsgVote{}), sdk.MsgTypeURL(&govv1beta1.MsgVoteWeighted{}):
+			if err = k.HandleVoteResponse(ctx, msg, channel, sequence); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -111,10 +111,7 @@ func (k *Keeper) HandleUndelegateResponse(
 	k.SetHostChainValidator(ctx, hc, validator)
 
 	// update the state of all the unbondings associated with the undelegation
-	unbondings := k.FilterUnbondings(
-		ctx,
-		func(u types.Unbonding) bool { return u.IbcSequenceId == k.GetTransactionSequenceID(channel, sequence) },
-	)
+	unbondings := k.GetUnbondingsWithSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence))
 
 	for _, unbonding := range unbondings {
 		// burn the undelegated stk tokens
@@ -145,12 +142,7 @@ func (k *Keeper) HandleUndelegateResponse(
 	}
 
 	// update the state of all the validator unbondings associated with the undelegation
-	validatorUnbondings := k.FilterValidatorUnbondings(
-		ctx,
-		func(u types.ValidatorUnbonding) bool {
-			return u.IbcSequenceId == k.GetTransactionSequenceID(channel, sequence)
-		},
-	)
+	validatorUnbondings := k.GetValidatorUnbondingsWithSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence))
 
 	for _, validatorUnbonding := range validatorUnbondings {
 		// update the mature time and the state for the validator undelegation
@@ -172,6 +164,86 @@ func (k *Keeper) HandleUndelegateResponse(
 	return nil
 }
 
+func (k *Keeper) HandleRedelegateResponse(
+	ctx sdk.Context,
+	msg sdk.Msg,
+	resp stakingtypes.MsgBeginRedelegateResponse,
+	channel string,
+	sequence uint64,
+) error {
+	parsedMsg, ok := msg.(*stakingtypes.MsgBeginRedelegate)
+	if !ok {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidType,
+			"unable to cast msg of type %s to MsgBeginRedelegate",
+			sdk.MsgTypeURL(msg),
+		)
+	}
+
+	// get the host chain of the redelegation using its delegator address
+	hc, found := k.GetHostChainFromDelegatorAddress(ctx, parsedMsg.DelegatorAddress)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrInvalidHostChain,
+			"host chain with delegator address %s not registered, or account not associated",
+			parsedMsg.DelegatorAddress,
+		)
+	}
+
+	// get the validators the tokens have been moved from and to
+	srcValidator, found := hc.GetValidator(parsedMsg.ValidatorSrcAddress)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrValidatorNotFound,
+			"validator with operator address %s not found",
+			parsedMsg.ValidatorSrcAddress,
+		)
+	}
+	dstValidator, found := hc.GetValidator(parsedMsg.ValidatorDstAddress)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrValidatorNotFound,
+			"validator with operator address %s not found",
+			parsedMsg.ValidatorDstAddress,
+		)
+	}
+
+	// update the delegated amount of both validators
+	srcValidator.DelegatedAmount = srcValidator.DelegatedAmount.Sub(parsedMsg.Amount.Amount)
+	k.SetHostChainValidator(ctx, hc, srcValidator)
+
+	dstValidator.DelegatedAmount = dstValidator.DelegatedAmount.Add(parsedMsg.Amount.Amount)
+	k.SetHostChainValidator(ctx, hc, dstValidator)
+
+	// update the completion time and the state of the redelegation
+	redelegation, found := k.GetRedelegation(
+		ctx,
+		hc.ChainId,
+		parsedMsg.ValidatorSrcAddress,
+		parsedMsg.ValidatorDstAddress,
+		k.GetTransactionSequenceID(channel, sequence),
+	)
+	if found {
+		redelegation.CompletionTime = resp.CompletionTime
+		redelegation.State = types.Redelegation_REDELEGATION_MATURING
+		k.SetRedelegation(ctx, redelegation)
+	}
+
+	k.Logger(ctx).Info(
+		"Received redelegation acknowledgement",
+		"delegator",
+		parsedMsg.DelegatorAddress,
+		"source validator",
+		parsedMsg.ValidatorSrcAddress,
+		"destination validator",
+		parsedMsg.ValidatorDstAddress,
+		"amount",
+		parsedMsg.Amount.String(),
+	)
+
+	return nil
+}
+
 func (k *Keeper) HandleMsgTransfer(
 	ctx sdk.Context,
 	msg sdk.Msg,
@@ -202,12 +274,7 @@ func (k *Keeper) HandleMsgTransfer(
 	if parsedMsg.Sender == hc.DelegationAccount.Address &&
 		parsedMsg.Receiver == k.GetUndelegationModuleAccount(ctx).GetAddress().String() {
 		// get all the unbondings for that ibc sequence id
-		unbondings := k.FilterUnbondings(
-			ctx,
-			func(u types.Unbonding) bool {
-				return u.IbcSequenceId == k.GetTransactionSequenceID(channel, sequence)
-			},
-		)
+		unbondings := k.GetUnbondingsWithSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence))
 
 		// update the unbonding ibc sequence id to the transfer id
 		for _, unbonding := range unbondings {
@@ -218,15 +285,13 @@ func (k *Keeper) HandleMsgTransfer(
 
 	if parsedMsg.Sender == hc.DelegationAccount.Address &&
 		parsedMsg.Receiver == k.GetDepositModuleAccount(ctx).GetAddress().String() {
-		validatorUnbondings := k.FilterValidatorUnbondings(
-			ctx,
-			func(u types.ValidatorUnbonding) bool {
-				return u.ChainId == hc.ChainId && u.IbcSequenceId == k.GetTransactionSequenceID(channel, sequence)
-			},
-		)
+		validatorUnbondings := k.GetValidatorUnbondingsWithSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence))
 
 		// remove the unbonding entries as the transfer has succeeded on our part
 		for _, validatorUnbonding := range validatorUnbondings {
+			if validatorUnbonding.ChainId != hc.ChainId {
+				continue
+			}
 			k.DeleteValidatorUnbonding(ctx, validatorUnbonding)
 		}
 	}
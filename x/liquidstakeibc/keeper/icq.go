@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	icqtypes "github.com/persistenceOne/persistence-sdk/v2/x/interchainquery/types"
 
@@ -13,10 +14,16 @@ import (
 )
 
 const (
-	Validator                 = "validator"
-	Delegation                = "validator-delegation"
-	RewardAccountBalances     = "reward-balances"
-	DelegationAccountBalances = "delegation-balances"
+	Validator                        = "validator"
+	Delegation                       = "validator-delegation"
+	RewardAccountBalances            = "reward-balances"
+	DelegationAccountBalances        = "delegation-balances"
+	ValidatorSet                     = "validator-set"
+	ValidatorSetCandidate            = "validator-set-candidate"
+	ValidatorSetCandidateSigningInfo = "validator-set-candidate-signing-info"
+	SlashingParams                   = "slashing-params"
+	ValidatorSigningInfo             = "validator-signing-info"
+	StakingParams                    = "staking-params"
 )
 
 type CallbackFn func(Keeper, sdk.Context, []byte, icqtypes.Query) error
@@ -50,7 +57,13 @@ func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
 		AddCallback(Validator, CallbackFn(ValidatorCallback)).
 		AddCallback(RewardAccountBalances, CallbackFn(RewardsAccountBalanceCallback)).
 		AddCallback(DelegationAccountBalances, CallbackFn(DelegationAccountBalanceCallback)).
-		AddCallback(Delegation, CallbackFn(DelegationCallback))
+		AddCallback(Delegation, CallbackFn(DelegationCallback)).
+		AddCallback(ValidatorSet, CallbackFn(ValidatorSetCallback)).
+		AddCallback(ValidatorSetCandidate, CallbackFn(ValidatorSetCandidateCallback)).
+		AddCallback(ValidatorSetCandidateSigningInfo, CallbackFn(ValidatorSetCandidateSigningInfoCallback)).
+		AddCallback(SlashingParams, CallbackFn(SlashingParamsCallback)).
+		AddCallback(ValidatorSigningInfo, CallbackFn(ValidatorSigningInfoCallback)).
+		AddCallback(StakingParams, CallbackFn(StakingParamsCallback))
 
 	return a.(Callbacks)
 }
@@ -71,6 +84,20 @@ func ValidatorCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Qu
 	return k.ProcessHostChainValidatorUpdates(ctx, hc, validator)
 }
 
+func ValidatorSigningInfoCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
+	hc, found := k.GetHostChain(ctx, query.ChainId)
+	if !found {
+		return fmt.Errorf("host chain with id %s is not registered", query.ChainId)
+	}
+
+	var signingInfo slashingtypes.ValidatorSigningInfo
+	if err := k.cdc.Unmarshal(data, &signingInfo); err != nil {
+		return fmt.Errorf("could not unmarshall ICQ validator signing info response: %w", err)
+	}
+
+	return k.ProcessHostChainValidatorSigningInfo(ctx, hc, signingInfo)
+}
+
 func DelegationCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
 	hc, found := k.GetHostChain(ctx, query.ChainId)
 	if !found {
@@ -101,15 +128,38 @@ func DelegationCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Q
 			"slashed-amount:", slashedAmount,
 		)
 
+		// the exchange rate the delegation was accounted at before the slash
+		exchangeRateBefore := validator.ExchangeRate
+		if delegation.Shares.IsPositive() {
+			exchangeRateBefore = sdk.NewDecFromInt(validator.DelegatedAmount).Quo(delegation.Shares)
+		}
+
 		// update the delegated amount to the slashed amount
+		existingDelegation := validator.DelegatedAmount
 		validator.DelegatedAmount = delegatedAmount.TruncateInt()
 		k.SetHostChainValidator(ctx, hc, validator)
 
+		k.ProcessValidatorSlash(
+			ctx,
+			hc,
+			validator,
+			&types.SlashRecord{
+				ChainId:            hc.ChainId,
+				ValidatorAddress:   validator.OperatorAddress,
+				Height:             ctx.BlockHeight(),
+				Time:               ctx.BlockTime(),
+				SlashedAmount:      existingDelegation.Sub(validator.DelegatedAmount),
+				ExchangeRateBefore: exchangeRateBefore,
+				ExchangeRateAfter:  validator.ExchangeRate,
+			},
+			existingDelegation,
+		)
+
 		ctx.EventManager().EmitEvents(sdk.Events{
 			sdk.NewEvent(
 				types.EventTypeSlashing,
 				sdk.NewAttribute(types.AttributeValidatorAddress, validator.OperatorAddress),
-				sdk.NewAttribute(types.AttributeExistingDelegation, validator.DelegatedAmount.String()),
+				sdk.NewAttribute(types.AttributeExistingDelegation, existingDelegation.String()),
 				sdk.NewAttribute(types.AttributeUpdatedDelegation, delegatedAmount.String()),
 				sdk.NewAttribute(types.AttributeSlashedAmount, slashedAmount.String()),
 			)})
@@ -118,6 +168,112 @@ func DelegationCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Q
 	return nil
 }
 
+func ValidatorSetCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
+	hc, found := k.GetHostChain(ctx, query.ChainId)
+	if !found {
+		return fmt.Errorf("host chain with id %s is not registered", query.ChainId)
+	}
+
+	var response stakingtypes.QueryValidatorsResponse
+	if err := k.cdc.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("could not unmarshall ICQ validator set response: %w", err)
+	}
+
+	var nextKey []byte
+	if response.Pagination != nil {
+		nextKey = response.Pagination.NextKey
+	}
+
+	return k.ProcessHostChainValidatorSetPage(ctx, hc, response.Validators, nextKey)
+}
+
+func ValidatorSetCandidateCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
+	hc, found := k.GetHostChain(ctx, query.ChainId)
+	if !found {
+		return fmt.Errorf("host chain with id %s is not registered", query.ChainId)
+	}
+
+	if len(query.Request) < 3 {
+		return fmt.Errorf("invalid ICQ validator set candidate request %X", query.Request)
+	}
+	valAddr := sdk.ValAddress(stakingtypes.AddressFromValidatorsKey(query.Request))
+
+	// the proof shows the validator doesn't exist on the host chain
+	if len(data) == 0 {
+		return k.ProcessValidatorSetCandidate(ctx, hc, valAddr, nil)
+	}
+
+	validator, err := stakingtypes.UnmarshalValidator(k.cdc, data)
+	if err != nil {
+		return fmt.Errorf("could not unmarshall ICQ validator set candidate response: %w", err)
+	}
+
+	return k.ProcessValidatorSetCandidate(ctx, hc, valAddr, &validator)
+}
+
+func ValidatorSetCandidateSigningInfoCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
+	hc, found := k.GetHostChain(ctx, query.ChainId)
+	if !found {
+		return fmt.Errorf("host chain with id %s is not registered", query.ChainId)
+	}
+
+	if len(query.Request) < 3 {
+		return fmt.Errorf("invalid ICQ validator set candidate signing info request %X", query.Request)
+	}
+	consAddr := slashingtypes.ValidatorSigningInfoAddress(query.Request)
+
+	// the proof shows the validator has no signing info on the host chain
+	if len(data) == 0 {
+		return k.ProcessValidatorSetCandidateSigningInfo(ctx, hc, consAddr, nil)
+	}
+
+	var signingInfo slashingtypes.ValidatorSigningInfo
+	if err := k.cdc.Unmarshal(data, &signingInfo); err != nil {
+		return fmt.Errorf("could not unmarshall ICQ validator set candidate signing info response: %w", err)
+	}
+
+	return k.ProcessValidatorSetCandidateSigningInfo(ctx, hc, consAddr, &signingInfo)
+}
+
+func SlashingParamsCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
+	hc, found := k.GetHostChain(ctx, query.ChainId)
+	if !found {
+		return fmt.Errorf("host chain with id %s is not registered", query.ChainId)
+	}
+
+	var params slashingtypes.Params
+	if err := k.cdc.Unmarshal(data, &params); err != nil {
+		return fmt.Errorf("could not unmarshall ICQ slashing params response: %w", err)
+	}
+
+	if params.SignedBlocksWindow <= 0 {
+		return fmt.Errorf("invalid host chain %s signed blocks window %d", hc.ChainId, params.SignedBlocksWindow)
+	}
+
+	return k.ProcessValidatorSetSignedBlocksWindow(ctx, hc, params.SignedBlocksWindow)
+}
+
+func StakingParamsCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
+	hc, found := k.GetHostChain(ctx, query.ChainId)
+	if !found {
+		return fmt.Errorf("host chain with id %s is not registered", query.ChainId)
+	}
+
+	var response stakingtypes.QueryParamsResponse
+	if err := k.cdc.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("could not unmarshall ICQ staking params response: %w", err)
+	}
+
+	if response.Params.UnbondingTime <= 0 {
+		return fmt.Errorf("invalid host chain %s unbonding period %s", hc.ChainId, response.Params.UnbondingTime)
+	}
+
+	hc.UnbondingPeriod = response.Params.UnbondingTime
+	k.SetHostChain(ctx, hc)
+
+	return nil
+}
+
 func DelegationAccountBalanceCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
 	hc, found := k.GetHostChain(ctx, query.ChainId)
 	if !found {
@@ -131,6 +287,13 @@ func DelegationAccountBalanceCallback(k Keeper, ctx sdk.Context, data []byte, qu
 
 	hc.DelegationAccount.Balance = balance
 
+	// return the residual balance of a chain being deregistered
+	if hc.Deregistration != nil && hc.Deregistration.State == types.Deregistration_DEREGISTRATION_RETURNING {
+		if err = k.ReturnDeregistrationBalance(ctx, hc, hc.DelegationAccount); err != nil {
+			return fmt.Errorf("could not send ICA delegation account transfer: %w", err)
+		}
+	}
+
 	k.SetHostChain(ctx, hc)
 
 	return nil
@@ -148,7 +311,14 @@ func RewardsAccountBalanceCallback(k Keeper, ctx sdk.Context, data []byte, query
 	}
 
 	hc.RewardsAccount.Balance = balance
-	if !hc.RewardsAccount.Balance.IsZero() {
+
+	// return the whole residual balance of a chain being deregistered
+	if hc.Deregistration != nil && hc.Deregistration.State == types.Deregistration_DEREGISTRATION_RETURNING {
+		if err = k.ReturnDeregistrationBalance(ctx, hc, hc.RewardsAccount); err != nil {
+			return fmt.Errorf("could not send ICA rewards transfer: %w", err)
+		}
+	} else if !hc.RewardsAccount.Balance.IsZero() &&
+		!hc.GetPauses().GetAutocompound() && !hc.GetPauses().GetIcaSends() {
 
 		// limit the auto-compounded rewards to the host chain autocompound factor
 		var autocompoundRewards sdk.Coin
@@ -3,7 +3,9 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
@@ -36,6 +38,127 @@ func (k *Keeper) QueryHostChainValidator(
 	return nil
 }
 
+// QueryValidatorSigningInfo sends an ICQ query to get a host chain validator signing info
+func (k *Keeper) QueryValidatorSigningInfo(ctx sdk.Context, hc *types.HostChain, consAddr sdk.ConsAddress) error {
+	k.icqKeeper.MakeRequest(
+		ctx,
+		hc.ConnectionId,
+		hc.ChainId,
+		types.SlashingStoreQuery,
+		slashingtypes.ValidatorSigningInfoKey(consAddr),
+		sdk.NewInt(int64(-1)),
+		types.ModuleName,
+		ValidatorSigningInfo,
+		0,
+	)
+
+	return nil
+}
+
+// QueryHostChainValidatorSet sends an ICQ query to discover a page of the host chain bonded validator set, starting
+// at the given pagination key
+func (k *Keeper) QueryHostChainValidatorSet(ctx sdk.Context, hc *types.HostChain, pageKey []byte) error {
+	request, err := k.cdc.Marshal(&stakingtypes.QueryValidatorsRequest{
+		Status:     stakingtypes.BondStatusBonded,
+		Pagination: &query.PageRequest{Key: pageKey, Limit: types.MaxValidatorSetQueryLimit},
+	})
+	if err != nil {
+		return err
+	}
+
+	k.icqKeeper.MakeRequest(
+		ctx,
+		hc.ConnectionId,
+		hc.ChainId,
+		types.StakingValidatorsQuery,
+		request,
+		sdk.NewInt(int64(-1)),
+		types.ModuleName,
+		ValidatorSet,
+		0,
+	)
+
+	return nil
+}
+
+// QueryValidatorSetCandidate sends an ICQ query to retrieve the proof verified record of a validator set candidate
+func (k *Keeper) QueryValidatorSetCandidate(ctx sdk.Context, hc *types.HostChain, valAddr sdk.ValAddress) error {
+	k.icqKeeper.MakeRequest(
+		ctx,
+		hc.ConnectionId,
+		hc.ChainId,
+		types.StakingStoreQuery,
+		stakingtypes.GetValidatorKey(valAddr),
+		sdk.NewInt(int64(-1)),
+		types.ModuleName,
+		ValidatorSetCandidate,
+		0,
+	)
+
+	return nil
+}
+
+// QueryValidatorSetCandidateSigningInfo sends an ICQ query to retrieve the proof verified signing info of a
+// validator set candidate
+func (k *Keeper) QueryValidatorSetCandidateSigningInfo(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	consAddr sdk.ConsAddress,
+) error {
+	k.icqKeeper.MakeRequest(
+		ctx,
+		hc.ConnectionId,
+		hc.ChainId,
+		types.SlashingStoreQuery,
+		slashingtypes.ValidatorSigningInfoKey(consAddr),
+		sdk.NewInt(int64(-1)),
+		types.ModuleName,
+		ValidatorSetCandidateSigningInfo,
+		0,
+	)
+
+	return nil
+}
+
+// QueryHostChainSlashingParams sends an ICQ query to retrieve the proof verified host chain slashing params
+func (k *Keeper) QueryHostChainSlashingParams(ctx sdk.Context, hc *types.HostChain) error {
+	k.icqKeeper.MakeRequest(
+		ctx,
+		hc.ConnectionId,
+		hc.ChainId,
+		types.SlashingStoreQuery,
+		slashingtypes.ParamsKey,
+		sdk.NewInt(int64(-1)),
+		types.ModuleName,
+		SlashingParams,
+		0,
+	)
+
+	return nil
+}
+
+// QueryHostChainStakingParams sends an ICQ query to retrieve the host chain staking params
+func (k *Keeper) QueryHostChainStakingParams(ctx sdk.Context, hc *types.HostChain) error {
+	request, err := k.cdc.Marshal(&stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+
+	k.icqKeeper.MakeRequest(
+		ctx,
+		hc.ConnectionId,
+		hc.ChainId,
+		types.StakingParamsQuery,
+		request,
+		sdk.NewInt(int64(-1)),
+		types.ModuleName,
+		StakingParams,
+		0,
+	)
+
+	return nil
+}
+
 // QueryValidatorDelegation sends an ICQ query to get a validator delegation
 func (k *Keeper) QueryValidatorDelegation(
 	ctx sdk.Context,
@@ -1,10 +1,15 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	icqtypes "github.com/persistenceOne/persistence-sdk/v2/x/interchainquery/types"
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 )
 
 func (suite *IntegrationTestSuite) TestValidatorCallback() {
@@ -154,6 +159,14 @@ func (suite *IntegrationTestSuite) TestDelegationCallback() {
 			}
 		})
 	}
+
+	// only the slashed validator case is recorded
+	slashRecords := k.GetValidatorSlashRecords(ctx, hc.ChainId, hc.Validators[0].OperatorAddress)
+	suite.Require().Len(slashRecords, 1)
+	suite.Require().Equal(ctx.BlockHeight(), slashRecords[0].Height)
+	suite.Require().Equal(sdk.NewInt(90), slashRecords[0].SlashedAmount)
+	suite.Require().Equal(sdk.NewDec(10), slashRecords[0].ExchangeRateBefore)
+	suite.Require().Equal(sdk.OneDec(), slashRecords[0].ExchangeRateAfter)
 }
 
 func (suite *IntegrationTestSuite) TestDelegationAccountBalanceCallback() {
@@ -269,3 +282,166 @@ func (suite *IntegrationTestSuite) TestRewardsAccountBalanceCallback() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestValidatorSetCallback() {
+	pstakeApp, ctx := suite.app, suite.ctx
+	k := pstakeApp.LiquidStakeIBCKeeper
+	cdc := pstakeApp.AppCodec()
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	validators := []stakingtypes.Validator{verifiedHostValidator("validatorA", 100), verifiedHostValidator("validatorB", 300)}
+	data, err := cdc.Marshal(&stakingtypes.QueryValidatorsResponse{Validators: validators})
+	suite.Require().NoError(err)
+
+	// the response is ignored while no round is in progress
+	suite.Require().NoError(keeper.ValidatorSetCallback(k, ctx, data, icqtypes.Query{ChainId: hc.ChainId}))
+	suite.Require().Empty(k.GetValidatorSetCandidates(ctx, hc.ChainId))
+
+	hc.Flags = &types.HostChainFlags{}
+	hc.ValidatorSetConfig = &types.ValidatorSetConfig{Enabled: true, AutoApply: true, MaxValidators: 2}
+	k.SetHostChain(ctx, hc)
+	suite.Require().NoError(k.StartValidatorSetRound(ctx, hc, k.GetEpochNumber(ctx, types.DelegationEpoch)))
+
+	suite.Require().NoError(keeper.ValidatorSetCallback(k, ctx, data, icqtypes.Query{ChainId: hc.ChainId}))
+	suite.Require().Len(k.GetValidatorSetCandidates(ctx, hc.ChainId), 2)
+
+	for _, validator := range validators {
+		_, valAddr, err := bech32.DecodeAndConvert(validator.OperatorAddress)
+		suite.Require().NoError(err)
+		suite.Require().NoError(keeper.ValidatorSetCandidateCallback(
+			k,
+			ctx,
+			stakingtypes.MustMarshalValidator(cdc, &validator),
+			icqtypes.Query{ChainId: hc.ChainId, Request: stakingtypes.GetValidatorKey(valAddr)},
+		))
+
+		consAddr, err := validator.GetConsAddr()
+		suite.Require().NoError(err)
+		signingInfo := slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Time{}, false, 0)
+		suite.Require().NoError(keeper.ValidatorSetCandidateSigningInfoCallback(
+			k,
+			ctx,
+			cdc.MustMarshal(&signingInfo),
+			icqtypes.Query{ChainId: hc.ChainId, Request: slashingtypes.ValidatorSigningInfoKey(consAddr)},
+		))
+	}
+
+	slashingParams := slashingtypes.DefaultParams()
+	suite.Require().NoError(keeper.SlashingParamsCallback(
+		k,
+		ctx,
+		cdc.MustMarshal(&slashingParams),
+		icqtypes.Query{ChainId: hc.ChainId, Request: slashingtypes.ParamsKey},
+	))
+
+	// the auto applied proposal waits for the update timelock
+	epoch := k.GetEpochNumber(ctx, types.DelegationEpoch)
+	updates := k.GetTimelockedUpdates(ctx, hc.ChainId)
+	suite.Require().Len(updates, 1)
+	suite.Require().Equal(types.KeyApplyValidatorSet, updates[0].Updates[0].Key)
+	suite.Require().Equal(epoch+types.DefaultUpdateTimelockEpochs, updates[0].ExecutionEpoch)
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	for _, validator := range validators {
+		_, found := hc.GetValidator(validator.OperatorAddress)
+		suite.Require().False(found)
+	}
+
+	// once applied, the proposal is removed
+	k.TimelockWorkflow(ctx, epoch+types.DefaultUpdateTimelockEpochs)
+	suite.Require().Empty(k.GetTimelockedUpdates(ctx, hc.ChainId))
+	_, found = k.GetValidatorSetProposal(ctx, hc.ChainId)
+	suite.Require().False(found)
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	for _, validator := range validators {
+		registered, found := hc.GetValidator(validator.OperatorAddress)
+		suite.Require().True(found)
+		suite.Require().True(registered.Weight.IsPositive())
+	}
+
+	suite.Require().Error(keeper.ValidatorSetCallback(k, ctx, data, icqtypes.Query{ChainId: "invalid-1"}))
+	suite.Require().Error(keeper.ValidatorSetCallback(k, ctx, []byte("invalid data"), icqtypes.Query{ChainId: hc.ChainId}))
+	suite.Require().Error(keeper.ValidatorSetCandidateCallback(
+		k,
+		ctx,
+		[]byte("invalid data"),
+		icqtypes.Query{ChainId: hc.ChainId, Request: stakingtypes.GetValidatorKey(sdk.ValAddress("validatorA"))},
+	))
+	suite.Require().Error(keeper.SlashingParamsCallback(
+		k,
+		ctx,
+		cdc.MustMarshal(&slashingtypes.Params{}),
+		icqtypes.Query{ChainId: hc.ChainId, Request: slashingtypes.ParamsKey},
+	))
+}
+
+func (suite *IntegrationTestSuite) TestValidatorSigningInfoCallback() {
+	pstakeApp, ctx := suite.app, suite.ctx
+	k := pstakeApp.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	consAddr := sdk.ConsAddress("validatorConsAddr")
+	consAddrBech32, err := bech32.ConvertAndEncode("cosmosvalcons", consAddr)
+	suite.Require().NoError(err)
+
+	val := hc.Validators[0]
+	val.ConsensusAddress = consAddrBech32
+	val.Delegable = true
+	k.SetHostChainValidator(ctx, hc, val)
+
+	makeData := func(address string, tombstoned bool) []byte {
+		return pstakeApp.AppCodec().MustMarshal(&slashingtypes.ValidatorSigningInfo{
+			Address:    address,
+			Tombstoned: tombstoned,
+		})
+	}
+
+	suite.Require().NoError(
+		keeper.ValidatorSigningInfoCallback(k, ctx, makeData(consAddrBech32, true), icqtypes.Query{ChainId: hc.ChainId}),
+	)
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	updated, _ := hc.GetValidator(val.OperatorAddress)
+	suite.Require().True(updated.Tombstoned)
+	suite.Require().False(updated.Delegable)
+
+	unknown, err := bech32.ConvertAndEncode("cosmosvalcons", sdk.ConsAddress("unknownConsAddr"))
+	suite.Require().NoError(err)
+	suite.Require().Error(
+		keeper.ValidatorSigningInfoCallback(k, ctx, makeData(unknown, true), icqtypes.Query{ChainId: hc.ChainId}),
+	)
+	suite.Require().Error(
+		keeper.ValidatorSigningInfoCallback(k, ctx, makeData(consAddrBech32, true), icqtypes.Query{ChainId: "invalid-1"}),
+	)
+	suite.Require().Error(
+		keeper.ValidatorSigningInfoCallback(k, ctx, []byte("invalid data"), icqtypes.Query{ChainId: hc.ChainId}),
+	)
+}
+
+func (suite *IntegrationTestSuite) TestStakingParamsCallback() {
+	pstakeApp, ctx := suite.app, suite.ctx
+	k := pstakeApp.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.DefaultHostUnbondingPeriod, hc.HostUnbondingPeriod())
+
+	params := stakingtypes.DefaultParams()
+	params.UnbondingTime = 14 * 24 * time.Hour
+	data, err := pstakeApp.AppCodec().Marshal(&stakingtypes.QueryParamsResponse{Params: params})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(keeper.StakingParamsCallback(k, ctx, data, icqtypes.Query{ChainId: hc.ChainId}))
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(params.UnbondingTime, hc.UnbondingPeriod)
+	suite.Require().Equal(params.UnbondingTime, hc.HostUnbondingPeriod())
+
+	params.UnbondingTime = 0
+	data, err = pstakeApp.AppCodec().Marshal(&stakingtypes.QueryParamsResponse{Params: params})
+	suite.Require().NoError(err)
+
+	suite.Require().Error(keeper.StakingParamsCallback(k, ctx, data, icqtypes.Query{ChainId: hc.ChainId}))
+	suite.Require().Error(keeper.StakingParamsCallback(k, ctx, data, icqtypes.Query{ChainId: "invalid-1"}))
+	suite.Require().Error(keeper.StakingParamsCallback(k, ctx, []byte("invalid data"), icqtypes.Query{ChainId: hc.ChainId}))
+}
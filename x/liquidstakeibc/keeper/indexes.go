@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// setSequenceIndex adds the store key of a record to the ibc sequence id index, records without sequence id
+// are not indexed
+func (k *Keeper) setSequenceIndex(ctx sdk.Context, indexKey []byte, sequenceID string, storeKey []byte) {
+	if sequenceID == "" {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexKey)
+	store.Set(types.GetSequenceIndexKey(sequenceID, storeKey), []byte{})
+}
+
+func (k *Keeper) deleteSequenceIndex(ctx sdk.Context, indexKey []byte, sequenceID string, storeKey []byte) {
+	if sequenceID == "" {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexKey)
+	store.Delete(types.GetSequenceIndexKey(sequenceID, storeKey))
+}
+
+// setStateIndex adds the store key of a record to the chain id and state index
+func (k *Keeper) setStateIndex(ctx sdk.Context, indexKey []byte, chainID string, state int32, storeKey []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexKey)
+	store.Set(types.GetStateIndexKey(chainID, state, storeKey), []byte{})
+}
+
+func (k *Keeper) deleteStateIndex(ctx sdk.Context, indexKey []byte, chainID string, state int32, storeKey []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexKey)
+	store.Delete(types.GetStateIndexKey(chainID, state, storeKey))
+}
+
+// setEpochIndex adds the store key of a record to the chain id and epoch index
+func (k *Keeper) setEpochIndex(ctx sdk.Context, indexKey []byte, chainID string, epochNumber int64, storeKey []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexKey)
+	store.Set(types.GetEpochIndexKey(chainID, epochNumber, storeKey), []byte{})
+}
+
+func (k *Keeper) deleteEpochIndex(ctx sdk.Context, indexKey []byte, chainID string, epochNumber int64, storeKey []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexKey)
+	store.Delete(types.GetEpochIndexKey(chainID, epochNumber, storeKey))
+}
+
+// getIndexedStoreKeys returns the record store keys found under an index entry prefix
+func (k *Keeper) getIndexedStoreKeys(ctx sdk.Context, indexKey []byte, entryPrefix []byte) [][]byte {
+	store := prefix.NewStore(prefix.NewStore(ctx.KVStore(k.storeKey), indexKey), entryPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	storeKeys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		storeKeys = append(storeKeys, iterator.Key())
+	}
+
+	return storeKeys
+}
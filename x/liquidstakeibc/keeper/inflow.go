@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// GetEpochInflow returns the amount liquid staked on a host chain during an epoch
+func (k *Keeper) GetEpochInflow(ctx sdk.Context, chainID string, epoch int64) math.Int {
+	return k.getEpochAmount(ctx, types.InflowKey, types.GetInflowEpochPrefix(chainID, epoch))
+}
+
+// GetAddressEpochInflow returns the amount liquid staked by an address on a host chain during an epoch
+func (k *Keeper) GetAddressEpochInflow(ctx sdk.Context, chainID string, epoch int64, delegatorAddress string) math.Int {
+	return k.getEpochAmount(ctx, types.InflowKey, types.GetInflowStoreKey(chainID, epoch, delegatorAddress))
+}
+
+// AddInflow increases both the host chain and the address inflows for an epoch
+func (k *Keeper) AddInflow(ctx sdk.Context, chainID string, epoch int64, delegatorAddress string, amount math.Int) {
+	epochKey := types.GetInflowEpochPrefix(chainID, epoch)
+	k.setEpochAmount(ctx, types.InflowKey, epochKey, k.getEpochAmount(ctx, types.InflowKey, epochKey).Add(amount))
+
+	addressKey := types.GetInflowStoreKey(chainID, epoch, delegatorAddress)
+	k.setEpochAmount(ctx, types.InflowKey, addressKey, k.getEpochAmount(ctx, types.InflowKey, addressKey).Add(amount))
+}
+
+// SetInflow sets the inflow of a host chain for an epoch, or the inflow of an address if it is set
+func (k *Keeper) SetInflow(ctx sdk.Context, inflow *types.Inflow) {
+	key := types.GetInflowEpochPrefix(inflow.ChainId, inflow.Epoch)
+	if inflow.Address != "" {
+		key = types.GetInflowStoreKey(inflow.ChainId, inflow.Epoch, inflow.Address)
+	}
+	k.setEpochAmount(ctx, types.InflowKey, key, inflow.Amount)
+}
+
+// GetAllInflows returns the host chain and address inflows of all the host chains
+func (k *Keeper) GetAllInflows(ctx sdk.Context) []*types.Inflow {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InflowKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	inflows := make([]*types.Inflow, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		chainID, epoch, address, err := types.ParseInflowStoreKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		var amount sdk.IntProto
+		k.cdc.MustUnmarshal(iterator.Value(), &amount)
+		inflows = append(inflows, &types.Inflow{
+			ChainId: chainID,
+			Epoch:   epoch,
+			Address: address,
+			Amount:  amount.Int,
+		})
+	}
+
+	return inflows
+}
+
+// DeleteInflowsBeforeEpoch removes the inflows of a host chain for all the epochs previous to the one provided
+func (k *Keeper) DeleteInflowsBeforeEpoch(ctx sdk.Context, chainID string, epoch int64) {
+	k.deleteEpochAmounts(
+		ctx,
+		types.InflowKey,
+		types.GetInflowChainPrefix(chainID),
+		types.GetInflowEpochPrefix(chainID, epoch),
+	)
+}
+
+// GetLiquidStakedAmount returns the amount of host chain tokens backing the minted stk tokens
+func (k *Keeper) GetLiquidStakedAmount(ctx sdk.Context, hc *types.HostChain) math.Int {
+	return k.GetLSMDepositAmountUntokenized(ctx, hc.ChainId).
+		Add(hc.GetHostChainTotalDelegations()).
+		Add(k.GetDepositAmountOnPersistence(ctx, hc.ChainId)).
+		Add(k.GetDepositAmountOnHostChain(ctx, hc.ChainId)).
+		Add(k.GetAllValidatorUnbondedAmount(ctx, hc))
+}
+
+// GetLiquidStakeCapacity computes how much more can be liquid staked on a host chain under each of its caps,
+// a nil remaining amount means the cap is disabled
+func (k *Keeper) GetLiquidStakeCapacity(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	delegatorAddress string,
+) *types.QueryLiquidStakeCapacityResponse {
+	epoch := k.GetEpochNumber(ctx, types.DelegationEpoch)
+
+	capacity := &types.QueryLiquidStakeCapacityResponse{
+		Epoch:              epoch,
+		Tvl:                k.GetLiquidStakedAmount(ctx, hc),
+		EpochInflow:        k.GetEpochInflow(ctx, hc.ChainId, epoch),
+		AddressEpochInflow: sdk.ZeroInt(),
+	}
+	if delegatorAddress != "" {
+		capacity.AddressEpochInflow = k.GetAddressEpochInflow(ctx, hc.ChainId, epoch, delegatorAddress)
+	}
+
+	capacity.RemainingTvl = remainingCapacity(hc.Params.MaxTvl, capacity.Tvl)
+	capacity.RemainingEpochInflow = remainingCapacity(hc.Params.MaxEpochInflow, capacity.EpochInflow)
+	if delegatorAddress != "" {
+		capacity.RemainingAddressEpochInflow = remainingCapacity(
+			hc.Params.MaxAddressEpochInflow,
+			capacity.AddressEpochInflow,
+		)
+	}
+
+	for _, remaining := range []*math.Int{
+		capacity.RemainingTvl,
+		capacity.RemainingEpochInflow,
+		capacity.RemainingAddressEpochInflow,
+	} {
+		if remaining != nil && (capacity.Remaining == nil || remaining.LT(*capacity.Remaining)) {
+			capacity.Remaining = remaining
+		}
+	}
+
+	return capacity
+}
+
+// ValidateLiquidStakeCaps checks that liquid staking an amount on a host chain doesn't exceed any of its caps
+func (k *Keeper) ValidateLiquidStakeCaps(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	delegatorAddress string,
+	amount math.Int,
+) error {
+	capacity := k.GetLiquidStakeCapacity(ctx, hc, delegatorAddress)
+
+	if capacity.RemainingTvl != nil && amount.GT(*capacity.RemainingTvl) {
+		return errorsmod.Wrapf(
+			types.ErrLiquidStakeCapExceeded,
+			"host chain %s tvl cap is %s, remaining %s, got %s",
+			hc.ChainId,
+			hc.Params.MaxTvl,
+			capacity.RemainingTvl,
+			amount,
+		)
+	}
+
+	if capacity.RemainingEpochInflow != nil && amount.GT(*capacity.RemainingEpochInflow) {
+		return errorsmod.Wrapf(
+			types.ErrLiquidStakeCapExceeded,
+			"host chain %s epoch inflow cap is %s, remaining %s, got %s",
+			hc.ChainId,
+			hc.Params.MaxEpochInflow,
+			capacity.RemainingEpochInflow,
+			amount,
+		)
+	}
+
+	if capacity.RemainingAddressEpochInflow != nil && amount.GT(*capacity.RemainingAddressEpochInflow) {
+		return errorsmod.Wrapf(
+			types.ErrLiquidStakeCapExceeded,
+			"host chain %s address epoch inflow cap is %s, remaining %s for %s, got %s",
+			hc.ChainId,
+			hc.Params.MaxAddressEpochInflow,
+			capacity.RemainingAddressEpochInflow,
+			delegatorAddress,
+			amount,
+		)
+	}
+
+	return nil
+}
+
+// getEpochAmount returns an amount accounted for an epoch in one of the inflow or outflow stores
+func (k *Keeper) getEpochAmount(ctx sdk.Context, storePrefix []byte, key []byte) math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+	bz := store.Get(key)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var amount sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &amount)
+	return amount.Int
+}
+
+func (k *Keeper) setEpochAmount(ctx sdk.Context, storePrefix []byte, key []byte, amount math.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+	store.Set(key, k.cdc.MustMarshal(&sdk.IntProto{Int: amount}))
+}
+
+// deleteEpochAmounts removes the entries of one of the inflow or outflow stores in the [start, end) key range
+func (k *Keeper) deleteEpochAmounts(ctx sdk.Context, storePrefix []byte, start, end []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+	iterator := store.Iterator(start, end)
+
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// remainingCapacity returns what is left under a cap given the amount already used, nil if the cap is disabled
+func remainingCapacity(limit, used math.Int) *math.Int {
+	if limit.IsNil() || !limit.IsPositive() {
+		return nil
+	}
+
+	remaining := sdk.ZeroInt()
+	if limit.GT(used) {
+		remaining = limit.Sub(used)
+	}
+
+	return &remaining
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestInflows() {
+	k := suite.app.LiquidStakeIBCKeeper
+	chainID := suite.chainB.ChainID
+
+	k.AddInflow(suite.ctx, chainID, 1, "address1", sdk.NewInt(100))
+	k.AddInflow(suite.ctx, chainID, 1, "address2", sdk.NewInt(200))
+	k.AddInflow(suite.ctx, chainID, 1, "address1", sdk.NewInt(50))
+	k.AddInflow(suite.ctx, chainID, 2, "address1", sdk.NewInt(10))
+	k.AddInflow(suite.ctx, "other-chain", 1, "address1", sdk.NewInt(1000))
+
+	suite.Require().Equal(sdk.NewInt(350), k.GetEpochInflow(suite.ctx, chainID, 1))
+	suite.Require().Equal(sdk.NewInt(150), k.GetAddressEpochInflow(suite.ctx, chainID, 1, "address1"))
+	suite.Require().Equal(sdk.NewInt(200), k.GetAddressEpochInflow(suite.ctx, chainID, 1, "address2"))
+	suite.Require().Equal(sdk.NewInt(10), k.GetEpochInflow(suite.ctx, chainID, 2))
+	suite.Require().True(k.GetAddressEpochInflow(suite.ctx, chainID, 3, "address1").IsZero())
+
+	// only the inflows of the chain previous to the epoch are removed
+	k.DeleteInflowsBeforeEpoch(suite.ctx, chainID, 2)
+
+	suite.Require().True(k.GetEpochInflow(suite.ctx, chainID, 1).IsZero())
+	suite.Require().True(k.GetAddressEpochInflow(suite.ctx, chainID, 1, "address1").IsZero())
+	suite.Require().Equal(sdk.NewInt(10), k.GetEpochInflow(suite.ctx, chainID, 2))
+	suite.Require().Equal(sdk.NewInt(1000), k.GetEpochInflow(suite.ctx, "other-chain", 1))
+}
+
+func (suite *IntegrationTestSuite) TestInflowsPrunedOnDelegationEpoch() {
+	k := suite.app.LiquidStakeIBCKeeper
+	epoch := k.GetEpochNumber(suite.ctx, types.DelegationEpoch)
+
+	k.AddInflow(suite.ctx, suite.chainB.ChainID, epoch, "address", sdk.NewInt(100))
+
+	err := k.BeforeEpochStart(suite.ctx, types.DelegationEpoch, epoch+1)
+	suite.Require().NoError(err)
+
+	suite.Require().True(k.GetEpochInflow(suite.ctx, suite.chainB.ChainID, epoch).IsZero())
+	suite.Require().True(k.GetAddressEpochInflow(suite.ctx, suite.chainB.ChainID, epoch, "address").IsZero())
+}
+
+func (suite *IntegrationTestSuite) TestGetLiquidStakeCapacity() {
+	k := suite.app.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	// no caps configured
+	capacity := k.GetLiquidStakeCapacity(suite.ctx, hc, "address")
+	suite.Require().Nil(capacity.RemainingTvl)
+	suite.Require().Nil(capacity.RemainingEpochInflow)
+	suite.Require().Nil(capacity.RemainingAddressEpochInflow)
+	suite.Require().Nil(capacity.Remaining)
+
+	epoch := k.GetEpochNumber(suite.ctx, types.DelegationEpoch)
+	k.AddInflow(suite.ctx, hc.ChainId, epoch, "address", sdk.NewInt(400))
+
+	hc.Params.MaxTvl = capacity.Tvl.AddRaw(500)
+	hc.Params.MaxEpochInflow = sdk.NewInt(300)
+	hc.Params.MaxAddressEpochInflow = sdk.NewInt(1000)
+
+	capacity = k.GetLiquidStakeCapacity(suite.ctx, hc, "address")
+	suite.Require().Equal(sdk.NewInt(500), *capacity.RemainingTvl)
+	suite.Require().True(capacity.RemainingEpochInflow.IsZero())
+	suite.Require().Equal(sdk.NewInt(600), *capacity.RemainingAddressEpochInflow)
+	suite.Require().True(capacity.Remaining.IsZero())
+
+	// the address cap is ignored without an address
+	capacity = k.GetLiquidStakeCapacity(suite.ctx, hc, "")
+	suite.Require().Nil(capacity.RemainingAddressEpochInflow)
+
+	err := k.ValidateLiquidStakeCaps(suite.ctx, hc, "address", sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrLiquidStakeCapExceeded)
+
+	hc.Params.MaxEpochInflow = sdk.ZeroInt()
+	suite.Require().NoError(k.ValidateLiquidStakeCaps(suite.ctx, hc, "address", sdk.NewInt(500)))
+	suite.Require().ErrorIs(
+		k.ValidateLiquidStakeCaps(suite.ctx, hc, "address", sdk.NewInt(501)),
+		types.ErrLiquidStakeCapExceeded,
+	)
+}
+
+func (suite *IntegrationTestSuite) TestSetGetAllInflows() {
+	k := suite.app.LiquidStakeIBCKeeper
+	chainID := suite.chainB.ChainID
+
+	inflows := []*types.Inflow{
+		{ChainId: chainID, Epoch: 1, Amount: sdk.NewInt(300)},
+		{ChainId: chainID, Epoch: 1, Address: "address1", Amount: sdk.NewInt(100)},
+		{ChainId: chainID, Epoch: 1, Address: "address2", Amount: sdk.NewInt(200)},
+	}
+	for _, inflow := range inflows {
+		k.SetInflow(suite.ctx, inflow)
+	}
+
+	suite.Require().Equal(inflows, k.GetAllInflows(suite.ctx))
+	suite.Require().Equal(sdk.NewInt(300), k.GetEpochInflow(suite.ctx, chainID, 1))
+	suite.Require().Equal(sdk.NewInt(100), k.GetAddressEpochInflow(suite.ctx, chainID, 1, "address1"))
+}
@@ -255,6 +255,10 @@ func (k *Keeper) UpdateCValues(ctx sdk.Context) {
 	hostChains := k.GetAllHostChains(ctx)
 
 	for _, hc := range hostChains {
+		// the c value of a chain being deregistered is fixed when its stk holders are unbonded
+		if hc.Deregistration != nil {
+			continue
+		}
 
 		// total stk tokens minted
 		mintedAmount := k.bankKeeper.GetSupply(ctx, hc.MintDenom()).Amount
@@ -303,34 +307,101 @@ func (k *Keeper) UpdateCValues(ctx sdk.Context) {
 		hc.CValue = cValue
 		k.SetHostChain(ctx, hc)
 
+		k.RecordCValue(ctx, &types.CValueRecord{
+			ChainId:                  hc.ChainId,
+			Epoch:                    k.GetEpochNumber(ctx, types.CValueEpoch),
+			Height:                   ctx.BlockHeight(),
+			Time:                     ctx.BlockTime(),
+			CValue:                   cValue,
+			MintedAmount:             mintedAmount,
+			StakedAmount:             stakedAmount,
+			AmountOnPersistence:      amountOnPersistence,
+			AmountOnHostChain:        amountOnHostChain,
+			TokenizedStakedAmount:    tokenizedStakedAmount,
+			ValidatorUnbondingAmount: totalUnbondingAmount,
+		})
+
 		defer func() {
 			cValueFloat, _ := hc.CValue.Float64()
 			telemetry.ModuleSetGauge(types.ModuleName, float32(cValueFloat), hc.ChainId, "c_value")
 		}()
 
-		// if the c value is out of bounds, disable the chain
-		if !k.CValueWithinLimits(ctx, hc) {
-			hc.Active = false
-			k.SetHostChain(ctx, hc)
+		k.UpdateCValueCooldown(ctx, hc)
+	}
+}
 
-			defer func() {
-				telemetry.ModuleSetGauge(types.ModuleName, float32(0), hc.ChainId, "active")
-			}()
+// UpdateCValueCooldown trips the c value circuit breaker of the host chain if its c value is out
+// of limits or changed too much, and releases it after enough consecutive in-range computations
+func (k *Keeper) UpdateCValueCooldown(ctx sdk.Context, hc *types.HostChain) {
+	reason := types.CValueCooldown_COOLDOWN_REASON_OUT_OF_LIMITS
+	inRange := k.CValueWithinLimits(ctx, hc)
+	if inRange && hc.CValueDeltaExceeded() {
+		reason = types.CValueCooldown_COOLDOWN_REASON_DELTA_EXCEEDED
+		inRange = false
+	}
 
-			k.Logger(ctx).Error(fmt.Sprintf("C value out of limits !!! Disabling chain %s with c value %v.", hc.ChainId, hc.CValue))
+	switch {
+	case hc.CValueCooldown == nil && !inRange:
+		// the c value is out of bounds, put the chain in cooldown
+		hc.CValueCooldown = &types.CValueCooldown{
+			Reason: reason,
+			CValue: hc.CValue,
+			Height: ctx.BlockHeight(),
+		}
+		k.SetHostChain(ctx, hc)
+
+		k.Logger(ctx).Error(
+			fmt.Sprintf(
+				"C value circuit breaker tripped !!! Chain %s in cooldown with c value %v, reason %s.",
+				hc.ChainId,
+				hc.CValue,
+				reason,
+			),
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeChainDisabled,
+				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+				sdk.NewAttribute(types.AttributeCValue, hc.CValue.String()),
+				sdk.NewAttribute(types.AttributeReason, reason.String()),
+			),
+		)
+	case hc.CValueCooldown != nil && !inRange:
+		// any out of bounds c value restarts the recovery
+		hc.CValueCooldown.InRangeCount = 0
+		k.SetHostChain(ctx, hc)
+	case hc.CValueCooldown != nil && inRange:
+		hc.CValueCooldown.InRangeCount++
+
+		// the recovery is manual if the host chain has no recovery count
+		recoveryCount := hc.Params.CValueRecoveryCount
+		if recoveryCount > 0 && hc.CValueCooldown.InRangeCount >= recoveryCount {
+			hc.CValueCooldown = nil
+
+			k.Logger(ctx).Info(fmt.Sprintf("C value circuit breaker released for chain %s with c value %v.", hc.ChainId, hc.CValue))
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
-					types.EventTypeChainDisabled,
+					types.EventTypeChainRecovered,
 					sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
 					sdk.NewAttribute(types.AttributeCValue, hc.CValue.String()),
 				),
 			)
 		}
+		k.SetHostChain(ctx, hc)
+	}
+
+	cooldown := float32(0)
+	if hc.CValueCooldown != nil {
+		cooldown = 1
 	}
+	telemetry.ModuleSetGauge(types.ModuleName, cooldown, hc.ChainId, "c_value_cooldown")
 }
 
+// CValueWithinLimits returns true if the host chain c value is within its c value limits
 func (k *Keeper) CValueWithinLimits(ctx sdk.Context, hc *types.HostChain) bool {
-	return hc.CValue.LT(k.GetParams(ctx).UpperCValueLimit) && hc.CValue.GT(k.GetParams(ctx).LowerCValueLimit)
+	params := k.GetParams(ctx)
+	lower, upper := hc.CValueLimits(params.LowerCValueLimit, params.UpperCValueLimit)
+	return hc.CValue.LT(upper) && hc.CValue.GT(lower)
 }
 
 func (k *Keeper) CalculateAutocompoundLimit(autocompoundFactor sdk.Dec) sdk.Dec {
@@ -276,5 +276,71 @@ func (suite *IntegrationTestSuite) TestUpdateCValues() {
 	pstakeApp.LiquidStakeIBCKeeper.SetParams(ctx, params)
 	suite.Require().NotPanics(func() { pstakeApp.LiquidStakeIBCKeeper.UpdateCValues(ctx) })
 	hc, _ = pstakeApp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
-	suite.Require().Equal(false, hc.Active)
+	suite.Require().Equal(true, hc.Active)
+	suite.Require().NotNil(hc.CValueCooldown)
+	suite.Require().Equal(types.CValueCooldown_COOLDOWN_REASON_OUT_OF_LIMITS, hc.CValueCooldown.Reason)
+}
+
+func (suite *IntegrationTestSuite) TestUpdateCValueCooldown() {
+	pstakeApp, ctx := suite.app, suite.ctx
+	hc, found := pstakeApp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().Equal(true, found)
+
+	// per chain limits take precedence over the module ones
+	hc.Params.LowerCValueLimit = sdk.MustNewDecFromStr("0.9")
+	hc.Params.UpperCValueLimit = sdk.MustNewDecFromStr("1.1")
+	hc.Params.MaxCValueDelta = sdk.MustNewDecFromStr("0.05")
+	hc.Params.CValueRecoveryCount = 2
+	hc.CValueCooldown = nil
+
+	hc.LastCValue = sdk.OneDec()
+	hc.CValue = sdk.MustNewDecFromStr("1.2")
+	pstakeApp.LiquidStakeIBCKeeper.UpdateCValueCooldown(ctx, hc)
+	suite.Require().NotNil(hc.CValueCooldown)
+	suite.Require().Equal(types.CValueCooldown_COOLDOWN_REASON_OUT_OF_LIMITS, hc.CValueCooldown.Reason)
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.2"), hc.CValueCooldown.CValue)
+
+	// the chain rejects user operations while in cooldown
+	msgLiquidStake := types.NewMsgLiquidStake(sdk.NewInt64Coin(hc.IBCDenom(), 1000000), suite.chainA.SenderAccount.GetAddress())
+	_, err := suite.app.MsgServiceRouter().Handler(msgLiquidStake)(ctx, msgLiquidStake)
+	suite.Require().ErrorIs(err, types.ErrCValueCooldown)
+
+	// in range computations count towards the recovery
+	hc.LastCValue = sdk.OneDec()
+	hc.CValue = sdk.OneDec()
+	pstakeApp.LiquidStakeIBCKeeper.UpdateCValueCooldown(ctx, hc)
+	suite.Require().NotNil(hc.CValueCooldown)
+	suite.Require().Equal(uint32(1), hc.CValueCooldown.InRangeCount)
+
+	// an out of range computation restarts the recovery
+	hc.CValue = sdk.MustNewDecFromStr("0.85")
+	pstakeApp.LiquidStakeIBCKeeper.UpdateCValueCooldown(ctx, hc)
+	suite.Require().NotNil(hc.CValueCooldown)
+	suite.Require().Equal(uint32(0), hc.CValueCooldown.InRangeCount)
+
+	hc.LastCValue = sdk.OneDec()
+	hc.CValue = sdk.OneDec()
+	pstakeApp.LiquidStakeIBCKeeper.UpdateCValueCooldown(ctx, hc)
+	pstakeApp.LiquidStakeIBCKeeper.UpdateCValueCooldown(ctx, hc)
+	suite.Require().Nil(hc.CValueCooldown)
+
+	// a c value jump within limits trips the breaker as well
+	hc.LastCValue = sdk.MustNewDecFromStr("0.92")
+	hc.CValue = sdk.MustNewDecFromStr("1.05")
+	pstakeApp.LiquidStakeIBCKeeper.UpdateCValueCooldown(ctx, hc)
+	suite.Require().NotNil(hc.CValueCooldown)
+	suite.Require().Equal(types.CValueCooldown_COOLDOWN_REASON_DELTA_EXCEEDED, hc.CValueCooldown.Reason)
+
+	// the recovery is manual without a recovery count
+	hc.Params.CValueRecoveryCount = 0
+	hc.LastCValue = sdk.OneDec()
+	hc.CValue = sdk.OneDec()
+	for i := 0; i < 5; i++ {
+		pstakeApp.LiquidStakeIBCKeeper.UpdateCValueCooldown(ctx, hc)
+	}
+	suite.Require().NotNil(hc.CValueCooldown)
+
+	stored, _ := pstakeApp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().NotNil(stored.CValueCooldown)
+	suite.Require().Equal(uint32(5), stored.CValueCooldown.InRangeCount)
 }
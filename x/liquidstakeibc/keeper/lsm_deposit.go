@@ -10,8 +10,63 @@ import (
 
 func (k *Keeper) SetLSMDeposit(ctx sdk.Context, deposit *liquidstakeibctypes.LSMDeposit) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.LSMDepositKey)
+	storeKey := liquidstakeibctypes.GetLSMDepositStoreKey(deposit.ChainId, deposit.DelegatorAddress, deposit.Denom)
+
+	// remove the index entries of the previous version of the deposit
+	if bz := store.Get(storeKey); bz != nil {
+		previous := liquidstakeibctypes.LSMDeposit{}
+		k.cdc.MustUnmarshal(bz, &previous)
+		k.deleteLSMDepositIndexes(ctx, &previous, storeKey)
+	}
+
 	bytes := k.cdc.MustMarshal(deposit)
-	store.Set(liquidstakeibctypes.GetLSMDepositStoreKey(deposit.ChainId, deposit.DelegatorAddress, deposit.Denom), bytes)
+	store.Set(storeKey, bytes)
+	k.setLSMDepositIndexes(ctx, deposit, storeKey)
+}
+
+func (k *Keeper) setLSMDepositIndexes(ctx sdk.Context, deposit *liquidstakeibctypes.LSMDeposit, storeKey []byte) {
+	k.setSequenceIndex(ctx, liquidstakeibctypes.LSMDepositSequenceIndexKey, deposit.IbcSequenceId, storeKey)
+	k.setStateIndex(ctx, liquidstakeibctypes.LSMDepositStateIndexKey, deposit.ChainId, int32(deposit.State), storeKey)
+}
+
+func (k *Keeper) deleteLSMDepositIndexes(ctx sdk.Context, deposit *liquidstakeibctypes.LSMDeposit, storeKey []byte) {
+	k.deleteSequenceIndex(ctx, liquidstakeibctypes.LSMDepositSequenceIndexKey, deposit.IbcSequenceId, storeKey)
+	k.deleteStateIndex(ctx, liquidstakeibctypes.LSMDepositStateIndexKey, deposit.ChainId, int32(deposit.State), storeKey)
+}
+
+// getLSMDepositsFromStoreKeys loads the LSM deposits referenced by an index
+func (k *Keeper) getLSMDepositsFromStoreKeys(ctx sdk.Context, storeKeys [][]byte) []*liquidstakeibctypes.LSMDeposit {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.LSMDepositKey)
+
+	deposits := make([]*liquidstakeibctypes.LSMDeposit, 0, len(storeKeys))
+	for _, storeKey := range storeKeys {
+		bz := store.Get(storeKey)
+		if bz == nil {
+			continue
+		}
+
+		deposit := &liquidstakeibctypes.LSMDeposit{}
+		k.cdc.MustUnmarshal(bz, deposit)
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits
+}
+
+// GetLSMDepositsForChainAndState returns the LSM deposits of a host chain in a given state using the state index
+func (k *Keeper) GetLSMDepositsForChainAndState(
+	ctx sdk.Context,
+	chainID string,
+	state liquidstakeibctypes.LSMDeposit_LSMDepositState,
+) []*liquidstakeibctypes.LSMDeposit {
+	return k.getLSMDepositsFromStoreKeys(
+		ctx,
+		k.getIndexedStoreKeys(
+			ctx,
+			liquidstakeibctypes.LSMDepositStateIndexKey,
+			liquidstakeibctypes.GetStateIndexPrefix(chainID, int32(state)),
+		),
+	)
 }
 
 func (k *Keeper) GetLSMDeposit(ctx sdk.Context, chainID, delegator, denom string) (*liquidstakeibctypes.LSMDeposit, bool) {
@@ -36,35 +91,36 @@ func (k *Keeper) GetLSMDepositsFromIbcDenom(ctx sdk.Context, ibcDenom string) []
 }
 
 func (k *Keeper) GetLSMDepositsFromIbcSequenceID(ctx sdk.Context, ibcSequenceID string) []*liquidstakeibctypes.LSMDeposit {
-	return k.FilterLSMDeposits(
+	return k.getLSMDepositsFromStoreKeys(
 		ctx,
-		func(d liquidstakeibctypes.LSMDeposit) bool {
-			return d.IbcSequenceId == ibcSequenceID
-		},
+		k.getIndexedStoreKeys(
+			ctx,
+			liquidstakeibctypes.LSMDepositSequenceIndexKey,
+			liquidstakeibctypes.GetSequenceIndexPrefix(ibcSequenceID),
+		),
 	)
 }
 
 func (k *Keeper) GetTransferableLSMDeposits(ctx sdk.Context, chainID string) []*liquidstakeibctypes.LSMDeposit {
-	return k.FilterLSMDeposits(
-		ctx,
-		func(d liquidstakeibctypes.LSMDeposit) bool {
-			return d.ChainId == chainID && d.State == liquidstakeibctypes.LSMDeposit_DEPOSIT_PENDING
-		},
-	)
+	return k.GetLSMDepositsForChainAndState(ctx, chainID, liquidstakeibctypes.LSMDeposit_DEPOSIT_PENDING)
 }
 
 func (k *Keeper) GetRedeemableLSMDeposits(ctx sdk.Context, chainID string) []*liquidstakeibctypes.LSMDeposit {
-	return k.FilterLSMDeposits(
-		ctx,
-		func(d liquidstakeibctypes.LSMDeposit) bool {
-			return d.ChainId == chainID && d.State == liquidstakeibctypes.LSMDeposit_DEPOSIT_RECEIVED
-		},
-	)
+	return k.GetLSMDepositsForChainAndState(ctx, chainID, liquidstakeibctypes.LSMDeposit_DEPOSIT_RECEIVED)
 }
 
 func (k *Keeper) DeleteLSMDeposit(ctx sdk.Context, deposit *liquidstakeibctypes.LSMDeposit) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.LSMDepositKey)
-	store.Delete(liquidstakeibctypes.GetLSMDepositStoreKey(deposit.ChainId, deposit.DelegatorAddress, deposit.Denom))
+	storeKey := liquidstakeibctypes.GetLSMDepositStoreKey(deposit.ChainId, deposit.DelegatorAddress, deposit.Denom)
+
+	// the indexes are built from the stored deposit, which might differ from the one provided
+	if bz := store.Get(storeKey); bz != nil {
+		stored := liquidstakeibctypes.LSMDeposit{}
+		k.cdc.MustUnmarshal(bz, &stored)
+		k.deleteLSMDepositIndexes(ctx, &stored, storeKey)
+	}
+
+	store.Delete(storeKey)
 }
 
 func (k *Keeper) RevertLSMDepositsState(ctx sdk.Context, deposits []*liquidstakeibctypes.LSMDeposit) {
@@ -112,6 +168,25 @@ func (k *Keeper) FilterLSMDeposits(
 	return deposits
 }
 
+// GetLSMDepositsForHostChain returns all the LSM deposits of a host chain iterating over its store key prefix
+func (k *Keeper) GetLSMDepositsForHostChain(ctx sdk.Context, chainID string) []*liquidstakeibctypes.LSMDeposit {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), liquidstakeibctypes.LSMDepositKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(chainID))
+	defer iterator.Close()
+
+	deposits := make([]*liquidstakeibctypes.LSMDeposit, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		deposit := liquidstakeibctypes.LSMDeposit{}
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		// the prefix is shared with the chain ids it is a prefix of
+		if deposit.ChainId == chainID {
+			deposits = append(deposits, &deposit)
+		}
+	}
+
+	return deposits
+}
+
 func (k *Keeper) GetLSMDepositAmountUntokenized(ctx sdk.Context, chainID string) math.Int {
 	amount := sdk.ZeroInt()
 
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestMigrate2to3() {
+	pstakeApp, ctx := suite.app, suite.ctx
+	cdc := pstakeApp.AppCodec()
+	kvStore := ctx.KVStore(pstakeApp.GetKey(types.StoreKey))
+
+	// write the records as v2 did, without any index entries
+	deposit := &types.Deposit{
+		ChainId:       suite.chainB.ChainID,
+		Amount:        sdk.NewInt64Coin("uatom", 100),
+		Epoch:         100,
+		State:         types.Deposit_DEPOSIT_SENT,
+		IbcSequenceId: "channel-0-sequence-100",
+	}
+	prefix.NewStore(kvStore, types.DepositKey).Set(
+		types.GetDepositStoreKey(deposit.ChainId, deposit.Epoch),
+		cdc.MustMarshal(deposit),
+	)
+
+	unbonding := &types.Unbonding{
+		ChainId:       suite.chainB.ChainID,
+		EpochNumber:   100,
+		BurnAmount:    sdk.NewInt64Coin("stk/uatom", 10),
+		UnbondAmount:  sdk.NewInt64Coin("uatom", 10),
+		IbcSequenceId: "channel-0-sequence-101",
+		State:         types.Unbonding_UNBONDING_INITIATED,
+	}
+	prefix.NewStore(kvStore, types.UnbondingKey).Set(
+		types.GetUnbondingStoreKey(unbonding.ChainId, unbonding.EpochNumber),
+		cdc.MustMarshal(unbonding),
+	)
+
+	lsmDeposit := &types.LSMDeposit{
+		ChainId:          suite.chainB.ChainID,
+		Amount:           sdk.NewInt(1000),
+		Shares:           sdk.NewDec(1000),
+		Denom:            "cosmosvaloper1/1",
+		DelegatorAddress: "persistence1",
+		State:            types.LSMDeposit_DEPOSIT_RECEIVED,
+		IbcSequenceId:    "channel-0-sequence-102",
+	}
+	prefix.NewStore(kvStore, types.LSMDepositKey).Set(
+		types.GetLSMDepositStoreKey(lsmDeposit.ChainId, lsmDeposit.DelegatorAddress, lsmDeposit.Denom),
+		cdc.MustMarshal(lsmDeposit),
+	)
+
+	validatorUnbonding := &types.ValidatorUnbonding{
+		ChainId:          suite.chainB.ChainID,
+		EpochNumber:      100,
+		ValidatorAddress: "cosmosvaloper1",
+		Amount:           sdk.NewInt64Coin("uatom", 10),
+		IbcSequenceId:    "channel-0-sequence-103",
+	}
+	prefix.NewStore(kvStore, types.ValidatorUnbondingKey).Set(
+		types.GetValidatorUnbondingStoreKey(
+			validatorUnbonding.ChainId,
+			validatorUnbonding.ValidatorAddress,
+			validatorUnbonding.EpochNumber,
+		),
+		cdc.MustMarshal(validatorUnbonding),
+	)
+
+	userUnbonding := &types.UserUnbonding{
+		ChainId:      suite.chainB.ChainID,
+		EpochNumber:  100,
+		Address:      "persistence1",
+		StkAmount:    sdk.NewInt64Coin("stk/uatom", 10),
+		UnbondAmount: sdk.NewInt64Coin("uatom", 10),
+	}
+	prefix.NewStore(kvStore, types.UserUnbondingKey).Set(
+		types.GetUserUnbondingStoreKey(userUnbonding.ChainId, userUnbonding.Address, userUnbonding.EpochNumber),
+		cdc.MustMarshal(userUnbonding),
+	)
+
+	k := pstakeApp.LiquidStakeIBCKeeper
+	suite.Require().Len(k.GetDepositsWithSequenceID(ctx, deposit.IbcSequenceId), 0)
+
+	// write the params as v2 did, without the params added in v3
+	params := k.GetParams(ctx)
+	params.MaxRecordsPerBlock = 0
+	params.UpdateTimelockEpochs = 0
+	params.CValueHistoryLength = 0
+	kvStore.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	suite.Require().NoError(keeper.NewMigrator(k).Migrate2to3(ctx))
+
+	suite.Require().Equal([]*types.Deposit{deposit}, k.GetDepositsWithSequenceID(ctx, deposit.IbcSequenceId))
+	suite.Require().Equal(
+		[]*types.Deposit{deposit},
+		k.GetDepositsForChainAndState(ctx, deposit.ChainId, types.Deposit_DEPOSIT_SENT),
+	)
+	suite.Require().Equal([]*types.Unbonding{unbonding}, k.GetUnbondingsWithSequenceID(ctx, unbonding.IbcSequenceId))
+	suite.Require().Equal(
+		[]*types.Unbonding{unbonding},
+		k.GetUnbondingsForChainAndState(ctx, unbonding.ChainId, types.Unbonding_UNBONDING_INITIATED),
+	)
+	suite.Require().Equal(
+		[]*types.LSMDeposit{lsmDeposit},
+		k.GetLSMDepositsFromIbcSequenceID(ctx, lsmDeposit.IbcSequenceId),
+	)
+	suite.Require().Equal([]*types.LSMDeposit{lsmDeposit}, k.GetRedeemableLSMDeposits(ctx, lsmDeposit.ChainId))
+	suite.Require().Equal(
+		[]*types.ValidatorUnbonding{validatorUnbonding},
+		k.GetValidatorUnbondingsWithSequenceID(ctx, validatorUnbonding.IbcSequenceId),
+	)
+	suite.Require().Equal(
+		[]*types.UserUnbonding{userUnbonding},
+		k.GetUserUnbondingsForEpoch(ctx, userUnbonding.ChainId, userUnbonding.EpochNumber),
+	)
+
+	suite.Require().Equal(types.DefaultMaxRecordsPerBlock, k.GetParams(ctx).MaxRecordsPerBlock)
+	suite.Require().Equal(types.DefaultUpdateTimelockEpochs, k.GetParams(ctx).UpdateTimelockEpochs)
+	suite.Require().Equal(types.DefaultCValueHistoryLength, k.GetParams(ctx).CValueHistoryLength)
+}
@@ -11,8 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 
@@ -58,6 +58,15 @@ func (k msgServer) RegisterHostChain(
 		RedemptionFee: msg.RedemptionFee,
 	}
 
+	// the stk tokens of a deregistered host chain with the same denom are still being redeemed
+	if _, found := k.GetRedemptionRecord(ctx, types.LiquidStakeDenomPrefix+"/"+msg.HostDenom); found {
+		return nil, errorsmod.Wrapf(
+			types.ErrRegisterFailed,
+			"the %s stk tokens of a deregistered host chain are still being redeemed",
+			msg.HostDenom,
+		)
+	}
+
 	hc := &types.HostChain{
 		ChainId:         chainID,
 		ConnectionId:    msg.ConnectionId,
@@ -81,6 +90,7 @@ func (k msgServer) RegisterHostChain(
 		Flags: &types.HostChainFlags{
 			Lsm: false,
 		},
+		RegistrationEpoch: k.GetEpochNumber(ctx, types.DelegationEpoch),
 	}
 
 	// save the host chain
@@ -126,10 +136,35 @@ func (k msgServer) UpdateHostChain(
 ) (*types.MsgUpdateHostChainResponse, error) {
 	ctx := sdktypes.UnwrapSDKContext(goCtx)
 
+	// an update without keys would pass the role checks of any signer
+	if len(msg.Updates) == 0 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "updates cannot be empty")
+	}
+
 	// authority needs to be either the gov module account (for proposals)
-	// or the module admin account (for normal txs)
-	if msg.Authority != k.authority && msg.Authority != k.GetParams(ctx).AdminAddress {
-		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "tx signer is not a module authority")
+	// or hold a module role that allows updating every key (for normal txs)
+	if msg.Authority != k.authority {
+		params := k.GetParams(ctx)
+		for _, update := range msg.Updates {
+			if !params.CanUpdateHostChainKey(msg.Authority, update.Key) {
+				return nil, errorsmod.Wrapf(
+					govtypes.ErrInvalidSigner,
+					"tx signer is not allowed to update the %s key",
+					update.Key,
+				)
+			}
+
+			// the guardian can only pause, lifting a pause is left to the admin
+			if update.Key == types.KeyPauses && msg.Authority != params.AdminAddress {
+				flows, err := types.ParsePausesUpdate(update.Value)
+				if err != nil {
+					return nil, err
+				}
+				if !types.IsPauseOnly(flows) {
+					return nil, errorsmod.Wrap(govtypes.ErrInvalidSigner, "tx signer is not allowed to unpause operations")
+				}
+			}
+		}
 	}
 
 	hc, found := k.GetHostChain(ctx, msg.ChainId)
@@ -137,19 +172,52 @@ func (k msgServer) UpdateHostChain(
 		return nil, fmt.Errorf("invalid chain id \"%s\", host chain is not registered", msg.ChainId)
 	}
 
-	for _, update := range msg.Updates {
+	if hc.Deregistration != nil {
+		return nil, errorsmod.Wrapf(types.ErrHostChainDeregistering, "host chain %s can't be updated", hc.ChainId)
+	}
+
+	// sensitive updates are queued behind the timelock, the rest are applied right away
+	updates, timelockedUpdates := k.SplitTimelockedUpdates(ctx, msg.Updates)
+	timelockedUpdates, err := k.PinTimelockedUpdates(ctx, hc, timelockedUpdates)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ApplyHostChainUpdates(ctx, hc, updates); err != nil {
+		return nil, err
+	}
+
+	k.SetHostChain(ctx, hc)
+
+	if len(timelockedUpdates) > 0 {
+		k.QueueTimelockedUpdate(ctx, hc.ChainId, msg.Authority, timelockedUpdates)
+	}
+
+	defer func() {
+		if hc.Active {
+			telemetry.ModuleSetGauge(types.ModuleName, float32(1), hc.ChainId, "active")
+		} else {
+			telemetry.ModuleSetGauge(types.ModuleName, float32(0), hc.ChainId, "active")
+		}
+	}()
+
+	return &types.MsgUpdateHostChainResponse{}, nil
+}
+
+// ApplyHostChainUpdates applies the KV updates to a host chain, the caller is expected to store the host chain
+func (k *Keeper) ApplyHostChainUpdates(ctx sdktypes.Context, hc *types.HostChain, updates []*types.KVUpdate) error {
+	for _, update := range updates {
 	updateCase:
 		switch update.Key {
 		case types.KeyAddValidator:
 			var validator types.Validator
 			err := json.Unmarshal([]byte(update.Value), &validator)
 			if err != nil {
-				return nil, fmt.Errorf("unable to unmarshal validator update string")
+				return fmt.Errorf("unable to unmarshal validator update string")
 			}
 
-			_, found = hc.GetValidator(validator.OperatorAddress)
-			if found {
-				return nil, fmt.Errorf("validator %s already registered on %s", validator.OperatorAddress, hc.ChainId)
+			if _, found := hc.GetValidator(validator.OperatorAddress); found {
+				return fmt.Errorf("validator %s already registered on %s", validator.OperatorAddress, hc.ChainId)
 			}
 
 			hc.Validators = append(hc.Validators, &validator)
@@ -159,7 +227,7 @@ func (k msgServer) UpdateHostChain(
 				if validator.OperatorAddress == update.Value {
 					// remove just when there are no delegated tokens and weight is 0
 					if validator.DelegatedAmount.GT(sdktypes.ZeroInt()) || validator.Weight.GT(sdktypes.ZeroDec()) {
-						return nil, fmt.Errorf(
+						return fmt.Errorf(
 							"validator %s can't be removed, it either has weight or staked tokens",
 							validator.OperatorAddress,
 						)
@@ -170,91 +238,95 @@ func (k msgServer) UpdateHostChain(
 				}
 			}
 
-			return nil, types.ErrValidatorNotFound
+			return types.ErrValidatorNotFound
 		case types.KeyValidatorUpdate:
-			_, found = hc.GetValidator(update.Value)
-			if !found {
-				return nil, types.ErrValidatorNotFound
+			if _, found := hc.GetValidator(update.Value); !found {
+				return types.ErrValidatorNotFound
 			}
 
 			if err := k.QueryHostChainValidator(ctx, hc, update.Value); err != nil {
-				return nil, fmt.Errorf("unable to send ICQ query for validator")
+				return fmt.Errorf("unable to send ICQ query for validator")
 			}
 		case types.KeyValidatorWeight:
 			validator, weight, valid := strings.Cut(update.Value, ",")
 			if !valid {
-				return nil, fmt.Errorf("unable to parse validator update string")
+				return fmt.Errorf("unable to parse validator update string")
 			}
 
 			if err := k.UpdateHostChainValidatorWeight(ctx, hc, validator, weight); err != nil {
-				return nil, fmt.Errorf("invalid validator weight update values: %v", err)
+				return fmt.Errorf("invalid validator weight update values: %v", err)
 			}
 		case types.KeyDepositFee:
 			fee, err := sdktypes.NewDecFromStr(update.Value)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
 			}
 			//fee limits validated in msg.ValidateBasic()
 			hc.Params.DepositFee = fee
 		case types.KeyRestakeFee:
 			fee, err := sdktypes.NewDecFromStr(update.Value)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
 			}
 			//fee limits validated in msg.ValidateBasic()
 			hc.Params.RestakeFee = fee
 		case types.KeyRedemptionFee:
 			fee, err := sdktypes.NewDecFromStr(update.Value)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
 			}
 			//fee limits validated in msg.ValidateBasic()
 			hc.Params.RedemptionFee = fee
 		case types.KeyUnstakeFee:
 			fee, err := sdktypes.NewDecFromStr(update.Value)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
 			}
 			//fee limits validated in msg.ValidateBasic()
 			hc.Params.UnstakeFee = fee
 		case types.KeyLSMValidatorCap:
 			validatorCap, err := sdktypes.NewDecFromStr(update.Value)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
 			}
 			//cap limits validated in msg.ValidateBasic()
 			hc.Params.LsmValidatorCap = validatorCap
 		case types.KeyLSMBondFactor:
 			bondFactor, err := sdktypes.NewDecFromStr(update.Value)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
 			}
 			//factor limits validated in msg.ValidateBasic()
 			hc.Params.LsmBondFactor = bondFactor
 		case types.KeyMinimumDeposit:
 			minimumDeposit, ok := sdktypes.NewIntFromString(update.Value)
 			if !ok {
-				return nil, fmt.Errorf("unable to parse string to sdk.Int")
+				return fmt.Errorf("unable to parse string to sdk.Int")
 			}
 			//min deposit limits validated in msg.ValidateBasic()
 			hc.MinimumDeposit = minimumDeposit
 		case types.KeyActive:
 			active, err := strconv.ParseBool(update.Value)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse string to bool")
+				return fmt.Errorf("unable to parse string to bool")
 			}
 
 			hc.Active = active
+
+			// manually activating a chain also resets its c value circuit breaker
+			if active {
+				hc.CValueCooldown = nil
+			}
 		case types.KeySetWithdrawAddress:
 			err := k.SetWithdrawAddress(ctx, hc)
 			if err != nil {
 				k.Logger(ctx).Error("Could not set withdraw address.", "chain_id", hc.ChainId)
-				return nil, fmt.Errorf("could not set withdraw address for host chain %s", hc.ChainId)
+				return fmt.Errorf("could not set withdraw address for host chain %s", hc.ChainId)
 			}
 		case types.KeyAutocompoundFactor:
 			autocompoundFactor, err := sdktypes.NewDecFromStr(update.Value)
 			if err != nil {
-				return nil, fmt.Errorf("unable to parse string to sdk.Dec")
+				return fmt.Errorf("unable to parse string to sdk.Dec")
 			}
 			//autoCompoundFactor limits validated in msg.ValidateBasic()
 			hc.AutoCompoundFactor = k.CalculateAutocompoundLimit(autocompoundFactor)
@@ -262,27 +334,205 @@ func (k msgServer) UpdateHostChain(
 			var flags types.HostChainFlags
 			err := json.Unmarshal([]byte(update.Value), &flags)
 			if err != nil {
-				return nil, fmt.Errorf("unable to unmarshal flags update string")
+				return fmt.Errorf("unable to unmarshal flags update string")
 			}
 
 			hc.Flags = &flags
 			k.SetHostChain(ctx, hc)
-		default:
-			return nil, fmt.Errorf("invalid or unexpected update key: %s", update.Key)
-		}
-	}
+		case types.KeyPauses:
+			flows, err := types.ParsePausesUpdate(update.Value)
+			if err != nil {
+				return err
+			}
 
-	k.SetHostChain(ctx, hc)
+			hc.UpdatePauses(flows)
+			k.SetHostChain(ctx, hc)
+		case types.KeyRebalance:
+			if hc.GetPauses().GetIcaSends() {
+				return errorsmod.Wrapf(types.ErrOperationPaused, "ICA transactions are paused for host chain %s", hc.ChainId)
+			}
 
-	defer func() {
-		if hc.Active {
-			telemetry.ModuleSetGauge(types.ModuleName, float32(1), hc.ChainId, "active")
-		} else {
-			telemetry.ModuleSetGauge(types.ModuleName, float32(0), hc.ChainId, "active")
+			if err := k.RebalanceHostChain(ctx, hc); err != nil {
+				return fmt.Errorf("could not rebalance host chain %s: %w", hc.ChainId, err)
+			}
+		case types.KeyValidatorSetConfig:
+			var config types.ValidatorSetConfig
+			err := json.Unmarshal([]byte(update.Value), &config)
+			if err != nil {
+				return fmt.Errorf("unable to unmarshal validator set config update string")
+			}
+
+			hc.ValidatorSetConfig = &config
+			k.SetHostChain(ctx, hc)
+
+			// select the validator set right away instead of waiting for the next delegation epoch
+			if config.Enabled {
+				if err := k.StartValidatorSetRound(ctx, hc, k.GetEpochNumber(ctx, types.DelegationEpoch)); err != nil {
+					return fmt.Errorf("unable to send ICQ queries for validator set")
+				}
+			}
+		case types.KeyApplyValidatorSet:
+			// a timelocked apply carries the proposal pinned when it was queued, which was validated back then
+			if update.Value != "" {
+				proposal, err := types.UnmarshalPinnedValidatorSetProposal(update.Value)
+				if err != nil {
+					return err
+				}
+				if proposal.ChainId != hc.ChainId {
+					return fmt.Errorf("pinned validator set proposal is for %s, not %s", proposal.ChainId, hc.ChainId)
+				}
+
+				k.applyValidatorSetProposal(ctx, hc, proposal)
+				break
+			}
+
+			proposal, found := k.GetValidatorSetProposal(ctx, hc.ChainId)
+			if !found {
+				return fmt.Errorf("host chain %s has no validator set proposal", hc.ChainId)
+			}
+
+			if err := k.ApplyValidatorSetProposal(ctx, hc, proposal); err != nil {
+				return err
+			}
+		case types.KeyVoteSignaling:
+			var signaling types.VoteSignaling
+			err := json.Unmarshal([]byte(update.Value), &signaling)
+			if err != nil {
+				return fmt.Errorf("unable to unmarshal vote signaling update string")
+			}
+
+			if !signaling.EndTime.After(ctx.BlockTime()) {
+				return fmt.Errorf("vote signaling end time %s is not in the future", signaling.EndTime)
+			}
+
+			// an open signaling can have its end time updated, keeping its signals
+			existing, found := k.GetVoteSignaling(ctx, hc.ChainId, signaling.ProposalId)
+			if found && existing.Tallied {
+				return errorsmod.Wrapf(
+					types.ErrVoteSignalingClosed,
+					"vote signaling for proposal %d has already been tallied",
+					signaling.ProposalId,
+				)
+			}
+			if !found {
+				existing = &types.VoteSignaling{
+					ChainId:    hc.ChainId,
+					ProposalId: signaling.ProposalId,
+					TotalPower: sdktypes.ZeroInt(),
+				}
+			}
+
+			existing.EndTime = signaling.EndTime
+			k.SetVoteSignaling(ctx, existing)
+		case types.KeyMaxRedelegationEntries:
+			maxEntries, err := strconv.ParseUint(update.Value, 10, 32)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to uint32")
+			}
+			//max entries limits validated in msg.ValidateBasic()
+			hc.Params.MaxRedelegationEntries = uint32(maxEntries)
+		case types.KeyMaxTVL:
+			limit, ok := sdktypes.NewIntFromString(update.Value)
+			if !ok {
+				return fmt.Errorf("unable to parse string to sdk.Int")
+			}
+			//cap limits validated in msg.ValidateBasic()
+			hc.Params.MaxTvl = limit
+		case types.KeyMaxEpochInflow:
+			limit, ok := sdktypes.NewIntFromString(update.Value)
+			if !ok {
+				return fmt.Errorf("unable to parse string to sdk.Int")
+			}
+			//cap limits validated in msg.ValidateBasic()
+			hc.Params.MaxEpochInflow = limit
+		case types.KeyMaxAddressEpochInflow:
+			limit, ok := sdktypes.NewIntFromString(update.Value)
+			if !ok {
+				return fmt.Errorf("unable to parse string to sdk.Int")
+			}
+			//cap limits validated in msg.ValidateBasic()
+			hc.Params.MaxAddressEpochInflow = limit
+		case types.KeyMaxEpochUnstake:
+			limit, ok := sdktypes.NewIntFromString(update.Value)
+			if !ok {
+				return fmt.Errorf("unable to parse string to sdk.Int")
+			}
+			//cap limits validated in msg.ValidateBasic()
+			hc.Params.MaxEpochUnstake = limit
+		case types.KeyMaxEpochRedeemRatio:
+			ratio, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			//ratio limits validated in msg.ValidateBasic()
+			hc.Params.MaxEpochRedeemRatio = ratio
+		case types.KeySlashThreshold:
+			threshold, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			//threshold limits validated in msg.ValidateBasic()
+			hc.Params.SlashThreshold = threshold
+		case types.KeyMaxValidatorCommission:
+			commission, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			//commission limits validated in msg.ValidateBasic()
+			hc.Params.MaxValidatorCommission = commission
+		case types.KeyUpperCValueLimit:
+			limit, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+
+			hc.Params.UpperCValueLimit = limit
+
+			// the host chain limits fall back to the module ones, the combination has to be valid
+			params := k.GetParams(ctx)
+			if err := hc.ValidateCValueLimits(params.LowerCValueLimit, params.UpperCValueLimit); err != nil {
+				return err
+			}
+		case types.KeyLowerCValueLimit:
+			limit, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+
+			hc.Params.LowerCValueLimit = limit
+
+			// the host chain limits fall back to the module ones, the combination has to be valid
+			params := k.GetParams(ctx)
+			if err := hc.ValidateCValueLimits(params.LowerCValueLimit, params.UpperCValueLimit); err != nil {
+				return err
+			}
+		case types.KeyMaxCValueDelta:
+			delta, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+			//delta limits validated in msg.ValidateBasic()
+			hc.Params.MaxCValueDelta = delta
+		case types.KeyCValueRecoveryCount:
+			recoveryCount, err := strconv.ParseUint(update.Value, 10, 32)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to uint32")
+			}
+
+			hc.Params.CValueRecoveryCount = uint32(recoveryCount)
+		case types.KeyDelegationStrategy:
+			strategy, ok := types.HostChain_DelegationStrategy_value[update.Value]
+			if !ok {
+				return fmt.Errorf("invalid delegation strategy: %s", update.Value)
+			}
+
+			hc.DelegationStrategy = types.HostChain_DelegationStrategy(strategy)
+		default:
+			return fmt.Errorf("invalid or unexpected update key: %s", update.Key)
 		}
-	}()
+	}
 
-	return &types.MsgUpdateHostChainResponse{}, nil
+	return nil
 }
 
 // LiquidStake defines a method for liquid staking tokens
@@ -302,30 +552,19 @@ func (k msgServer) LiquidStake(
 		)
 	}
 
-	if !hostChain.Active {
-		return nil, types.ErrHostChainInactive
-	}
-
-	// check for minimum deposit amount
-	if msg.Amount.Amount.LT(hostChain.MinimumDeposit) {
-		return nil, errorsmod.Wrapf(
-			types.ErrMinDeposit,
-			"expected amount more than %s, got %s",
-			hostChain.MinimumDeposit,
-			msg.Amount.Amount,
-		)
-	}
-
 	// get the delegator address from the bech32 string
 	delegatorAddress, err := sdktypes.AccAddressFromBech32(msg.DelegatorAddress)
 	if err != nil {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "error parsing delegator address: %s", err)
 	}
 
-	// amount of stk tokens to be minted
-	mintDenom := hostChain.MintDenom()
-	mintAmount := sdktypes.NewDecCoinFromCoin(msg.Amount).Amount.Mul(hostChain.CValue)
-	mintToken, _ := sdktypes.NewDecCoinFromDec(mintDenom, mintAmount).TruncateDecimal()
+	// check the host chain state, the minimum deposit and the liquid stake caps
+	if err = k.validateLiquidStake(ctx, hostChain, msg.DelegatorAddress, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	// amount of stk tokens to be minted and the protocol fee charged on them
+	mintToken, protocolFee := liquidStakeAmounts(hostChain, msg.Amount.Amount)
 
 	// send the deposit to the deposit-module account
 	depositAmount := sdktypes.NewCoins(msg.Amount)
@@ -353,6 +592,9 @@ func (k msgServer) LiquidStake(
 	deposit.Amount.Amount = deposit.Amount.Amount.Add(msg.Amount.Amount)
 	k.SetDeposit(ctx, deposit)
 
+	// account the deposit amount in the host chain and delegator inflows for the epoch
+	k.AddInflow(ctx, hostChain.ChainId, currentEpoch, msg.DelegatorAddress, msg.Amount.Amount)
+
 	// mint stk tokens in the module account
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdktypes.NewCoins(mintToken))
 	if err != nil {
@@ -363,10 +605,6 @@ func (k msgServer) LiquidStake(
 		)
 	}
 
-	// calculate protocol fee
-	protocolFeeAmount := hostChain.Params.DepositFee.MulInt(mintToken.Amount)
-	protocolFee, _ := sdktypes.NewDecCoinFromDec(mintDenom, protocolFeeAmount).TruncateDecimal()
-
 	// send stk tokens to the delegator address
 	err = k.bankKeeper.SendCoinsFromModuleToAccount(
 		ctx,
@@ -436,48 +674,28 @@ func (k msgServer) LiquidStakeLSM(
 			return nil, err
 		}
 
-		// check for minimum deposit amount
-		if delegation.Amount.LT(hc.MinimumDeposit) {
-			return nil, errorsmod.Wrapf(
-				types.ErrMinDeposit,
-				"expected amount for delegation %s more than %s, got %s",
-				delegation.Denom,
-				hc.MinimumDeposit,
-				delegation.Amount,
-			)
-		}
-
 		// create the LSM deposit
-		deposit := &types.LSMDeposit{
-			ChainId:          hc.ChainId,
-			Shares:           sdktypes.NewDecFromInt(delegation.Amount),
-			Amount:           sdktypes.NewDecFromInt(delegation.Amount).Mul(validator.ExchangeRate).TruncateInt(),
-			Denom:            denomTrace.BaseDenom,
-			IbcDenom:         delegation.Denom,
-			DelegatorAddress: msg.DelegatorAddress,
-			State:            types.LSMDeposit_DEPOSIT_PENDING,
-			IbcSequenceId:    "",
-		}
+		deposit := newLSMDeposit(hc, validator, denomTrace, msg.DelegatorAddress, delegation)
 
-		// we won't process more than one deposit for a user and token
-		_, found := k.GetLSMDeposit(ctx, deposit.ChainId, deposit.DelegatorAddress, deposit.Denom)
-		if found {
-			return nil,
-				errorsmod.Wrapf(
-					types.ErrLSMDepositProcessing,
-					"already processing LSM deposit for token %s and delegator %s",
-					deposit.Denom,
-					deposit.DelegatorAddress,
-				)
+		// check the deposit against the minimum deposit, the deposits in process and the liquid stake caps
+		if err = k.validateLSMDeposit(ctx, hc, deposit); err != nil {
+			return nil, err
 		}
 
 		// store the deposit
 		k.SetLSMDeposit(ctx, deposit)
 
+		// account the deposit amount in the host chain and delegator inflows for the epoch
+		k.AddInflow(
+			ctx,
+			hc.ChainId,
+			k.GetEpochNumber(ctx, types.DelegationEpoch),
+			deposit.DelegatorAddress,
+			deposit.Amount,
+		)
+
 		// mint stk tokens
-		mintDenom := hc.MintDenom()
-		mintAmount := sdktypes.NewDecFromInt(deposit.Amount).Mul(hc.CValue)
-		mintToken, _ := sdktypes.NewDecCoinFromDec(mintDenom, mintAmount).TruncateDecimal()
+		mintToken, protocolFee := liquidStakeAmounts(hc, deposit.Amount)
 		err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdktypes.NewCoins(mintToken))
 		if err != nil {
 			return nil, errorsmod.Wrapf(types.ErrMintFailed, "failed to mint coins in module %s: %s", types.ModuleName, err)
@@ -495,10 +713,6 @@ func (k msgServer) LiquidStakeLSM(
 			)
 		}
 
-		// calculate protocol fee
-		protocolFeeAmount := hc.Params.DepositFee.MulInt(mintToken.Amount)
-		protocolFee, _ := sdktypes.NewDecCoinFromDec(mintDenom, protocolFeeAmount).TruncateDecimal()
-
 		// send stk tokens to the delegator address
 		err = k.bankKeeper.SendCoinsFromModuleToAccount(
 			ctx,
@@ -573,17 +787,9 @@ func (k msgServer) LiquidUnstake(
 		)
 	}
 
-	if !hc.Active {
-		return nil, types.ErrHostChainInactive
-	}
-
-	// check if the message amount has the correct denom
-	if msg.Amount.Denom != hc.MintDenom() {
-		return nil, errorsmod.Wrapf(types.ErrInvalidDenom,
-			"expected %s, got %s",
-			hc.MintDenom(),
-			msg.Amount.Denom,
-		)
+	// check the host chain state and the message amount denom
+	if err := k.validateLiquidUnstake(hc, msg.Amount); err != nil {
+		return nil, err
 	}
 
 	// parse the delegator address
@@ -603,12 +809,11 @@ func (k msgServer) LiquidUnstake(
 		return nil, err
 	}
 
-	// send the unstake fee to the module fee address and subtract it from the total to unstake
-	unstakeAmount := msg.Amount
-	feeAmount := hc.Params.UnstakeFee.MulInt(unstakeAmount.Amount).TruncateInt()
-	if feeAmount.IsPositive() {
-		fee := sdktypes.NewCoin(msg.Amount.Denom, feeAmount)
+	// calculate the unstake fee, the stk amount to unstake and the host chain token unbond amount
+	fee, unstakeAmount, unbondAmount := liquidUnstakeAmounts(hc, msg.Amount)
 
+	// send the unstake fee to the module fee address
+	if fee.IsPositive() {
 		err = k.SendProtocolFee(
 			ctx,
 			sdktypes.NewCoins(fee),
@@ -617,41 +822,27 @@ func (k msgServer) LiquidUnstake(
 		if err != nil {
 			return nil, err
 		}
-
-		unstakeAmount = msg.Amount.Sub(fee)
 	}
 
-	// calculate the host chain token unbond amount from the stk amount
-	decTokenAmount := sdktypes.NewDecCoinFromCoin(unstakeAmount).Amount.Mul(sdktypes.OneDec().Quo(hc.CValue))
-	unbondAmount, _ := sdktypes.NewDecCoinFromDec(hc.HostDenom, decTokenAmount).TruncateDecimal()
-
 	// calculate the current unbonding epoch
 	epoch := k.epochsKeeper.GetEpochInfo(ctx, types.UndelegationEpoch)
 	unbondingEpoch := types.CurrentUnbondingEpoch(hc.UnbondingFactor, epoch.CurrentEpoch)
 
+	// check the unbond amount against the host chain unbonding epoch cap and what is currently staked
+	if err = k.validateUnbondAmount(ctx, hc, unbondingEpoch, unbondAmount.Amount); err != nil {
+		return nil, err
+	}
+
 	// increase the unbonding value for the epoch both for the user record and the module record
 	k.IncreaseUserUnbondingAmountForEpoch(ctx, hc.ChainId, msg.DelegatorAddress, unbondingEpoch, unstakeAmount, unbondAmount)
 	k.IncreaseUndelegatingAmountForEpoch(ctx, hc.ChainId, unbondingEpoch, unstakeAmount, unbondAmount)
 
-	// check if the total unbonding amount for the next unbonding epoch is less than what is currently staked
-	totalUnbondingsForEpoch, _ := k.GetUnbonding(ctx, hc.ChainId, unbondingEpoch)
-	totalDelegations := hc.GetHostChainTotalDelegations()
-	if totalDelegations.LTE(totalUnbondingsForEpoch.UnbondAmount.Amount) {
-		return nil, errorsmod.Wrapf(
-			types.ErrNotEnoughDelegations,
-			"delegated amount %s is less than the total undelegation %s for epoch %d",
-			totalDelegations,
-			totalUnbondingsForEpoch,
-			unbondingEpoch,
-		)
-	}
-
 	ctx.EventManager().EmitEvents(sdktypes.Events{
 		sdktypes.NewEvent(
 			types.EventTypeLiquidUnstake,
 			sdktypes.NewAttribute(types.AttributeDelegatorAddress, msg.GetDelegatorAddress()),
 			sdktypes.NewAttribute(types.AttributeAmountReceived, msg.Amount.String()),
-			sdktypes.NewAttribute(types.AttributePstakeUnstakeFee, feeAmount.String()),
+			sdktypes.NewAttribute(types.AttributePstakeUnstakeFee, fee.Amount.String()),
 			sdktypes.NewAttribute(types.AttributeUnstakeAmount, unbondAmount.String()),
 			sdktypes.NewAttribute(types.AttributeUnstakeEpoch, strconv.FormatInt(unbondingEpoch, 10)),
 		),
@@ -674,6 +865,24 @@ func (k msgServer) Redeem(
 ) (*types.MsgRedeemResponse, error) {
 	ctx := sdktypes.UnwrapSDKContext(goCtx)
 
+	// the stk tokens of a deregistered host chain are redeemed at its final c value, even once it has been deleted
+	if record, found := k.GetRedemptionRecord(ctx, msg.Amount.Denom); found {
+		redeemAddress, err := sdktypes.AccAddressFromBech32(msg.DelegatorAddress)
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "got error : %s", err)
+		}
+
+		redeemToken, err := k.RedeemFromRedemptionRecord(ctx, record, redeemAddress, msg.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		emitRedeemEvents(ctx, msg, redeemToken, sdktypes.NewCoin(redeemToken.Denom, sdktypes.ZeroInt()))
+		telemetry.IncrCounter(float32(1), record.ChainId, "redeem")
+
+		return &types.MsgRedeemResponse{}, nil
+	}
+
 	// parse the chain host denom from the stk denom
 	_, hostDenom, found := strings.Cut(msg.Amount.Denom, "/")
 	if !found {
@@ -692,20 +901,20 @@ func (k msgServer) Redeem(
 		)
 	}
 
-	if !hc.Active {
-		return nil, types.ErrHostChainInactive
-	}
-
-	// check the msg amount denom is the host chain mint denom
-	if msg.Amount.Denom != hc.MintDenom() {
+	// the stk tokens of a host chain being deregistered can't be redeemed until its unbondings are settled
+	if hc.Deregistration != nil {
 		return nil, errorsmod.Wrapf(
-			types.ErrInvalidDenom,
-			"expected %s, got %s",
-			hc.MintDenom(),
-			msg.Amount.Denom,
+			types.ErrHostChainDeregistering,
+			"host chain %s has no redemption record yet",
+			hc.ChainId,
 		)
 	}
 
+	// check the host chain state and the message amount denom
+	if err := k.validateRedeem(hc, msg.Amount); err != nil {
+		return nil, err
+	}
+
 	// get the redeem address
 	redeemAddress, err := sdktypes.AccAddressFromBech32(msg.DelegatorAddress)
 	if err != nil {
@@ -728,11 +937,8 @@ func (k msgServer) Redeem(
 		)
 	}
 
-	// calculate the instant redemption fee
-	fee, _ := sdktypes.NewDecCoinFromDec(
-		hc.MintDenom(),
-		hc.Params.RedemptionFee.MulInt(msg.Amount.Amount),
-	).TruncateDecimal()
+	// calculate the instant redemption fee, the stk amount to burn and the amount of tokens to be redeemed
+	fee, stkAmount, redeemToken := redeemAmounts(hc, msg.Amount)
 
 	// send the protocol fee to the module fee address
 	if fee.IsPositive() {
@@ -752,24 +958,9 @@ func (k msgServer) Redeem(
 		}
 	}
 
-	// amount of tokens to be redeemed
-	stkAmount := msg.Amount.Sub(fee)
-	redeemAmount := sdktypes.NewDecCoinFromCoin(stkAmount).Amount.Quo(hc.CValue)
-	redeemToken, _ := sdktypes.NewDecCoinFromDec(hc.IBCDenom(), redeemAmount).TruncateDecimal()
-
-	// check if there is enough deposits to fulfill the instant redemption request
-	depositAccountBalance := k.bankKeeper.GetBalance(
-		ctx,
-		authtypes.NewModuleAddress(types.DepositModuleAccount),
-		hc.IBCDenom(),
-	)
-	if redeemToken.IsGTE(depositAccountBalance) {
-		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrInsufficientFunds,
-			"can't instant redeem %s tokens, only %s is available",
-			redeemToken.String(),
-			depositAccountBalance.Amount.String(),
-		)
+	// check if there is enough deposits to fulfill the instant redemption request and the epoch redeem cap
+	if err = k.validateRedeemAmount(ctx, hc, redeemToken); err != nil {
+		return nil, err
 	}
 
 	// subtract the redemption amount from the deposits
@@ -797,6 +988,9 @@ func (k msgServer) Redeem(
 		)
 	}
 
+	// account the redeemed amount in the host chain outflows for the epoch
+	k.AddRedeemOutflow(ctx, hc.ChainId, k.GetEpochNumber(ctx, types.DelegationEpoch), redeemToken.Amount)
+
 	// burn the stk tokens
 	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdktypes.NewCoins(stkAmount))
 	if err != nil {
@@ -808,10 +1002,17 @@ func (k msgServer) Redeem(
 		)
 	}
 
+	emitRedeemEvents(ctx, msg, redeemToken, fee)
+	telemetry.IncrCounter(float32(1), hc.ChainId, "redeem")
+
+	return &types.MsgRedeemResponse{}, nil
+}
+
+func emitRedeemEvents(ctx sdktypes.Context, msg *types.MsgRedeem, redeemToken, fee sdktypes.Coin) {
 	ctx.EventManager().EmitEvents(sdktypes.Events{
 		sdktypes.NewEvent(
 			types.EventTypeRedeem,
-			sdktypes.NewAttribute(types.AttributeDelegatorAddress, redeemAddress.String()),
+			sdktypes.NewAttribute(types.AttributeDelegatorAddress, msg.DelegatorAddress),
 			sdktypes.NewAttribute(types.AttributeAmount, msg.Amount.String()),
 			sdktypes.NewAttribute(types.AttributeAmountReceived, redeemToken.String()),
 			sdktypes.NewAttribute(types.AttributePstakeRedeemFee, fee.String()),
@@ -822,10 +1023,6 @@ func (k msgServer) Redeem(
 			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.DelegatorAddress),
 		)},
 	)
-
-	telemetry.IncrCounter(float32(1), hc.ChainId, "redeem")
-
-	return &types.MsgRedeemResponse{}, nil
 }
 
 // UpdateParams defines a method for updating the module params
@@ -843,7 +1040,25 @@ func (k msgServer) UpdateParams(
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "tx signer is not a module authority")
 	}
 
-	k.SetParams(ctx, msg.Params)
+	// the module c value limits are the fallback of the host chains that don't set their own
+	for _, hc := range k.GetAllHostChains(ctx) {
+		if err := hc.ValidateCValueLimits(msg.Params.LowerCValueLimit, msg.Params.UpperCValueLimit); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+		}
+	}
+
+	// lowering the update timelock waits for the current one, raising it applies right away and drops any lowering
+	// waiting for it
+	newParams := msg.Params
+	switch {
+	case newParams.UpdateTimelockEpochs < params.UpdateTimelockEpochs:
+		k.QueueTimelockEpochsUpdate(ctx, msg.Authority, newParams.UpdateTimelockEpochs)
+		newParams.UpdateTimelockEpochs = params.UpdateTimelockEpochs
+	case newParams.UpdateTimelockEpochs > params.UpdateTimelockEpochs:
+		k.CancelTimelockEpochsUpdate(ctx)
+	}
+
+	k.SetParams(ctx, newParams)
 
 	ctx.EventManager().EmitEvents(sdktypes.Events{
 		sdktypes.NewEvent(
@@ -860,7 +1075,292 @@ func (k msgServer) UpdateParams(
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
-func (k msgServer) validateLiquidStakeLSMDeposit(
+// VoteOnHostChainProposal defines a method to vote on a host chain governance proposal through the delegation account
+func (k msgServer) VoteOnHostChainProposal(
+	goCtx context.Context,
+	msg *types.MsgVoteOnHostChainProposal,
+) (*types.MsgVoteOnHostChainProposalResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	// authority needs to be either the gov module account (for proposals)
+	// or the module admin account (for normal txs)
+	if msg.Authority != k.authority && msg.Authority != k.GetParams(ctx).AdminAddress {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "tx signer is not a module authority")
+	}
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, fmt.Errorf("invalid chain id \"%s\", host chain is not registered", msg.ChainId)
+	}
+
+	if hc.GetPauses().GetIcaSends() {
+		return nil, errorsmod.Wrapf(types.ErrOperationPaused, "ICA transactions are paused for host chain %s", hc.ChainId)
+	}
+
+	if err := k.Keeper.VoteOnHostChainProposal(ctx, hc, msg.ProposalId, msg.Options); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgVoteOnHostChainProposalResponse{}, nil
+}
+
+// DeregisterHostChain disables a host chain and starts winding it down, after which it is deleted along with all
+// of its records
+func (k msgServer) DeregisterHostChain(
+	goCtx context.Context,
+	msg *types.MsgDeregisterHostChain,
+) (*types.MsgDeregisterHostChainResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	// authority needs to be either the gov module account (for proposals)
+	// or the module admin account (for normal txs)
+	if msg.Authority != k.authority && msg.Authority != k.GetParams(ctx).AdminAddress {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "tx signer is not a module authority")
+	}
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, fmt.Errorf("invalid chain id \"%s\", host chain is not registered", msg.ChainId)
+	}
+
+	if err := k.StartHostChainDeregistration(ctx, hc); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgDeregisterHostChainResponse{}, nil
+}
+
+// CancelTimelockedUpdate removes queued host chain updates before they are applied
+func (k msgServer) CancelTimelockedUpdate(
+	goCtx context.Context,
+	msg *types.MsgCancelTimelockedUpdate,
+) (*types.MsgCancelTimelockedUpdateResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	// authority needs to be either the gov module account (for proposals),
+	// the module admin account or the guardian account (for normal txs)
+	params := k.GetParams(ctx)
+	if msg.Authority != k.authority && msg.Authority != params.AdminAddress &&
+		(params.GuardianAddress == "" || msg.Authority != params.GuardianAddress) {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "tx signer is not a module authority")
+	}
+
+	update, found := k.GetTimelockedUpdate(ctx, msg.ChainId, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrKeyNotFound,
+			"timelocked update %d of host chain %s not found",
+			msg.Id,
+			msg.ChainId,
+		)
+	}
+
+	k.DeleteTimelockedUpdate(ctx, update)
+	k.emitTimelockedUpdateEvent(ctx, update, types.AttributeValueCancelled)
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgCancelTimelockedUpdateResponse{}, nil
+}
+
+// SignalHostChainVote records the vote preference of a liquid staker on a host chain proposal, weighted by the stk
+// the signaler escrows with it, to be tallied with the other signals once the signaling ends
+func (k msgServer) SignalHostChainVote(
+	goCtx context.Context,
+	msg *types.MsgSignalHostChainVote,
+) (*types.MsgSignalHostChainVoteResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, fmt.Errorf("invalid chain id \"%s\", host chain is not registered", msg.ChainId)
+	}
+
+	signaling, found := k.GetVoteSignaling(ctx, hc.ChainId, msg.ProposalId)
+	if !found || signaling.Tallied || !ctx.BlockTime().Before(signaling.EndTime) {
+		return nil, errorsmod.Wrapf(
+			types.ErrVoteSignalingClosed,
+			"no open vote signaling for proposal %d of host chain %s",
+			msg.ProposalId,
+			hc.ChainId,
+		)
+	}
+
+	signaler, err := sdktypes.AccAddressFromBech32(msg.Signaler)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signaler address %q: %v", msg.Signaler, err)
+	}
+
+	// only holders of the liquid staked token of the host chain can signal, their stk balance is escrowed until the
+	// signaling is pruned so the same tokens can't signal again from another address
+	balance := k.bankKeeper.GetBalance(ctx, signaler, hc.MintDenom())
+	power := balance.Amount
+	previous, found := k.GetVoteSignal(ctx, hc.ChainId, msg.ProposalId, msg.Signaler)
+	if found {
+		power = power.Add(previous.Power)
+	}
+	if !power.IsPositive() {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInsufficientFunds,
+			"signaler %s holds no %s",
+			msg.Signaler,
+			hc.MintDenom(),
+		)
+	}
+
+	if balance.IsPositive() {
+		if err = k.bankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			signaler,
+			types.ModuleName,
+			sdktypes.NewCoins(balance),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	signal := &types.VoteSignal{
+		ChainId:    hc.ChainId,
+		ProposalId: msg.ProposalId,
+		Signaler:   msg.Signaler,
+		Options:    msg.Options,
+		Power:      power,
+	}
+
+	// a new signal replaces the previous one of the same signaler keeping its escrow, the power of the signal is
+	// accumulated into the signaling so the tally doesn't need to read the signals back
+	if found {
+		signaling.RemoveSignal(previous)
+	}
+	signaling.AddSignal(signal)
+	k.SetVoteSignaling(ctx, signaling)
+	k.SetVoteSignal(ctx, signal)
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			types.EventTypeVoteSignal,
+			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdktypes.NewAttribute(types.AttributeProposalID, fmt.Sprintf("%d", msg.ProposalId)),
+			sdktypes.NewAttribute(types.AttributeDelegatorAddress, msg.Signaler),
+			sdktypes.NewAttribute(types.AttributeVoteOptions, govv1beta1.WeightedVoteOptions(msg.Options).String()),
+		),
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.Signaler),
+		),
+	})
+
+	return &types.MsgSignalHostChainVoteResponse{}, nil
+}
+
+// Claim defines a method for claiming the claimable unbondings of a delegator to a recipient
+func (k msgServer) Claim(
+	goCtx context.Context,
+	msg *types.MsgClaim,
+) (*types.MsgClaimResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain with id %s not registered", msg.ChainId)
+	}
+
+	// the claimed tokens are sent to the delegator unless a recipient is set
+	recipient := msg.DelegatorAddress
+	if msg.Recipient != "" {
+		recipient = msg.Recipient
+	}
+	recipientAddress, err := sdktypes.AccAddressFromBech32(recipient)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	claimed := sdktypes.NewCoins()
+	for _, userUnbonding := range k.GetUserUnbondingsForDelegator(ctx, hc.ChainId, msg.DelegatorAddress) {
+		unbonding, found := k.GetUnbonding(ctx, hc.ChainId, userUnbonding.EpochNumber)
+		if !found || (unbonding.State != types.Unbonding_UNBONDING_CLAIMABLE &&
+			unbonding.State != types.Unbonding_UNBONDING_FAILED) {
+			continue
+		}
+
+		claimedCoins, err := k.ClaimUserUnbonding(ctx, hc, userUnbonding, recipientAddress)
+		if err != nil {
+			return nil, err
+		}
+		claimed = claimed.Add(claimedCoins...)
+	}
+
+	if claimed.IsZero() {
+		return nil, errorsmod.Wrapf(
+			types.ErrNothingToClaim,
+			"delegator %s has no claimable unbondings on host chain %s",
+			msg.DelegatorAddress,
+			hc.ChainId,
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.DelegatorAddress),
+		),
+	)
+
+	telemetry.IncrCounter(float32(1), hc.ChainId, "claim")
+
+	return &types.MsgClaimResponse{}, nil
+}
+
+// SetAutoClaim defines a method for opting in or out of the automatic claim of the delegator unbondings
+func (k msgServer) SetAutoClaim(
+	goCtx context.Context,
+	msg *types.MsgSetAutoClaim,
+) (*types.MsgSetAutoClaimResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	k.Keeper.SetAutoClaim(ctx, msg.DelegatorAddress, msg.AutoClaim)
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			types.EventTypeSetAutoClaim,
+			sdktypes.NewAttribute(types.AttributeDelegatorAddress, msg.DelegatorAddress),
+			sdktypes.NewAttribute(types.AttributeAutoClaim, strconv.FormatBool(msg.AutoClaim)),
+		),
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.DelegatorAddress),
+		)},
+	)
+
+	return &types.MsgSetAutoClaimResponse{}, nil
+}
+
+func (k *Keeper) validateLiquidStakeLSMDeposit(
 	ctx sdktypes.Context,
 	delegatorAddress sdktypes.AccAddress,
 	delegation sdktypes.Coin,
@@ -896,6 +1396,14 @@ func (k msgServer) validateLiquidStakeLSMDeposit(
 		return nil, nil, nil, types.ErrHostChainInactive
 	}
 
+	if hc.GetPauses().GetLsmStake() {
+		return nil, nil, nil, errorsmod.Wrapf(types.ErrOperationPaused, "LSM liquid staking is paused for host chain %s", hc.ChainId)
+	}
+
+	if hc.CValueCooldown != nil {
+		return nil, nil, nil, errorsmod.Wrapf(types.ErrCValueCooldown, "host chain %s", hc.ChainId)
+	}
+
 	// check if the host chain accepts LSM delegations
 	if !hc.Flags.Lsm {
 		return nil, nil, nil, types.ErrLSMNotEnabled
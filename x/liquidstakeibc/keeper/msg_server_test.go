@@ -2,10 +2,15 @@ package keeper_test
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	ibctfrtypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
@@ -107,6 +112,68 @@ func (suite *IntegrationTestSuite) Test_msgServer_LiquidStake() {
 	}
 }
 
+func (suite *IntegrationTestSuite) Test_msgServer_LiquidStakeCaps() {
+	pstakeapp, ctx := suite.app, suite.ctx
+	hc, found := pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	epoch := pstakeapp.EpochsKeeper.GetEpochInfo(ctx, types.DelegationEpoch)
+	err := pstakeapp.LiquidStakeIBCKeeper.BeforeEpochStart(ctx, epoch.Identifier, epoch.CurrentEpoch)
+	suite.Require().NoError(err)
+
+	delegator := suite.chainA.SenderAccount.GetAddress()
+	k := keeper.NewMsgServerImpl(pstakeapp.LiquidStakeIBCKeeper)
+
+	tests := []struct {
+		name     string
+		setCaps  func(params *types.HostChainLSParams)
+		accepted int64
+	}{
+		{
+			name: "address epoch inflow cap",
+			setCaps: func(params *types.HostChainLSParams) {
+				params.MaxAddressEpochInflow = sdk.NewInt(1000)
+			},
+			accepted: 1000,
+		}, {
+			name: "epoch inflow cap",
+			setCaps: func(params *types.HostChainLSParams) {
+				params.MaxAddressEpochInflow = sdk.ZeroInt()
+				params.MaxEpochInflow = sdk.NewInt(1500)
+			},
+			accepted: 500,
+		}, {
+			name: "tvl cap",
+			setCaps: func(params *types.HostChainLSParams) {
+				params.MaxEpochInflow = sdk.ZeroInt()
+				params.MaxTvl = pstakeapp.LiquidStakeIBCKeeper.GetLiquidStakedAmount(ctx, hc).AddRaw(100)
+			},
+			accepted: 100,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			tt.setCaps(hc.Params)
+			pstakeapp.LiquidStakeIBCKeeper.SetHostChain(ctx, hc)
+
+			// the amount over the cap is rejected, the remaining capacity is still accepted
+			_, err := k.LiquidStake(ctx, types.NewMsgLiquidStake(sdk.NewInt64Coin(hc.IBCDenom(), tt.accepted+1), delegator))
+			suite.Require().ErrorIs(err, types.ErrLiquidStakeCapExceeded)
+
+			_, err = k.LiquidStake(ctx, types.NewMsgLiquidStake(sdk.NewInt64Coin(hc.IBCDenom(), tt.accepted), delegator))
+			suite.Require().NoError(err)
+
+			_, err = k.LiquidStake(ctx, types.NewMsgLiquidStake(sdk.NewInt64Coin(hc.IBCDenom(), MinDeposit.Int64()), delegator))
+			suite.Require().ErrorIs(err, types.ErrLiquidStakeCapExceeded)
+		})
+	}
+
+	suite.Require().Equal(
+		sdk.NewInt(1600),
+		pstakeapp.LiquidStakeIBCKeeper.GetAddressEpochInflow(ctx, hc.ChainId, epoch.CurrentEpoch, delegator.String()),
+	)
+}
+
 func (suite *IntegrationTestSuite) Test_msgServer_LiquidStakeLSM() {
 	pstakeapp, ctx := suite.app, suite.ctx
 	hc, found := pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
@@ -123,6 +190,7 @@ func (suite *IntegrationTestSuite) Test_msgServer_LiquidStakeLSM() {
 		chainActive         bool
 		lsmActive           bool
 		createSecondDeposit bool
+		addressInflowCap    int64
 	}
 	tests := []struct {
 		name    string
@@ -131,6 +199,21 @@ func (suite *IntegrationTestSuite) Test_msgServer_LiquidStakeLSM() {
 		wantErr bool
 	}{
 		{
+			name: "Address epoch inflow cap exceeded",
+			args: args{
+				goCtx: ctx,
+				msg: &types.MsgLiquidStakeLSM{
+					DelegatorAddress: suite.chainA.SenderAccount.GetAddress().String(),
+					Delegations:      sdk.NewCoins(sdk.NewCoin(lsmIbcDenom, sdk.NewInt(1000))),
+				},
+				chainActive:         true,
+				lsmActive:           true,
+				createSecondDeposit: false,
+				addressInflowCap:    500,
+			},
+			want:    nil,
+			wantErr: true,
+		}, {
 			name: "Success",
 			args: args{
 				goCtx: ctx,
@@ -264,6 +347,9 @@ func (suite *IntegrationTestSuite) Test_msgServer_LiquidStakeLSM() {
 				)
 			}
 
+			hc.Params.MaxAddressEpochInflow = sdk.NewInt(tt.args.addressInflowCap)
+			suite.app.LiquidStakeIBCKeeper.SetHostChain(ctx, hc)
+
 			got, err := k.LiquidStakeLSM(tt.args.goCtx, tt.args.msg)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("LiquidStake() error = %v, wantErr %v", err, tt.wantErr)
@@ -275,6 +361,8 @@ func (suite *IntegrationTestSuite) Test_msgServer_LiquidStakeLSM() {
 
 			suite.UpdateChainActive(true, hc)
 			suite.UpdateChainLSMActive(true, hc)
+			hc.Params.MaxAddressEpochInflow = sdk.ZeroInt()
+			suite.app.LiquidStakeIBCKeeper.SetHostChain(ctx, hc)
 		})
 	}
 }
@@ -365,9 +453,79 @@ func (suite *IntegrationTestSuite) Test_msgServer_Redeem() {
 	}
 }
 
+func (suite *IntegrationTestSuite) Test_msgServer_OutflowCaps() {
+	pstakeapp, ctx := suite.app, suite.ctx
+	hc, found := pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	epoch := pstakeapp.EpochsKeeper.GetEpochInfo(ctx, types.DelegationEpoch)
+	err := pstakeapp.LiquidStakeIBCKeeper.BeforeEpochStart(ctx, epoch.Identifier, epoch.CurrentEpoch)
+	suite.Require().NoError(err)
+
+	delegator := suite.chainA.SenderAccount.GetAddress()
+	k := keeper.NewMsgServerImpl(pstakeapp.LiquidStakeIBCKeeper)
+
+	_, err = k.LiquidStake(ctx, types.NewMsgLiquidStake(sdk.NewInt64Coin(hc.IBCDenom(), 1000000), delegator))
+	suite.Require().NoError(err)
+
+	hc.Params.UnstakeFee = sdk.ZeroDec()
+	hc.Params.RedemptionFee = sdk.ZeroDec()
+	hc.Params.MaxEpochUnstake = sdk.NewInt(1000)
+	hc.Params.MaxEpochRedeemRatio = sdk.MustNewDecFromStr("0.1")
+	hc.Validators[0].DelegatedAmount = sdk.NewInt(1000000000)
+	pstakeapp.LiquidStakeIBCKeeper.SetHostChain(ctx, hc)
+
+	// the unstake cap applies to the aggregate unbond amount of the unbonding epoch
+	_, err = k.LiquidUnstake(ctx, types.NewMsgLiquidUnstake(sdk.NewInt64Coin(hc.MintDenom(), 1001), delegator))
+	suite.Require().ErrorIs(err, types.ErrUnstakeCapExceeded)
+
+	_, err = k.LiquidUnstake(ctx, types.NewMsgLiquidUnstake(sdk.NewInt64Coin(hc.MintDenom(), 600), delegator))
+	suite.Require().NoError(err)
+
+	_, err = k.LiquidUnstake(ctx, types.NewMsgLiquidUnstake(sdk.NewInt64Coin(hc.MintDenom(), 401), delegator))
+	suite.Require().ErrorIs(err, types.ErrUnstakeCapExceeded)
+
+	_, err = k.LiquidUnstake(ctx, types.NewMsgLiquidUnstake(sdk.NewInt64Coin(hc.MintDenom(), 400), delegator))
+	suite.Require().NoError(err)
+
+	// the redeem cap is a ratio of the deposits available at the start of the epoch
+	depositAccountBalance := pstakeapp.BankKeeper.GetBalance(
+		ctx,
+		pstakeapp.LiquidStakeIBCKeeper.GetDepositModuleAccount(ctx).GetAddress(),
+		hc.IBCDenom(),
+	)
+	redeemCap := depositAccountBalance.Amount.QuoRaw(10)
+
+	_, err = k.Redeem(ctx, types.NewMsgRedeem(sdk.NewCoin(hc.MintDenom(), redeemCap.AddRaw(1)), delegator))
+	suite.Require().ErrorIs(err, types.ErrRedeemCapExceeded)
+
+	_, err = k.Redeem(ctx, types.NewMsgRedeem(sdk.NewCoin(hc.MintDenom(), redeemCap.QuoRaw(2)), delegator))
+	suite.Require().NoError(err)
+
+	_, err = k.Redeem(ctx, types.NewMsgRedeem(sdk.NewCoin(hc.MintDenom(), redeemCap.Sub(redeemCap.QuoRaw(2))), delegator))
+	suite.Require().NoError(err)
+
+	_, err = k.Redeem(ctx, types.NewMsgRedeem(sdk.NewInt64Coin(hc.MintDenom(), 1), delegator))
+	suite.Require().ErrorIs(err, types.ErrRedeemCapExceeded)
+
+	suite.Require().Equal(
+		redeemCap,
+		pstakeapp.LiquidStakeIBCKeeper.GetEpochRedeemOutflow(ctx, hc.ChainId, epoch.CurrentEpoch),
+	)
+}
+
 func (suite *IntegrationTestSuite) Test_msgServer_RegisterHostChain() {
 	pstakeapp, ctx := suite.app, suite.ctx
 
+	// the stk tokens of a deregistered host chain still being redeemed
+	pstakeapp.LiquidStakeIBCKeeper.SetRedemptionRecord(ctx, &types.RedemptionRecord{
+		ChainId:   "deregistered",
+		MintDenom: "stk/uredeemed",
+		IbcDenom:  "ibc/uredeemed",
+		CValue:    sdk.OneDec(),
+		Amount:    sdk.NewInt(100),
+		StkAmount: sdk.NewInt(100),
+	})
+
 	type args struct {
 		goCtx context.Context
 		msg   *types.MsgRegisterHostChain
@@ -378,6 +536,28 @@ func (suite *IntegrationTestSuite) Test_msgServer_RegisterHostChain() {
 		want    *types.MsgRegisterHostChainResponse
 		wantErr bool
 	}{
+		{
+			name: "redeemed stk denom",
+			args: args{
+				goCtx: ctx,
+				msg: &types.MsgRegisterHostChain{
+					Authority:          suite.chainA.SenderAccount.GetAddress().String(),
+					ConnectionId:       suite.transferPathAC.EndpointA.ConnectionID,
+					DepositFee:         sdk.ZeroDec(),
+					RestakeFee:         sdk.ZeroDec(),
+					UnstakeFee:         sdk.ZeroDec(),
+					RedemptionFee:      sdk.ZeroDec(),
+					ChannelId:          suite.transferPathAC.EndpointA.ChannelID,
+					PortId:             suite.transferPathAC.EndpointA.ChannelConfig.PortID,
+					HostDenom:          "uredeemed",
+					MinimumDeposit:     sdk.OneInt(),
+					UnbondingFactor:    4,
+					AutoCompoundFactor: 2,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "success",
 			args: args{
@@ -414,6 +594,10 @@ func (suite *IntegrationTestSuite) Test_msgServer_RegisterHostChain() {
 			}
 		})
 	}
+
+	hc, found := pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainC.ChainID)
+	suite.Require().True(found)
+	suite.Require().Equal(pstakeapp.LiquidStakeIBCKeeper.GetEpochNumber(ctx, types.DelegationEpoch), hc.RegistrationEpoch)
 }
 
 func (suite *IntegrationTestSuite) Test_msgServer_UpdateHostChain() {
@@ -444,11 +628,74 @@ func (suite *IntegrationTestSuite) Test_msgServer_UpdateHostChain() {
 					}, {
 						Key:   types.KeyAutocompoundFactor,
 						Value: "20",
+					}, {
+						Key:   types.KeyDelegationStrategy,
+						Value: types.HostChain_DELEGATION_STRATEGY_WEIGHT_PROPORTIONAL.String(),
 					}},
 				},
 			},
 			want:    &types.MsgUpdateHostChainResponse{},
 			wantErr: false,
+		}, {
+			name: "apply validator set without proposal",
+			args: args{
+				goCtx: ctx,
+				msg: &types.MsgUpdateHostChain{
+					Authority: suite.chainA.SenderAccount.GetAddress().String(),
+					ChainId:   hc.ChainId,
+					Updates: []*types.KVUpdate{{
+						Key:   types.KeyApplyValidatorSet,
+						Value: "",
+					}},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		}, {
+			name: "validator set config",
+			args: args{
+				goCtx: ctx,
+				msg: &types.MsgUpdateHostChain{
+					Authority: suite.chainA.SenderAccount.GetAddress().String(),
+					ChainId:   hc.ChainId,
+					Updates: []*types.KVUpdate{{
+						Key:   types.KeyValidatorSetConfig,
+						Value: `{"enabled":true,"max_validators":10,"max_weight":"0.5","max_commission":"0.1"}`,
+					}},
+				},
+			},
+			want:    &types.MsgUpdateHostChainResponse{},
+			wantErr: false,
+		}, {
+			name: "vote signaling",
+			args: args{
+				goCtx: ctx,
+				msg: &types.MsgUpdateHostChain{
+					Authority: suite.chainA.SenderAccount.GetAddress().String(),
+					ChainId:   hc.ChainId,
+					Updates: []*types.KVUpdate{{
+						Key:   types.KeyVoteSignaling,
+						Value: fmt.Sprintf(`{"proposal_id":1,"end_time":%q}`, ctx.BlockTime().Add(time.Hour).Format(time.RFC3339)),
+					}},
+				},
+			},
+			want:    &types.MsgUpdateHostChainResponse{},
+			wantErr: false,
+		}, {
+			name: "vote signaling ending in the past",
+			args: args{
+				goCtx: ctx,
+				msg: &types.MsgUpdateHostChain{
+					Authority: suite.chainA.SenderAccount.GetAddress().String(),
+					ChainId:   hc.ChainId,
+					Updates: []*types.KVUpdate{{
+						Key:   types.KeyVoteSignaling,
+						Value: fmt.Sprintf(`{"proposal_id":2,"end_time":%q}`, ctx.BlockTime().Add(-time.Hour).Format(time.RFC3339)),
+					}},
+				},
+			},
+			want:    nil,
+			wantErr: true,
 		}}
 	for _, tt := range tests {
 		suite.T().Run(tt.name, func(t *testing.T) {
@@ -510,3 +757,580 @@ func (suite *IntegrationTestSuite) Test_msgServer_UpdateParams() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) Test_msgServer_CombinedCValueLimits() {
+	pstakeapp, ctx := suite.app, suite.ctx
+	k := keeper.NewMsgServerImpl(pstakeapp.LiquidStakeIBCKeeper)
+	authority := suite.chainA.SenderAccount.GetAddress().String()
+
+	hc, found := pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	hc.Params.LowerCValueLimit = sdk.ZeroDec()
+	hc.Params.UpperCValueLimit = sdk.MustNewDecFromStr("0.95")
+	pstakeapp.LiquidStakeIBCKeeper.SetHostChain(ctx, hc)
+
+	// the module lower limit can't reach the host chain upper limit
+	params := pstakeapp.LiquidStakeIBCKeeper.GetParams(ctx)
+	params.LowerCValueLimit = sdk.MustNewDecFromStr("0.96")
+	_, err := k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	suite.Require().Error(err)
+
+	params.LowerCValueLimit = sdk.MustNewDecFromStr("0.9")
+	params.UpperCValueLimit = sdk.OneDec()
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	suite.Require().NoError(err)
+
+	// the host chain upper limit can't go below the module lower limit
+	_, err = k.UpdateHostChain(ctx, types.NewMsgUpdateHostChain(
+		hc.ChainId,
+		authority,
+		[]*types.KVUpdate{{Key: types.KeyUpperCValueLimit, Value: "0.85"}},
+	))
+	suite.Require().Error(err)
+
+	// unsetting the host chain upper limit falls back to the module one
+	_, err = k.UpdateHostChain(ctx, types.NewMsgUpdateHostChain(
+		hc.ChainId,
+		authority,
+		[]*types.KVUpdate{{Key: types.KeyUpperCValueLimit, Value: "0"}},
+	))
+	suite.Require().NoError(err)
+
+	// the host chain lower limit can't reach the module upper limit
+	_, err = k.UpdateHostChain(ctx, types.NewMsgUpdateHostChain(
+		hc.ChainId,
+		authority,
+		[]*types.KVUpdate{{Key: types.KeyLowerCValueLimit, Value: "1"}},
+	))
+	suite.Require().Error(err)
+}
+
+func (suite *IntegrationTestSuite) Test_msgServer_VoteOnHostChainProposal() {
+	pstakeapp, ctx := suite.app, suite.ctx
+	hc, found := pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	tests := []struct {
+		name    string
+		msg     *types.MsgVoteOnHostChainProposal
+		wantErr bool
+	}{
+		{
+			name: "success",
+			msg: types.NewMsgVoteOnHostChainProposal(
+				suite.chainA.SenderAccount.GetAddress(),
+				hc.ChainId,
+				1,
+				govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes),
+			),
+			wantErr: false,
+		}, {
+			name: "not an authority",
+			msg: types.NewMsgVoteOnHostChainProposal(
+				suite.chainB.SenderAccount.GetAddress(),
+				hc.ChainId,
+				1,
+				govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes),
+			),
+			wantErr: true,
+		}, {
+			name: "host chain not registered",
+			msg: types.NewMsgVoteOnHostChainProposal(
+				suite.chainA.SenderAccount.GetAddress(),
+				"chain-1",
+				1,
+				govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes),
+			),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			k := keeper.NewMsgServerImpl(pstakeapp.LiquidStakeIBCKeeper)
+			_, err := k.VoteOnHostChainProposal(ctx, tt.msg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("VoteOnHostChainProposal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) Test_msgServer_SignalHostChainVote() {
+	pstakeapp, ctx := suite.app, suite.ctx
+	hc, found := pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	holder := authtypes.NewModuleAddress("holder")
+	suite.Require().NoError(testutil.FundAccount(
+		pstakeapp.BankKeeper,
+		ctx,
+		holder,
+		sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 100)),
+	))
+
+	pstakeapp.LiquidStakeIBCKeeper.SetVoteSignaling(ctx, &types.VoteSignaling{
+		ChainId:    hc.ChainId,
+		ProposalId: 1,
+		EndTime:    ctx.BlockTime().Add(time.Hour),
+	})
+	pstakeapp.LiquidStakeIBCKeeper.SetVoteSignaling(ctx, &types.VoteSignaling{
+		ChainId:    hc.ChainId,
+		ProposalId: 2,
+		EndTime:    ctx.BlockTime(),
+	})
+
+	yes := govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes)
+	tests := []struct {
+		name    string
+		msg     *types.MsgSignalHostChainVote
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			msg:     types.NewMsgSignalHostChainVote(holder, hc.ChainId, 1, yes),
+			wantErr: false,
+		}, {
+			name: "resignal",
+			msg: types.NewMsgSignalHostChainVote(
+				holder,
+				hc.ChainId,
+				1,
+				govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionNo),
+			),
+			wantErr: false,
+		}, {
+			name:    "no stk balance",
+			msg:     types.NewMsgSignalHostChainVote(authtypes.NewModuleAddress("no-holder"), hc.ChainId, 1, yes),
+			wantErr: true,
+		}, {
+			name:    "signaling ended",
+			msg:     types.NewMsgSignalHostChainVote(holder, hc.ChainId, 2, yes),
+			wantErr: true,
+		}, {
+			name:    "no signaling",
+			msg:     types.NewMsgSignalHostChainVote(holder, hc.ChainId, 3, yes),
+			wantErr: true,
+		}, {
+			name:    "host chain not registered",
+			msg:     types.NewMsgSignalHostChainVote(holder, "chain-1", 1, yes),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			k := keeper.NewMsgServerImpl(pstakeapp.LiquidStakeIBCKeeper)
+			_, err := k.SignalHostChainVote(ctx, tt.msg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SignalHostChainVote() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// the latest signal of the holder is kept with the escrowed balance
+	signal, found := pstakeapp.LiquidStakeIBCKeeper.GetVoteSignal(ctx, hc.ChainId, 1, holder.String())
+	suite.Require().True(found)
+	suite.Require().Equal(govv1beta1.OptionNo, signal.Options[0].Option)
+	suite.Require().Equal(sdk.NewInt(100), signal.Power)
+	suite.Require().True(pstakeapp.BankKeeper.GetBalance(ctx, holder, hc.MintDenom()).IsZero())
+
+	// the power of the replaced signal is taken out of the signaling
+	signaling, _ := pstakeapp.LiquidStakeIBCKeeper.GetVoteSignaling(ctx, hc.ChainId, 1)
+	suite.Require().Equal(sdk.NewInt(100), signaling.TotalPower)
+	suite.Require().Equal(
+		govv1beta1.WeightedVoteOptions(govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionNo)).String(),
+		govv1beta1.WeightedVoteOptions(signaling.CurrentTally()).String(),
+	)
+}
+
+func (suite *IntegrationTestSuite) Test_msgServer_SignalHostChainVoteEscrow() {
+	pstakeapp, ctx := suite.app, suite.ctx
+	k := keeper.NewMsgServerImpl(pstakeapp.LiquidStakeIBCKeeper)
+	hc, found := pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	first, second := authtypes.NewModuleAddress("first"), authtypes.NewModuleAddress("second")
+	suite.Require().NoError(testutil.FundAccount(
+		pstakeapp.BankKeeper,
+		ctx,
+		first,
+		sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 100)),
+	))
+
+	pstakeapp.LiquidStakeIBCKeeper.SetVoteSignaling(ctx, &types.VoteSignaling{
+		ChainId:    hc.ChainId,
+		ProposalId: 1,
+		EndTime:    ctx.BlockTime().Add(time.Hour),
+	})
+
+	yes := govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes)
+	_, err := k.SignalHostChainVote(ctx, types.NewMsgSignalHostChainVote(first, hc.ChainId, 1, yes))
+	suite.Require().NoError(err)
+
+	// the signaled stk is escrowed, it can't be moved to another signaler and signaled again
+	err = pstakeapp.BankKeeper.SendCoins(ctx, first, second, sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 100)))
+	suite.Require().Error(err)
+	_, err = k.SignalHostChainVote(ctx, types.NewMsgSignalHostChainVote(second, hc.ChainId, 1, yes))
+	suite.Require().Error(err)
+
+	// stk received after signaling is added to the signal of its holder
+	suite.Require().NoError(testutil.FundAccount(
+		pstakeapp.BankKeeper,
+		ctx,
+		second,
+		sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 50)),
+	))
+	suite.Require().NoError(
+		pstakeapp.BankKeeper.SendCoins(ctx, second, first, sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 50))),
+	)
+	_, err = k.SignalHostChainVote(ctx, types.NewMsgSignalHostChainVote(first, hc.ChainId, 1, yes))
+	suite.Require().NoError(err)
+	_, err = k.SignalHostChainVote(ctx, types.NewMsgSignalHostChainVote(second, hc.ChainId, 1, yes))
+	suite.Require().Error(err)
+
+	signaling, _ := pstakeapp.LiquidStakeIBCKeeper.GetVoteSignaling(ctx, hc.ChainId, 1)
+	suite.Require().Equal(sdk.NewInt(150), signaling.TotalPower)
+
+	// the escrow is returned once the closed signaling is pruned
+	signaling.Tallied, signaling.Closed = true, true
+	pstakeapp.LiquidStakeIBCKeeper.SetVoteSignaling(ctx, signaling)
+	pstakeapp.LiquidStakeIBCKeeper.DoTallyVoteSignalings(ctx, hc)
+
+	_, found = pstakeapp.LiquidStakeIBCKeeper.GetVoteSignal(ctx, hc.ChainId, 1, first.String())
+	suite.Require().False(found)
+	suite.Require().Equal(
+		sdk.NewInt64Coin(hc.MintDenom(), 150),
+		pstakeapp.BankKeeper.GetBalance(ctx, first, hc.MintDenom()),
+	)
+}
+
+func (suite *IntegrationTestSuite) Test_msgServer_DeregisterHostChain() {
+	pstakeapp, ctx := suite.app, suite.ctx
+	hc, found := pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	tests := []struct {
+		name    string
+		msg     *types.MsgDeregisterHostChain
+		wantErr bool
+	}{
+		{
+			name:    "not an authority",
+			msg:     types.NewMsgDeregisterHostChain(suite.chainB.SenderAccount.GetAddress(), hc.ChainId),
+			wantErr: true,
+		}, {
+			name:    "host chain not registered",
+			msg:     types.NewMsgDeregisterHostChain(suite.chainA.SenderAccount.GetAddress(), "chain-1"),
+			wantErr: true,
+		}, {
+			name:    "success",
+			msg:     types.NewMsgDeregisterHostChain(suite.chainA.SenderAccount.GetAddress(), hc.ChainId),
+			wantErr: false,
+		}, {
+			name:    "already deregistering",
+			msg:     types.NewMsgDeregisterHostChain(suite.chainA.SenderAccount.GetAddress(), hc.ChainId),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			k := keeper.NewMsgServerImpl(pstakeapp.LiquidStakeIBCKeeper)
+			_, err := k.DeregisterHostChain(ctx, tt.msg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeregisterHostChain() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	hc, _ = pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, hc.ChainId)
+	suite.Require().False(hc.Active)
+	suite.Require().Equal(types.Deregistration_DEREGISTRATION_UNBONDING, hc.Deregistration.State)
+
+	// a host chain being deregistered can't be updated
+	_, err := keeper.NewMsgServerImpl(pstakeapp.LiquidStakeIBCKeeper).UpdateHostChain(ctx, &types.MsgUpdateHostChain{
+		Authority: suite.chainA.SenderAccount.GetAddress().String(),
+		ChainId:   hc.ChainId,
+		Updates:   []*types.KVUpdate{{Key: types.KeyActive, Value: "true"}},
+	})
+	suite.Require().ErrorIs(err, types.ErrHostChainDeregistering)
+}
+
+func (suite *IntegrationTestSuite) Test_msgServer_Pauses() {
+	pstakeapp, ctx := suite.app, suite.ctx
+	hc, found := pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	guardian := authtypes.NewModuleAddress("guardian")
+	params := pstakeapp.LiquidStakeIBCKeeper.GetParams(ctx)
+	params.GuardianAddress = guardian.String()
+	pstakeapp.LiquidStakeIBCKeeper.SetParams(ctx, params)
+
+	pauses := `{"liquid_stake":true,"unstake":true}`
+	tests := []struct {
+		name    string
+		msg     *types.MsgUpdateHostChain
+		wantErr bool
+	}{
+		{
+			name: "guardian can't update other keys",
+			msg: types.NewMsgUpdateHostChain(
+				hc.ChainId,
+				guardian.String(),
+				[]*types.KVUpdate{{Key: types.KeyPauses, Value: pauses}, {Key: types.KeyActive, Value: "false"}},
+			),
+			wantErr: true,
+		}, {
+			name: "not an authority",
+			msg: types.NewMsgUpdateHostChain(
+				hc.ChainId,
+				suite.chainB.SenderAccount.GetAddress().String(),
+				[]*types.KVUpdate{{Key: types.KeyPauses, Value: pauses}},
+			),
+			wantErr: true,
+		}, {
+			name: "guardian pauses",
+			msg: types.NewMsgUpdateHostChain(
+				hc.ChainId,
+				guardian.String(),
+				[]*types.KVUpdate{{Key: types.KeyPauses, Value: pauses}},
+			),
+			wantErr: false,
+		}, {
+			name: "guardian pauses another flow",
+			msg: types.NewMsgUpdateHostChain(
+				hc.ChainId,
+				guardian.String(),
+				[]*types.KVUpdate{{Key: types.KeyPauses, Value: `{"redeem":true}`}},
+			),
+			wantErr: false,
+		}, {
+			name: "guardian can't unpause",
+			msg: types.NewMsgUpdateHostChain(
+				hc.ChainId,
+				guardian.String(),
+				[]*types.KVUpdate{{Key: types.KeyPauses, Value: `{"lsm_stake":true,"liquid_stake":false}`}},
+			),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			k := keeper.NewMsgServerImpl(pstakeapp.LiquidStakeIBCKeeper)
+			_, err := k.UpdateHostChain(ctx, tt.msg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateHostChain() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	hc, _ = pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, hc.ChainId)
+	suite.Require().True(hc.Active)
+	suite.Require().Equal(&types.HostChainPauses{LiquidStake: true, Unstake: true, Redeem: true}, hc.Pauses)
+
+	// the paused operations are rejected
+	msgServer := keeper.NewMsgServerImpl(pstakeapp.LiquidStakeIBCKeeper)
+	_, err := msgServer.LiquidStake(ctx, types.NewMsgLiquidStake(
+		sdk.NewInt64Coin(hc.IBCDenom(), 1000),
+		suite.chainA.SenderAccount.GetAddress(),
+	))
+	suite.Require().ErrorIs(err, types.ErrOperationPaused)
+
+	_, err = msgServer.LiquidUnstake(ctx, types.NewMsgLiquidUnstake(
+		sdk.NewInt64Coin(hc.MintDenom(), 1000),
+		suite.chainA.SenderAccount.GetAddress(),
+	))
+	suite.Require().ErrorIs(err, types.ErrOperationPaused)
+
+	// the admin can unpause them, the flows not present keep their pauses
+	_, err = msgServer.UpdateHostChain(ctx, types.NewMsgUpdateHostChain(
+		hc.ChainId,
+		suite.chainA.SenderAccount.GetAddress().String(),
+		[]*types.KVUpdate{{Key: types.KeyPauses, Value: `{"liquid_stake":false,"unstake":false}`}},
+	))
+	suite.Require().NoError(err)
+
+	hc, _ = pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(&types.HostChainPauses{Redeem: true}, hc.Pauses)
+}
+
+func (suite *IntegrationTestSuite) Test_msgServer_UpdateHostChainRoles() {
+	pstakeapp, ctx := suite.app, suite.ctx
+	hc, found := pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	feeManager := authtypes.NewModuleAddress("fee-manager")
+	validatorSetManager := authtypes.NewModuleAddress("validator-set-manager")
+	params := pstakeapp.LiquidStakeIBCKeeper.GetParams(ctx)
+	params.FeeManagerAddress = feeManager.String()
+	params.ValidatorSetManagerAddress = validatorSetManager.String()
+	params.UpdateTimelockEpochs = 0
+	pstakeapp.LiquidStakeIBCKeeper.SetParams(ctx, params)
+
+	tests := []struct {
+		name    string
+		msg     *types.MsgUpdateHostChain
+		wantErr bool
+	}{
+		{
+			name: "fee manager updates fees",
+			msg: types.NewMsgUpdateHostChain(
+				hc.ChainId,
+				feeManager.String(),
+				[]*types.KVUpdate{{Key: types.KeyDepositFee, Value: "0.01"}, {Key: types.KeyUnstakeFee, Value: "0.02"}},
+			),
+			wantErr: false,
+		}, {
+			name: "fee manager can't update the validator set",
+			msg: types.NewMsgUpdateHostChain(
+				hc.ChainId,
+				feeManager.String(),
+				[]*types.KVUpdate{{Key: types.KeyDelegationStrategy, Value: "DELEGATION_STRATEGY_EQUAL_SPLIT"}},
+			),
+			wantErr: true,
+		}, {
+			name: "validator set manager updates the delegation strategy",
+			msg: types.NewMsgUpdateHostChain(
+				hc.ChainId,
+				validatorSetManager.String(),
+				[]*types.KVUpdate{{Key: types.KeyDelegationStrategy, Value: "DELEGATION_STRATEGY_EQUAL_SPLIT"}},
+			),
+			wantErr: false,
+		}, {
+			name:    "no updates",
+			msg:     types.NewMsgUpdateHostChain(hc.ChainId, feeManager.String(), nil),
+			wantErr: true,
+		}, {
+			name: "validator set manager can't update fees",
+			msg: types.NewMsgUpdateHostChain(
+				hc.ChainId,
+				validatorSetManager.String(),
+				[]*types.KVUpdate{{Key: types.KeyRedemptionFee, Value: "0.01"}},
+			),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			k := keeper.NewMsgServerImpl(pstakeapp.LiquidStakeIBCKeeper)
+			_, err := k.UpdateHostChain(ctx, tt.msg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateHostChain() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	hc, _ = pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.01"), hc.Params.DepositFee)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.02"), hc.Params.UnstakeFee)
+	suite.Require().Equal(types.HostChain_DELEGATION_STRATEGY_EQUAL_SPLIT, hc.DelegationStrategy)
+}
+
+func (suite *IntegrationTestSuite) Test_msgServer_CancelTimelockedUpdate() {
+	pstakeapp, ctx := suite.app, suite.ctx
+	hc, found := pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	guardian := authtypes.NewModuleAddress("guardian")
+	params := pstakeapp.LiquidStakeIBCKeeper.GetParams(ctx)
+	params.GuardianAddress = guardian.String()
+	pstakeapp.LiquidStakeIBCKeeper.SetParams(ctx, params)
+
+	update := pstakeapp.LiquidStakeIBCKeeper.QueueTimelockedUpdate(
+		ctx,
+		hc.ChainId,
+		suite.chainA.SenderAccount.GetAddress().String(),
+		[]*types.KVUpdate{{Key: types.KeyRedemptionFee, Value: "0.1"}},
+	)
+
+	tests := []struct {
+		name    string
+		msg     *types.MsgCancelTimelockedUpdate
+		wantErr bool
+	}{
+		{
+			name:    "not an authority",
+			msg:     types.NewMsgCancelTimelockedUpdate(suite.chainB.SenderAccount.GetAddress(), hc.ChainId, update.Id),
+			wantErr: true,
+		}, {
+			name:    "update not found",
+			msg:     types.NewMsgCancelTimelockedUpdate(guardian, hc.ChainId, update.Id+1),
+			wantErr: true,
+		}, {
+			name:    "guardian cancels",
+			msg:     types.NewMsgCancelTimelockedUpdate(guardian, hc.ChainId, update.Id),
+			wantErr: false,
+		}, {
+			name:    "already cancelled",
+			msg:     types.NewMsgCancelTimelockedUpdate(guardian, hc.ChainId, update.Id),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			k := keeper.NewMsgServerImpl(pstakeapp.LiquidStakeIBCKeeper)
+			_, err := k.CancelTimelockedUpdate(ctx, tt.msg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CancelTimelockedUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	suite.Require().Empty(pstakeapp.LiquidStakeIBCKeeper.GetTimelockedUpdates(ctx, hc.ChainId))
+}
+
+func (suite *IntegrationTestSuite) Test_msgServer_Claim() {
+	pstakeapp, ctx := suite.app, suite.ctx
+	hc, found := pstakeapp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	delegator := authtypes.NewModuleAddress("delegator")
+	recipient := authtypes.NewModuleAddress("recipient")
+	suite.setClaimableUnbondings(hc, 100, []sdk.AccAddress{delegator})
+	pstakeapp.LiquidStakeIBCKeeper.SetAutoClaim(ctx, delegator.String(), false)
+
+	tests := []struct {
+		name    string
+		msg     *types.MsgClaim
+		wantErr bool
+	}{
+		{
+			name:    "host chain not registered",
+			msg:     types.NewMsgClaim(delegator, "chain-1", ""),
+			wantErr: true,
+		}, {
+			name:    "success",
+			msg:     types.NewMsgClaim(delegator, hc.ChainId, recipient.String()),
+			wantErr: false,
+		}, {
+			name:    "nothing to claim",
+			msg:     types.NewMsgClaim(delegator, hc.ChainId, ""),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		suite.T().Run(tt.name, func(t *testing.T) {
+			k := keeper.NewMsgServerImpl(pstakeapp.LiquidStakeIBCKeeper)
+			_, err := k.Claim(ctx, tt.msg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Claim() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// the tokens went to the recipient instead of the delegator
+	suite.Require().Equal(sdk.NewInt(110), pstakeapp.BankKeeper.GetBalance(ctx, recipient, hc.IBCDenom()).Amount)
+	suite.Require().True(pstakeapp.BankKeeper.GetBalance(ctx, delegator, hc.IBCDenom()).IsZero())
+}
+
+func (suite *IntegrationTestSuite) Test_msgServer_SetAutoClaim() {
+	pstakeapp, ctx := suite.app, suite.ctx
+	delegator := authtypes.NewModuleAddress("delegator")
+
+	k := keeper.NewMsgServerImpl(pstakeapp.LiquidStakeIBCKeeper)
+	_, err := k.SetAutoClaim(ctx, types.NewMsgSetAutoClaim(delegator, false))
+	suite.Require().NoError(err)
+	suite.Require().False(pstakeapp.LiquidStakeIBCKeeper.IsAutoClaimEnabled(ctx, delegator.String()))
+
+	_, err = k.SetAutoClaim(ctx, types.NewMsgSetAutoClaim(delegator, true))
+	suite.Require().NoError(err)
+	suite.Require().True(pstakeapp.LiquidStakeIBCKeeper.IsAutoClaimEnabled(ctx, delegator.String()))
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// GetEpochRedeemOutflow returns the amount instantly redeemed from a host chain during an epoch
+func (k *Keeper) GetEpochRedeemOutflow(ctx sdk.Context, chainID string, epoch int64) math.Int {
+	return k.getEpochAmount(ctx, types.RedeemOutflowKey, types.GetRedeemOutflowStoreKey(chainID, epoch))
+}
+
+// AddRedeemOutflow increases the amount instantly redeemed from a host chain during an epoch
+func (k *Keeper) AddRedeemOutflow(ctx sdk.Context, chainID string, epoch int64, amount math.Int) {
+	key := types.GetRedeemOutflowStoreKey(chainID, epoch)
+	k.setEpochAmount(ctx, types.RedeemOutflowKey, key, k.getEpochAmount(ctx, types.RedeemOutflowKey, key).Add(amount))
+}
+
+// SetRedeemOutflow sets the amount instantly redeemed from a host chain during an epoch
+func (k *Keeper) SetRedeemOutflow(ctx sdk.Context, outflow *types.RedeemOutflow) {
+	k.setEpochAmount(
+		ctx,
+		types.RedeemOutflowKey,
+		types.GetRedeemOutflowStoreKey(outflow.ChainId, outflow.Epoch),
+		outflow.Amount,
+	)
+}
+
+// GetAllRedeemOutflows returns the redeem outflows of all the host chains
+func (k *Keeper) GetAllRedeemOutflows(ctx sdk.Context) []*types.RedeemOutflow {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedeemOutflowKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	outflows := make([]*types.RedeemOutflow, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		chainID, epoch, err := types.ParseRedeemOutflowStoreKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		var amount sdk.IntProto
+		k.cdc.MustUnmarshal(iterator.Value(), &amount)
+		outflows = append(outflows, &types.RedeemOutflow{ChainId: chainID, Epoch: epoch, Amount: amount.Int})
+	}
+
+	return outflows
+}
+
+// DeleteRedeemOutflowsBeforeEpoch removes the redeem outflows of a host chain for all the epochs previous to the one
+// provided
+func (k *Keeper) DeleteRedeemOutflowsBeforeEpoch(ctx sdk.Context, chainID string, epoch int64) {
+	k.deleteEpochAmounts(
+		ctx,
+		types.RedeemOutflowKey,
+		types.GetRedeemOutflowChainPrefix(chainID),
+		types.GetRedeemOutflowStoreKey(chainID, epoch),
+	)
+}
+
+// GetOutflowQuota computes how much more can be liquid unstaked and instantly redeemed from a host chain,
+// a nil remaining amount means the cap is disabled
+func (k *Keeper) GetOutflowQuota(ctx sdk.Context, hc *types.HostChain) *types.QueryOutflowQuotaResponse {
+	unbondingEpoch := types.CurrentUnbondingEpoch(hc.UnbondingFactor, k.GetEpochNumber(ctx, types.UndelegationEpoch))
+	epoch := k.GetEpochNumber(ctx, types.DelegationEpoch)
+
+	quota := &types.QueryOutflowQuotaResponse{
+		UnbondingEpoch: unbondingEpoch,
+		EpochUnstake:   sdk.ZeroInt(),
+		Epoch:          epoch,
+		EpochRedeem:    k.GetEpochRedeemOutflow(ctx, hc.ChainId, epoch),
+	}
+
+	unbonding, found := k.GetUnbonding(ctx, hc.ChainId, unbondingEpoch)
+	if found {
+		quota.EpochUnstake = unbonding.UnbondAmount.Amount
+	}
+	quota.RemainingEpochUnstake = remainingCapacity(hc.Params.MaxEpochUnstake, quota.EpochUnstake)
+
+	// the redeem cap is a ratio of the deposits available at the beginning of the epoch, which are the ones still
+	// in the deposit account plus the ones already redeemed
+	ratio := hc.Params.MaxEpochRedeemRatio
+	if !ratio.IsNil() && ratio.IsPositive() {
+		depositAccountBalance := k.bankKeeper.GetBalance(
+			ctx,
+			authtypes.NewModuleAddress(types.DepositModuleAccount),
+			hc.IBCDenom(),
+		)
+
+		limit := ratio.MulInt(depositAccountBalance.Amount.Add(quota.EpochRedeem)).TruncateInt()
+		remaining := sdk.ZeroInt()
+		if limit.GT(quota.EpochRedeem) {
+			remaining = limit.Sub(quota.EpochRedeem)
+		}
+		quota.RemainingEpochRedeem = &remaining
+	}
+
+	return quota
+}
+
+// ValidateUnstakeCap checks that unbonding an amount from a host chain doesn't exceed its unbonding epoch cap
+func (k *Keeper) ValidateUnstakeCap(ctx sdk.Context, hc *types.HostChain, amount math.Int) error {
+	quota := k.GetOutflowQuota(ctx, hc)
+
+	if quota.RemainingEpochUnstake != nil && amount.GT(*quota.RemainingEpochUnstake) {
+		return errorsmod.Wrapf(
+			types.ErrUnstakeCapExceeded,
+			"host chain %s unbonding epoch %d unstake cap is %s, remaining %s, got %s",
+			hc.ChainId,
+			quota.UnbondingEpoch,
+			hc.Params.MaxEpochUnstake,
+			quota.RemainingEpochUnstake,
+			amount,
+		)
+	}
+
+	return nil
+}
+
+// ValidateRedeemCap checks that instantly redeeming an amount from a host chain doesn't exceed its epoch cap
+func (k *Keeper) ValidateRedeemCap(ctx sdk.Context, hc *types.HostChain, amount math.Int) error {
+	quota := k.GetOutflowQuota(ctx, hc)
+
+	if quota.RemainingEpochRedeem != nil && amount.GT(*quota.RemainingEpochRedeem) {
+		return errorsmod.Wrapf(
+			types.ErrRedeemCapExceeded,
+			"host chain %s epoch redeem cap is %s of the deposits, remaining %s, got %s",
+			hc.ChainId,
+			hc.Params.MaxEpochRedeemRatio,
+			quota.RemainingEpochRedeem,
+			amount,
+		)
+	}
+
+	return nil
+}
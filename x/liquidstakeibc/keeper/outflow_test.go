@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestRedeemOutflows() {
+	k := suite.app.LiquidStakeIBCKeeper
+	chainID := suite.chainB.ChainID
+
+	k.AddRedeemOutflow(suite.ctx, chainID, 1, sdk.NewInt(100))
+	k.AddRedeemOutflow(suite.ctx, chainID, 1, sdk.NewInt(50))
+	k.AddRedeemOutflow(suite.ctx, chainID, 2, sdk.NewInt(10))
+	k.AddRedeemOutflow(suite.ctx, "other-chain", 1, sdk.NewInt(1000))
+
+	suite.Require().Equal(sdk.NewInt(150), k.GetEpochRedeemOutflow(suite.ctx, chainID, 1))
+	suite.Require().Equal(sdk.NewInt(10), k.GetEpochRedeemOutflow(suite.ctx, chainID, 2))
+	suite.Require().True(k.GetEpochRedeemOutflow(suite.ctx, chainID, 3).IsZero())
+
+	k.DeleteRedeemOutflowsBeforeEpoch(suite.ctx, chainID, 2)
+
+	suite.Require().True(k.GetEpochRedeemOutflow(suite.ctx, chainID, 1).IsZero())
+	suite.Require().Equal(sdk.NewInt(10), k.GetEpochRedeemOutflow(suite.ctx, chainID, 2))
+	suite.Require().Equal(sdk.NewInt(1000), k.GetEpochRedeemOutflow(suite.ctx, "other-chain", 1))
+
+	// the outflows are pruned at the start of every delegation epoch
+	err := k.BeforeEpochStart(suite.ctx, types.DelegationEpoch, 3)
+	suite.Require().NoError(err)
+	suite.Require().True(k.GetEpochRedeemOutflow(suite.ctx, chainID, 2).IsZero())
+}
+
+func (suite *IntegrationTestSuite) TestGetOutflowQuota() {
+	k := suite.app.LiquidStakeIBCKeeper
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	// no caps configured
+	quota := k.GetOutflowQuota(suite.ctx, hc)
+	suite.Require().True(quota.EpochUnstake.IsZero())
+	suite.Require().Nil(quota.RemainingEpochUnstake)
+	suite.Require().Nil(quota.RemainingEpochRedeem)
+
+	k.IncreaseUndelegatingAmountForEpoch(
+		suite.ctx,
+		hc.ChainId,
+		quota.UnbondingEpoch,
+		sdk.NewInt64Coin(hc.MintDenom(), 300),
+		sdk.NewInt64Coin(hc.HostDenom, 300),
+	)
+	k.AddRedeemOutflow(suite.ctx, hc.ChainId, quota.Epoch, sdk.NewInt(40))
+
+	hc.Params.MaxEpochUnstake = sdk.NewInt(1000)
+	hc.Params.MaxEpochRedeemRatio = sdk.MustNewDecFromStr("0.5")
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, k.GetDepositModuleAccount(suite.ctx).GetAddress(), hc.IBCDenom())
+	remainingRedeem := balance.Amount.AddRaw(40).QuoRaw(2).SubRaw(40)
+	if remainingRedeem.IsNegative() {
+		remainingRedeem = sdk.ZeroInt()
+	}
+
+	quota = k.GetOutflowQuota(suite.ctx, hc)
+	suite.Require().Equal(sdk.NewInt(300), quota.EpochUnstake)
+	suite.Require().Equal(sdk.NewInt(700), *quota.RemainingEpochUnstake)
+	suite.Require().Equal(sdk.NewInt(40), quota.EpochRedeem)
+	suite.Require().Equal(remainingRedeem, *quota.RemainingEpochRedeem)
+
+	suite.Require().NoError(k.ValidateUnstakeCap(suite.ctx, hc, sdk.NewInt(700)))
+	suite.Require().ErrorIs(k.ValidateUnstakeCap(suite.ctx, hc, sdk.NewInt(701)), types.ErrUnstakeCapExceeded)
+	suite.Require().NoError(k.ValidateRedeemCap(suite.ctx, hc, remainingRedeem))
+	suite.Require().ErrorIs(
+		k.ValidateRedeemCap(suite.ctx, hc, remainingRedeem.AddRaw(1)),
+		types.ErrRedeemCapExceeded,
+	)
+}
+
+func (suite *IntegrationTestSuite) TestSetGetAllRedeemOutflows() {
+	k := suite.app.LiquidStakeIBCKeeper
+	chainID := suite.chainB.ChainID
+
+	outflows := []*types.RedeemOutflow{
+		{ChainId: chainID, Epoch: 1, Amount: sdk.NewInt(100)},
+		{ChainId: chainID, Epoch: 2, Amount: sdk.NewInt(10)},
+	}
+	for _, outflow := range outflows {
+		k.SetRedeemOutflow(suite.ctx, outflow)
+	}
+
+	suite.Require().Equal(outflows, k.GetAllRedeemOutflows(suite.ctx))
+	suite.Require().Equal(sdk.NewInt(100), k.GetEpochRedeemOutflow(suite.ctx, chainID, 1))
+}
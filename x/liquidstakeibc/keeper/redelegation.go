@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetRedelegation(ctx sdk.Context, redelegation *types.Redelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationKey)
+	bytes := k.cdc.MustMarshal(redelegation)
+	store.Set(
+		types.GetRedelegationStoreKey(
+			redelegation.ChainId,
+			redelegation.SrcValidatorAddress,
+			redelegation.DstValidatorAddress,
+			redelegation.IbcSequenceId,
+		),
+		bytes,
+	)
+}
+
+func (k *Keeper) GetRedelegation(
+	ctx sdk.Context,
+	chainID string,
+	srcValidatorAddress string,
+	dstValidatorAddress string,
+	sequenceID string,
+) (*types.Redelegation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationKey)
+	bz := store.Get(types.GetRedelegationStoreKey(chainID, srcValidatorAddress, dstValidatorAddress, sequenceID))
+	if bz == nil {
+		return &types.Redelegation{}, false
+	}
+
+	var redelegation types.Redelegation
+	k.cdc.MustUnmarshal(bz, &redelegation)
+	return &redelegation, true
+}
+
+func (k *Keeper) DeleteRedelegation(ctx sdk.Context, redelegation *types.Redelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationKey)
+	store.Delete(
+		types.GetRedelegationStoreKey(
+			redelegation.ChainId,
+			redelegation.SrcValidatorAddress,
+			redelegation.DstValidatorAddress,
+			redelegation.IbcSequenceId,
+		),
+	)
+}
+
+func (k *Keeper) DeleteRedelegationsForSequenceID(ctx sdk.Context, sequenceID string) {
+	redelegations := k.FilterRedelegations(
+		ctx,
+		func(r types.Redelegation) bool {
+			return r.IbcSequenceId == sequenceID
+		},
+	)
+
+	for _, redelegation := range redelegations {
+		k.DeleteRedelegation(ctx, redelegation)
+	}
+}
+
+// GetActiveRedelegations returns the redelegations of a host chain that have not completed yet
+func (k *Keeper) GetActiveRedelegations(ctx sdk.Context, chainID string) []*types.Redelegation {
+	redelegations := make([]*types.Redelegation, 0)
+	for _, r := range k.GetRedelegationsForHostChain(ctx, chainID) {
+		if r.State == types.Redelegation_REDELEGATION_INITIATED || r.CompletionTime.After(ctx.BlockTime()) {
+			redelegations = append(redelegations, r)
+		}
+	}
+
+	return redelegations
+}
+
+// DeleteMaturedRedelegations removes the redelegations of a host chain that have already completed
+func (k *Keeper) DeleteMaturedRedelegations(ctx sdk.Context, chainID string) {
+	for _, r := range k.GetRedelegationsForHostChain(ctx, chainID) {
+		if r.State == types.Redelegation_REDELEGATION_MATURING && !r.CompletionTime.After(ctx.BlockTime()) {
+			k.DeleteRedelegation(ctx, r)
+		}
+	}
+}
+
+func (k *Keeper) FilterRedelegations(
+	ctx sdk.Context,
+	filter func(r types.Redelegation) bool,
+) []*types.Redelegation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	redelegations := make([]*types.Redelegation, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		redelegation := types.Redelegation{}
+		k.cdc.MustUnmarshal(iterator.Value(), &redelegation)
+		if filter(redelegation) {
+			redelegations = append(redelegations, &redelegation)
+		}
+	}
+
+	return redelegations
+}
+
+// GetRedelegationsForHostChain returns all the redelegations of a host chain iterating over its store key prefix
+func (k *Keeper) GetRedelegationsForHostChain(ctx sdk.Context, chainID string) []*types.Redelegation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(chainID))
+	defer iterator.Close()
+
+	redelegations := make([]*types.Redelegation, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		redelegation := types.Redelegation{}
+		k.cdc.MustUnmarshal(iterator.Value(), &redelegation)
+		// the prefix is shared with the chain ids it is a prefix of
+		if redelegation.ChainId == chainID {
+			redelegations = append(redelegations, &redelegation)
+		}
+	}
+
+	return redelegations
+}
+
+// RebalanceHostChain redelegates the host chain delegations towards the target weights of its validators
+func (k *Keeper) RebalanceHostChain(ctx sdk.Context, hc *types.HostChain) error {
+	// remove the redelegations that already completed on the host chain
+	k.DeleteMaturedRedelegations(ctx, hc.ChainId)
+
+	// the delegated amounts are only updated once the redelegations are acknowledged,
+	// so wait for any in-flight redelegation tx before computing new ones
+	activeRedelegations := k.GetActiveRedelegations(ctx, hc.ChainId)
+	for _, redelegation := range activeRedelegations {
+		if redelegation.State == types.Redelegation_REDELEGATION_INITIATED {
+			return errorsmod.Wrapf(
+				types.ErrRedelegationInProgress,
+				"redelegation tx %s has not been acknowledged yet",
+				redelegation.IbcSequenceId,
+			)
+		}
+	}
+
+	redelegations, err := k.GenerateRedelegations(hc, activeRedelegations)
+	if err != nil {
+		return err
+	}
+
+	messages := make([]proto.Message, 0, len(redelegations))
+	for _, redelegation := range redelegations {
+		messages = append(messages, &stakingtypes.MsgBeginRedelegate{
+			DelegatorAddress:    hc.DelegationAccount.Address,
+			ValidatorSrcAddress: redelegation.SrcValidatorAddress,
+			ValidatorDstAddress: redelegation.DstValidatorAddress,
+			Amount:              redelegation.Amount,
+		})
+	}
+
+	// execute the ICA transactions
+	sequenceID, err := k.GenerateAndExecuteICATx(
+		ctx,
+		hc.ConnectionId,
+		hc.DelegationAccount.Owner,
+		messages,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, redelegation := range redelegations {
+		redelegation.IbcSequenceId = sequenceID
+		redelegation.State = types.Redelegation_REDELEGATION_INITIATED
+		k.SetRedelegation(ctx, redelegation)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRedelegation,
+				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+				sdk.NewAttribute(types.AttributeSrcValidator, redelegation.SrcValidatorAddress),
+				sdk.NewAttribute(types.AttributeDstValidator, redelegation.DstValidatorAddress),
+				sdk.NewAttribute(types.AttributeAmount, redelegation.Amount.String()),
+			),
+		)
+	}
+
+	telemetry.IncrCounter(float32(len(redelegations)), hc.ChainId, "redelegations")
+
+	k.Logger(ctx).Info(
+		"Started host chain rebalance.",
+		"host_chain",
+		hc.ChainId,
+		"redelegations",
+		len(redelegations),
+		"sequence_id",
+		sequenceID,
+	)
+
+	return nil
+}
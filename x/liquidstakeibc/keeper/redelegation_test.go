@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestGetSetRedelegation() {
+	redelegation := &types.Redelegation{
+		ChainId:             suite.chainB.ChainID,
+		SrcValidatorAddress: "src",
+		DstValidatorAddress: "dst",
+		Amount:              sdk.NewInt64Coin(HostDenom, 100),
+		IbcSequenceId:       "channel-0-sequence-1",
+	}
+	suite.app.LiquidStakeIBCKeeper.SetRedelegation(suite.ctx, redelegation)
+
+	found, ok := suite.app.LiquidStakeIBCKeeper.GetRedelegation(
+		suite.ctx,
+		suite.chainB.ChainID,
+		"src",
+		"dst",
+		"channel-0-sequence-1",
+	)
+	suite.Require().True(ok)
+	suite.Require().Equal(redelegation.Amount, found.Amount)
+
+	suite.app.LiquidStakeIBCKeeper.DeleteRedelegation(suite.ctx, redelegation)
+	_, ok = suite.app.LiquidStakeIBCKeeper.GetRedelegation(
+		suite.ctx,
+		suite.chainB.ChainID,
+		"src",
+		"dst",
+		"channel-0-sequence-1",
+	)
+	suite.Require().False(ok)
+}
+
+func (suite *IntegrationTestSuite) TestGetActiveRedelegations() {
+	blockTime := suite.ctx.BlockTime()
+	redelegations := []*types.Redelegation{
+		{
+			ChainId:             suite.chainB.ChainID,
+			SrcValidatorAddress: "val0",
+			DstValidatorAddress: "val1",
+			IbcSequenceId:       "1",
+			State:               types.Redelegation_REDELEGATION_INITIATED,
+		},
+		{
+			ChainId:             suite.chainB.ChainID,
+			SrcValidatorAddress: "val0",
+			DstValidatorAddress: "val1",
+			IbcSequenceId:       "2",
+			CompletionTime:      blockTime.Add(time.Hour),
+			State:               types.Redelegation_REDELEGATION_MATURING,
+		},
+		{
+			ChainId:             suite.chainB.ChainID,
+			SrcValidatorAddress: "val0",
+			DstValidatorAddress: "val1",
+			IbcSequenceId:       "3",
+			CompletionTime:      blockTime.Add(-time.Hour),
+			State:               types.Redelegation_REDELEGATION_MATURING,
+		},
+		{
+			ChainId:             suite.chainC.ChainID,
+			SrcValidatorAddress: "val0",
+			DstValidatorAddress: "val1",
+			IbcSequenceId:       "4",
+			State:               types.Redelegation_REDELEGATION_INITIATED,
+		},
+	}
+	for _, redelegation := range redelegations {
+		suite.app.LiquidStakeIBCKeeper.SetRedelegation(suite.ctx, redelegation)
+	}
+
+	active := suite.app.LiquidStakeIBCKeeper.GetActiveRedelegations(suite.ctx, suite.chainB.ChainID)
+	suite.Require().Equal(2, len(active))
+
+	suite.app.LiquidStakeIBCKeeper.DeleteMaturedRedelegations(suite.ctx, suite.chainB.ChainID)
+	_, found := suite.app.LiquidStakeIBCKeeper.GetRedelegation(
+		suite.ctx,
+		suite.chainB.ChainID,
+		"val0",
+		"val1",
+		"3",
+	)
+	suite.Require().False(found)
+	suite.Require().Equal(
+		3,
+		len(suite.app.LiquidStakeIBCKeeper.FilterRedelegations(suite.ctx, func(r types.Redelegation) bool { return true })),
+	)
+}
+
+func (suite *IntegrationTestSuite) TestRebalanceHostChain() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	hc.Validators[0].DelegatedAmount = sdk.NewInt(700)
+	hc.Validators[1].DelegatedAmount = sdk.NewInt(100)
+	hc.Validators[2].DelegatedAmount = sdk.NewInt(100)
+	hc.Validators[3].DelegatedAmount = sdk.NewInt(100)
+	suite.app.LiquidStakeIBCKeeper.SetHostChain(suite.ctx, hc)
+
+	err := suite.app.LiquidStakeIBCKeeper.RebalanceHostChain(suite.ctx, hc)
+	suite.Require().NoError(err)
+
+	redelegations := suite.app.LiquidStakeIBCKeeper.GetActiveRedelegations(suite.ctx, hc.ChainId)
+	suite.Require().Equal(3, len(redelegations))
+	for _, redelegation := range redelegations {
+		suite.Require().Equal(hc.Validators[0].OperatorAddress, redelegation.SrcValidatorAddress)
+		suite.Require().Equal(sdk.NewInt(150), redelegation.Amount.Amount)
+		suite.Require().Equal(types.Redelegation_REDELEGATION_INITIATED, redelegation.State)
+		suite.Require().NotEqual("", redelegation.IbcSequenceId)
+	}
+
+	// a new rebalance can't start until the previous one is acknowledged
+	err = suite.app.LiquidStakeIBCKeeper.RebalanceHostChain(suite.ctx, hc)
+	suite.Require().ErrorIs(err, types.ErrRedelegationInProgress)
+}
+
+func (suite *IntegrationTestSuite) TestDeleteRedelegationsForSequenceID() {
+	redelegations := []*types.Redelegation{
+		{ChainId: suite.chainB.ChainID, SrcValidatorAddress: "val0", DstValidatorAddress: "val1", IbcSequenceId: "1"},
+		{ChainId: suite.chainB.ChainID, SrcValidatorAddress: "val0", DstValidatorAddress: "val2", IbcSequenceId: "1"},
+		{ChainId: suite.chainB.ChainID, SrcValidatorAddress: "val0", DstValidatorAddress: "val1", IbcSequenceId: "2"},
+	}
+	for _, redelegation := range redelegations {
+		suite.app.LiquidStakeIBCKeeper.SetRedelegation(suite.ctx, redelegation)
+	}
+
+	suite.app.LiquidStakeIBCKeeper.DeleteRedelegationsForSequenceID(suite.ctx, "1")
+
+	remaining := suite.app.LiquidStakeIBCKeeper.FilterRedelegations(
+		suite.ctx,
+		func(r types.Redelegation) bool { return true },
+	)
+	suite.Require().Equal(1, len(remaining))
+	suite.Require().Equal("2", remaining[0].IbcSequenceId)
+}
+
+func (suite *IntegrationTestSuite) TestHandleRedelegateResponse() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	hc.Validators[0].DelegatedAmount = sdk.NewInt(700)
+	hc.Validators[1].DelegatedAmount = sdk.NewInt(100)
+	suite.app.LiquidStakeIBCKeeper.SetHostChain(suite.ctx, hc)
+
+	sequenceID := suite.app.LiquidStakeIBCKeeper.GetTransactionSequenceID("channel-1", 1)
+	suite.app.LiquidStakeIBCKeeper.SetRedelegation(suite.ctx, &types.Redelegation{
+		ChainId:             hc.ChainId,
+		SrcValidatorAddress: hc.Validators[0].OperatorAddress,
+		DstValidatorAddress: hc.Validators[1].OperatorAddress,
+		Amount:              sdk.NewInt64Coin(HostDenom, 300),
+		IbcSequenceId:       sequenceID,
+		State:               types.Redelegation_REDELEGATION_INITIATED,
+	})
+
+	completionTime := suite.ctx.BlockTime().Add(time.Hour)
+	err := suite.app.LiquidStakeIBCKeeper.HandleRedelegateResponse(
+		suite.ctx,
+		&stakingtypes.MsgBeginRedelegate{
+			DelegatorAddress:    hc.DelegationAccount.Address,
+			ValidatorSrcAddress: hc.Validators[0].OperatorAddress,
+			ValidatorDstAddress: hc.Validators[1].OperatorAddress,
+			Amount:              sdk.NewInt64Coin(HostDenom, 300),
+		},
+		stakingtypes.MsgBeginRedelegateResponse{CompletionTime: completionTime},
+		"channel-1",
+		1,
+	)
+	suite.Require().NoError(err)
+
+	hc, _ = suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().Equal(sdk.NewInt(400), hc.Validators[0].DelegatedAmount)
+	suite.Require().Equal(sdk.NewInt(400), hc.Validators[1].DelegatedAmount)
+
+	redelegation, found := suite.app.LiquidStakeIBCKeeper.GetRedelegation(
+		suite.ctx,
+		hc.ChainId,
+		hc.Validators[0].OperatorAddress,
+		hc.Validators[1].OperatorAddress,
+		sequenceID,
+	)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Redelegation_REDELEGATION_MATURING, redelegation.State)
+	suite.Require().True(completionTime.Equal(redelegation.CompletionTime))
+
+	// a wrong message type is rejected
+	err = suite.app.LiquidStakeIBCKeeper.HandleRedelegateResponse(
+		suite.ctx,
+		&stakingtypes.MsgDelegate{},
+		stakingtypes.MsgBeginRedelegateResponse{},
+		"channel-1",
+		1,
+	)
+	suite.Require().Error(err)
+}
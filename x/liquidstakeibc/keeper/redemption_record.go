@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetRedemptionRecord(ctx sdk.Context, record *types.RedemptionRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedemptionRecordKey)
+	bytes := k.cdc.MustMarshal(record)
+	store.Set(types.GetRedemptionRecordStoreKey(record.MintDenom), bytes)
+}
+
+func (k *Keeper) GetRedemptionRecord(ctx sdk.Context, mintDenom string) (*types.RedemptionRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedemptionRecordKey)
+	bz := store.Get(types.GetRedemptionRecordStoreKey(mintDenom))
+	if bz == nil {
+		return &types.RedemptionRecord{}, false
+	}
+
+	var record types.RedemptionRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return &record, true
+}
+
+func (k *Keeper) DeleteRedemptionRecord(ctx sdk.Context, mintDenom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedemptionRecordKey)
+	store.Delete(types.GetRedemptionRecordStoreKey(mintDenom))
+}
+
+// GetAllRedemptionRecords returns the redemption records of all the deregistered host chains
+func (k *Keeper) GetAllRedemptionRecords(ctx sdk.Context) []*types.RedemptionRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedemptionRecordKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	records := make([]*types.RedemptionRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.RedemptionRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, &record)
+	}
+
+	return records
+}
@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// daysPerYear is used to annualize the reward rates, delegation epochs last a day
+const daysPerYear = 365
+
+func (k *Keeper) SetRewardRecord(ctx sdk.Context, record *types.RewardRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RewardRecordKey)
+	bytes := k.cdc.MustMarshal(record)
+	store.Set(types.GetRewardRecordStoreKey(record.ChainId, record.Epoch), bytes)
+}
+
+func (k *Keeper) GetRewardRecord(ctx sdk.Context, chainID string, epochNumber int64) (*types.RewardRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RewardRecordKey)
+	bz := store.Get(types.GetRewardRecordStoreKey(chainID, epochNumber))
+	if bz == nil {
+		return &types.RewardRecord{}, false
+	}
+
+	var record types.RewardRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return &record, true
+}
+
+// GetRewardRecords returns the reward records of a host chain ordered by epoch, or the records of all the host
+// chains if the chain id is empty
+func (k *Keeper) GetRewardRecords(ctx sdk.Context, chainID string) []*types.RewardRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RewardRecordKey)
+
+	var keyPrefix []byte
+	if chainID != "" {
+		keyPrefix = types.GetRewardRecordChainPrefix(chainID)
+	}
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	records := make([]*types.RewardRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.RewardRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, &record)
+	}
+
+	return records
+}
+
+// AddRewardRecord adds autocompounded rewards and their restake fee to the host chain record of the epoch
+func (k *Keeper) AddRewardRecord(ctx sdk.Context, chainID string, epochNumber int64, amount, fee math.Int) {
+	record, found := k.GetRewardRecord(ctx, chainID, epochNumber)
+	if !found {
+		record = &types.RewardRecord{
+			ChainId: chainID,
+			Epoch:   epochNumber,
+			Amount:  sdk.ZeroInt(),
+			Fee:     sdk.ZeroInt(),
+		}
+	}
+
+	record.Amount = record.Amount.Add(amount)
+	record.Fee = record.Fee.Add(fee)
+	k.SetRewardRecord(ctx, record)
+}
+
+// DeleteRewardRecordsBeforeEpoch removes the reward records of a host chain older than the given epoch
+func (k *Keeper) DeleteRewardRecordsBeforeEpoch(ctx sdk.Context, chainID string, epoch int64) {
+	k.deleteEpochAmounts(
+		ctx,
+		types.RewardRecordKey,
+		types.GetRewardRecordChainPrefix(chainID),
+		types.GetRewardRecordStoreKey(chainID, epoch),
+	)
+}
+
+// GetRewardRate computes the reward rate of a host chain over the trailing delegation epochs, from the rewards
+// autocompounded net of the restake fee and the average amount liquid staked according to the c value history
+func (k *Keeper) GetRewardRate(ctx sdk.Context, hc *types.HostChain, epochs int64) types.RewardRateWindow {
+	currentEpoch := k.GetEpochNumber(ctx, types.DelegationEpoch)
+	startEpoch := currentEpoch - epochs + 1
+	if startEpoch < 0 {
+		startEpoch = 0
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RewardRecordKey)
+	iterator := store.Iterator(
+		types.GetRewardRecordStoreKey(hc.ChainId, startEpoch),
+		types.GetRewardRecordStoreKey(hc.ChainId, currentEpoch+1),
+	)
+	defer iterator.Close()
+
+	rewards := sdk.ZeroInt()
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.RewardRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		rewards = rewards.Add(record.Amount.Sub(record.Fee))
+	}
+
+	// the window is only shortened for chains registered within it, the chains registered before their registration
+	// epoch was recorded are limited by their first reward record instead
+	coverageStart := startEpoch
+	if hc.RegistrationEpoch > coverageStart {
+		coverageStart = hc.RegistrationEpoch
+	}
+	if hc.RegistrationEpoch == 0 {
+		if firstEpoch, found := k.getFirstRewardRecordEpoch(ctx, hc.ChainId); found && firstEpoch > coverageStart {
+			coverageStart = firstEpoch
+		}
+	}
+	coveredEpochs := currentEpoch - coverageStart + 1
+	if coveredEpochs < 1 {
+		coveredEpochs = 1
+	}
+
+	// average the liquid staked amount of the c value records in the window, or use the current one
+	since := ctx.BlockTime().Add(-time.Duration(coveredEpochs) * 24 * time.Hour)
+	stakedSum, count := sdk.ZeroInt(), int64(0)
+	for _, record := range k.GetCValueRecords(ctx, hc.ChainId) {
+		if record.Time.Before(since) {
+			continue
+		}
+		stakedSum = stakedSum.Add(record.LiquidStakedAmount())
+		count++
+	}
+	averageStaked := k.GetLiquidStakedAmount(ctx, hc)
+	if count > 0 {
+		averageStaked = stakedSum.QuoRaw(count)
+	}
+
+	apr, apy := sdk.ZeroDec(), sdk.ZeroDec()
+	if averageStaked.IsPositive() {
+		apr = sdk.NewDecFromInt(rewards).
+			QuoInt(averageStaked).
+			MulInt64(daysPerYear).
+			QuoInt64(coveredEpochs)
+		apy = sdk.OneDec().Add(apr.QuoInt64(daysPerYear)).Power(daysPerYear).Sub(sdk.OneDec())
+	}
+
+	return types.RewardRateWindow{
+		Epochs:              epochs,
+		Apr:                 apr,
+		Apy:                 apy,
+		Rewards:             rewards,
+		AverageStakedAmount: averageStaked,
+	}
+}
+
+// getFirstRewardRecordEpoch returns the epoch of the oldest reward record of a host chain
+func (k *Keeper) getFirstRewardRecordEpoch(ctx sdk.Context, chainID string) (int64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RewardRecordKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetRewardRecordChainPrefix(chainID))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, false
+	}
+
+	record := types.RewardRecord{}
+	k.cdc.MustUnmarshal(iterator.Value(), &record)
+	return record.Epoch, true
+}
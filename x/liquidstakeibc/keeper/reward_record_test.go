@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// setDelegationEpoch sets the current delegation epoch number
+func (suite *IntegrationTestSuite) setDelegationEpoch(epoch int64) int64 {
+	epochsKeeper := suite.app.EpochsKeeper
+	epochInfo := epochsKeeper.GetEpochInfo(suite.ctx, types.DelegationEpoch)
+	epochInfo.CurrentEpoch = epoch
+	epochsKeeper.DeleteEpochInfo(suite.ctx, types.DelegationEpoch)
+	suite.Require().NoError(epochsKeeper.AddEpochInfo(suite.ctx, epochInfo))
+
+	return suite.app.LiquidStakeIBCKeeper.GetEpochNumber(suite.ctx, types.DelegationEpoch)
+}
+
+func (suite *IntegrationTestSuite) TestAddRewardRecord() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	k.AddRewardRecord(suite.ctx, "test-chain", 1, sdk.NewInt(100), sdk.NewInt(5))
+	k.AddRewardRecord(suite.ctx, "test-chain", 1, sdk.NewInt(50), sdk.NewInt(2))
+	k.AddRewardRecord(suite.ctx, "test-chain", 2, sdk.NewInt(10), sdk.ZeroInt())
+	k.AddRewardRecord(suite.ctx, "test-chain", 3, sdk.NewInt(10), sdk.ZeroInt())
+
+	record, found := k.GetRewardRecord(suite.ctx, "test-chain", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(150), record.Amount)
+	suite.Require().Equal(sdk.NewInt(7), record.Fee)
+
+	k.DeleteRewardRecordsBeforeEpoch(suite.ctx, "test-chain", 3)
+
+	records := k.GetRewardRecords(suite.ctx, "test-chain")
+	suite.Require().Len(records, 1)
+	suite.Require().Equal(int64(3), records[0].Epoch)
+}
+
+func (suite *IntegrationTestSuite) TestGetRewardRate() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	// use a chain without history so the setup c value computations don't interfere
+	hc.ChainId = "reward-chain"
+	hc.RegistrationEpoch = 0
+
+	epoch := suite.setDelegationEpoch(40)
+
+	// no rewards yet
+	rate := k.GetRewardRate(suite.ctx, hc, types.RewardRateShortWindow)
+	suite.Require().Equal(sdk.ZeroDec(), rate.Apr)
+	suite.Require().Equal(sdk.ZeroDec(), rate.Apy)
+
+	for i, staked := range []int64{900000, 1100000} {
+		record := newCValueRecord(hc.ChainId, int64(i+1))
+		record.Time = suite.ctx.BlockTime()
+		record.StakedAmount = sdk.NewInt(staked)
+		k.SetCValueRecord(suite.ctx, record)
+	}
+
+	// a chain without registration epoch is limited by its first reward record, 200 net rewards over 2 epochs on an
+	// average of 1000000 staked
+	k.AddRewardRecord(suite.ctx, hc.ChainId, epoch-1, sdk.NewInt(110), sdk.NewInt(10))
+	k.AddRewardRecord(suite.ctx, hc.ChainId, epoch, sdk.NewInt(110), sdk.NewInt(10))
+
+	rate = k.GetRewardRate(suite.ctx, hc, types.RewardRateShortWindow)
+	suite.Require().Equal(types.RewardRateShortWindow, rate.Epochs)
+	suite.Require().Equal(sdk.NewInt(200), rate.Rewards)
+	suite.Require().Equal(sdk.NewInt(1000000), rate.AverageStakedAmount)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.0365"), rate.Apr)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.037172411302551958"), rate.Apy)
+
+	// rewards outside of the window are not accounted for, and the epochs without rewards within the window are
+	// covered
+	k.AddRewardRecord(suite.ctx, hc.ChainId, epoch-types.RewardRateLongWindow, sdk.NewInt(1000), sdk.ZeroInt())
+
+	rate = k.GetRewardRate(suite.ctx, hc, types.RewardRateShortWindow)
+	suite.Require().Equal(sdk.NewInt(200), rate.Rewards)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.010428571428571428"), rate.Apr)
+
+	// a chain registered within the window is limited by its registration, not by its first reward record
+	hc.RegistrationEpoch = epoch - 3
+	rate = k.GetRewardRate(suite.ctx, hc, types.RewardRateShortWindow)
+	suite.Require().Equal(sdk.NewInt(200), rate.Rewards)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.01825"), rate.Apr)
+
+	hc.RegistrationEpoch = epoch - types.RewardRateLongWindow
+	rate = k.GetRewardRate(suite.ctx, hc, types.RewardRateShortWindow)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.010428571428571428"), rate.Apr)
+}
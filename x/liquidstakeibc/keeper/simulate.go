@@ -0,0 +1,282 @@
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// getHostChainFromMintDenom returns the host chain whose stk tokens have the denom
+func (k *Keeper) getHostChainFromMintDenom(ctx sdk.Context, mintDenom string) (*types.HostChain, bool) {
+	_, hostDenom, found := strings.Cut(mintDenom, "/")
+	if !found {
+		return nil, false
+	}
+
+	hc, found := k.GetHostChainFromHostDenom(ctx, hostDenom)
+	if !found {
+		return nil, false
+	}
+
+	return hc, true
+}
+
+// liquidStakeAmounts returns the stk tokens minted for an amount of host chain tokens and the deposit fee
+// charged on them
+func liquidStakeAmounts(hc *types.HostChain, amount math.Int) (sdk.Coin, sdk.Coin) {
+	mintAmount := sdk.NewDecFromInt(amount).Mul(hc.CValue)
+	mintToken, _ := sdk.NewDecCoinFromDec(hc.MintDenom(), mintAmount).TruncateDecimal()
+
+	protocolFeeAmount := hc.Params.DepositFee.MulInt(mintToken.Amount)
+	protocolFee, _ := sdk.NewDecCoinFromDec(hc.MintDenom(), protocolFeeAmount).TruncateDecimal()
+
+	return mintToken, protocolFee
+}
+
+// liquidUnstakeAmounts returns the unstake fee charged on an amount of stk tokens, the stk tokens unstaked net
+// of it and the host chain tokens they are unbonded for
+func liquidUnstakeAmounts(hc *types.HostChain, amount sdk.Coin) (sdk.Coin, sdk.Coin, sdk.Coin) {
+	fee := sdk.NewCoin(amount.Denom, hc.Params.UnstakeFee.MulInt(amount.Amount).TruncateInt())
+	unstakeAmount := amount.Sub(fee)
+
+	decTokenAmount := sdk.NewDecCoinFromCoin(unstakeAmount).Amount.Mul(sdk.OneDec().Quo(hc.CValue))
+	unbondAmount, _ := sdk.NewDecCoinFromDec(hc.HostDenom, decTokenAmount).TruncateDecimal()
+
+	return fee, unstakeAmount, unbondAmount
+}
+
+// redeemAmounts returns the redemption fee charged on an amount of stk tokens, the stk tokens burnt net of it
+// and the ibc tokens they are redeemed for
+func redeemAmounts(hc *types.HostChain, amount sdk.Coin) (sdk.Coin, sdk.Coin, sdk.Coin) {
+	fee, _ := sdk.NewDecCoinFromDec(
+		hc.MintDenom(),
+		hc.Params.RedemptionFee.MulInt(amount.Amount),
+	).TruncateDecimal()
+	stkAmount := amount.Sub(fee)
+
+	redeemAmount := sdk.NewDecCoinFromCoin(stkAmount).Amount.Quo(hc.CValue)
+	redeemToken, _ := sdk.NewDecCoinFromDec(hc.IBCDenom(), redeemAmount).TruncateDecimal()
+
+	return fee, stkAmount, redeemToken
+}
+
+// redemptionAmount returns the ibc tokens an amount of stk tokens of a deregistered host chain is redeemed for
+// against its redemption record
+func redemptionAmount(record *types.RedemptionRecord, amount sdk.Coin) sdk.Coin {
+	redeemToken := sdk.NewCoin(record.IbcDenom, sdk.ZeroInt())
+	if record.StkAmount.IsPositive() {
+		redeemToken.Amount = amount.Amount.Mul(record.Amount).Quo(record.StkAmount)
+	}
+
+	return redeemToken
+}
+
+// newLSMDeposit creates the pending LSM deposit for a delegation of tokenized shares of a host chain validator
+func newLSMDeposit(
+	hc *types.HostChain,
+	validator *types.Validator,
+	denomTrace *transfertypes.DenomTrace,
+	delegatorAddress string,
+	delegation sdk.Coin,
+) *types.LSMDeposit {
+	return &types.LSMDeposit{
+		ChainId:          hc.ChainId,
+		Shares:           sdk.NewDecFromInt(delegation.Amount),
+		Amount:           sdk.NewDecFromInt(delegation.Amount).Mul(validator.ExchangeRate).TruncateInt(),
+		Denom:            denomTrace.BaseDenom,
+		IbcDenom:         delegation.Denom,
+		DelegatorAddress: delegatorAddress,
+		State:            types.LSMDeposit_DEPOSIT_PENDING,
+		IbcSequenceId:    "",
+	}
+}
+
+// validateLiquidStake checks that the host chain accepts a liquid stake of the amount by the delegator
+func (k *Keeper) validateLiquidStake(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	delegatorAddress string,
+	amount math.Int,
+) error {
+	if !hc.Active {
+		return types.ErrHostChainInactive
+	}
+
+	if hc.GetPauses().GetLiquidStake() {
+		return errorsmod.Wrapf(types.ErrOperationPaused, "liquid staking is paused for host chain %s", hc.ChainId)
+	}
+
+	if hc.CValueCooldown != nil {
+		return errorsmod.Wrapf(types.ErrCValueCooldown, "host chain %s", hc.ChainId)
+	}
+
+	// check for minimum deposit amount
+	if amount.LT(hc.MinimumDeposit) {
+		return errorsmod.Wrapf(
+			types.ErrMinDeposit,
+			"expected amount more than %s, got %s",
+			hc.MinimumDeposit,
+			amount,
+		)
+	}
+
+	// check the amount against the host chain liquid stake caps
+	return k.ValidateLiquidStakeCaps(ctx, hc, delegatorAddress, amount)
+}
+
+// validateLSMDeposit checks an LSM deposit against the host chain minimum deposit, the deposits being processed
+// and the host chain liquid stake caps
+func (k *Keeper) validateLSMDeposit(ctx sdk.Context, hc *types.HostChain, deposit *types.LSMDeposit) error {
+	// check for minimum deposit amount
+	if deposit.Shares.TruncateInt().LT(hc.MinimumDeposit) {
+		return errorsmod.Wrapf(
+			types.ErrMinDeposit,
+			"expected amount for delegation %s more than %s, got %s",
+			deposit.IbcDenom,
+			hc.MinimumDeposit,
+			deposit.Shares.TruncateInt(),
+		)
+	}
+
+	// we won't process more than one deposit for a user and token
+	_, found := k.GetLSMDeposit(ctx, deposit.ChainId, deposit.DelegatorAddress, deposit.Denom)
+	if found {
+		return errorsmod.Wrapf(
+			types.ErrLSMDepositProcessing,
+			"already processing LSM deposit for token %s and delegator %s",
+			deposit.Denom,
+			deposit.DelegatorAddress,
+		)
+	}
+
+	// check the deposit amount against the host chain liquid stake caps
+	return k.ValidateLiquidStakeCaps(ctx, hc, deposit.DelegatorAddress, deposit.Amount)
+}
+
+// validateLiquidUnstake checks that the host chain accepts a liquid unstake of the stk tokens
+func (k *Keeper) validateLiquidUnstake(hc *types.HostChain, amount sdk.Coin) error {
+	if !hc.Active {
+		return types.ErrHostChainInactive
+	}
+
+	if hc.GetPauses().GetUnstake() {
+		return errorsmod.Wrapf(types.ErrOperationPaused, "liquid unstaking is paused for host chain %s", hc.ChainId)
+	}
+
+	if hc.CValueCooldown != nil {
+		return errorsmod.Wrapf(types.ErrCValueCooldown, "host chain %s", hc.ChainId)
+	}
+
+	// check if the amount has the correct denom
+	if amount.Denom != hc.MintDenom() {
+		return errorsmod.Wrapf(types.ErrInvalidDenom,
+			"expected %s, got %s",
+			hc.MintDenom(),
+			amount.Denom,
+		)
+	}
+
+	return nil
+}
+
+// validateUnbondAmount checks an unbond amount against the host chain unbonding epoch cap, and that the total
+// unbonding of the epoch stays below what is currently staked
+func (k *Keeper) validateUnbondAmount(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	unbondingEpoch int64,
+	unbondAmount math.Int,
+) error {
+	if err := k.ValidateUnstakeCap(ctx, hc, unbondAmount); err != nil {
+		return err
+	}
+
+	totalUnbonding := unbondAmount
+	if unbonding, found := k.GetUnbonding(ctx, hc.ChainId, unbondingEpoch); found {
+		totalUnbonding = totalUnbonding.Add(unbonding.UnbondAmount.Amount)
+	}
+
+	totalDelegations := hc.GetHostChainTotalDelegations()
+	if totalDelegations.LTE(totalUnbonding) {
+		return errorsmod.Wrapf(
+			types.ErrNotEnoughDelegations,
+			"delegated amount %s is less than the total undelegation %s for epoch %d",
+			totalDelegations,
+			totalUnbonding,
+			unbondingEpoch,
+		)
+	}
+
+	return nil
+}
+
+// validateRedeem checks that the host chain accepts an instant redemption of the stk tokens
+func (k *Keeper) validateRedeem(hc *types.HostChain, amount sdk.Coin) error {
+	if !hc.Active {
+		return types.ErrHostChainInactive
+	}
+
+	if hc.GetPauses().GetRedeem() {
+		return errorsmod.Wrapf(types.ErrOperationPaused, "instant redemption is paused for host chain %s", hc.ChainId)
+	}
+
+	if hc.CValueCooldown != nil {
+		return errorsmod.Wrapf(types.ErrCValueCooldown, "host chain %s", hc.ChainId)
+	}
+
+	// check the amount denom is the host chain mint denom
+	if amount.Denom != hc.MintDenom() {
+		return errorsmod.Wrapf(
+			types.ErrInvalidDenom,
+			"expected %s, got %s",
+			hc.MintDenom(),
+			amount.Denom,
+		)
+	}
+
+	return nil
+}
+
+// validateRedeemAmount checks that there are enough deposits to instantly redeem the ibc tokens, and checks them
+// against the host chain epoch redeem cap
+func (k *Keeper) validateRedeemAmount(ctx sdk.Context, hc *types.HostChain, redeemToken sdk.Coin) error {
+	depositAccountBalance := k.bankKeeper.GetBalance(
+		ctx,
+		authtypes.NewModuleAddress(types.DepositModuleAccount),
+		hc.IBCDenom(),
+	)
+	if redeemToken.IsGTE(depositAccountBalance) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInsufficientFunds,
+			"can't instant redeem %s tokens, only %s is available",
+			redeemToken.String(),
+			depositAccountBalance.Amount.String(),
+		)
+	}
+
+	return k.ValidateRedeemCap(ctx, hc, redeemToken.Amount)
+}
+
+// validateRedemption checks that an amount of stk tokens can be redeemed against a redemption record
+func validateRedemption(record *types.RedemptionRecord, amount sdk.Coin) error {
+	if amount.Denom != record.MintDenom {
+		return errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s, got %s", record.MintDenom, amount.Denom)
+	}
+	if amount.Amount.GT(record.StkAmount) {
+		return errorsmod.Wrapf(
+			types.ErrRedeemFailed,
+			"only %s%s are left to redeem",
+			record.StkAmount,
+			record.MintDenom,
+		)
+	}
+
+	return nil
+}
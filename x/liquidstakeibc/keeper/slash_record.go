@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetSlashRecord(ctx sdk.Context, slashRecord *types.SlashRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordKey)
+	bytes := k.cdc.MustMarshal(slashRecord)
+	store.Set(
+		types.GetSlashRecordStoreKey(slashRecord.ChainId, slashRecord.ValidatorAddress, slashRecord.Height),
+		bytes,
+	)
+}
+
+func (k *Keeper) GetSlashRecord(
+	ctx sdk.Context,
+	chainID string,
+	validatorAddress string,
+	height int64,
+) (*types.SlashRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordKey)
+	bz := store.Get(types.GetSlashRecordStoreKey(chainID, validatorAddress, height))
+	if bz == nil {
+		return &types.SlashRecord{}, false
+	}
+
+	var slashRecord types.SlashRecord
+	k.cdc.MustUnmarshal(bz, &slashRecord)
+	return &slashRecord, true
+}
+
+// GetValidatorSlashRecords returns the slash records of a host chain validator ordered by height
+func (k *Keeper) GetValidatorSlashRecords(
+	ctx sdk.Context,
+	chainID string,
+	validatorAddress string,
+) []*types.SlashRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetSlashRecordValidatorPrefix(chainID, validatorAddress))
+	defer iterator.Close()
+
+	slashRecords := make([]*types.SlashRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		slashRecord := types.SlashRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &slashRecord)
+		slashRecords = append(slashRecords, &slashRecord)
+	}
+
+	return slashRecords
+}
+
+func (k *Keeper) FilterSlashRecords(
+	ctx sdk.Context,
+	filter func(r types.SlashRecord) bool,
+) []*types.SlashRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	slashRecords := make([]*types.SlashRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		slashRecord := types.SlashRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &slashRecord)
+		if filter(slashRecord) {
+			slashRecords = append(slashRecords, &slashRecord)
+		}
+	}
+
+	return slashRecords
+}
+
+// ProcessValidatorSlash records a slash of a host chain validator and, if the slashed ratio of its delegation crosses
+// the host chain slash threshold, redistributes the validator weight among the rest of the validators with weight
+func (k *Keeper) ProcessValidatorSlash(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	validator *types.Validator,
+	slashRecord *types.SlashRecord,
+	previousDelegatedAmount math.Int,
+) {
+	k.SetSlashRecord(ctx, slashRecord)
+
+	threshold := hc.Params.SlashThreshold
+	if threshold.IsNil() || !threshold.IsPositive() || !previousDelegatedAmount.IsPositive() {
+		return
+	}
+
+	slashedRatio := sdk.NewDecFromInt(slashRecord.SlashedAmount).QuoInt(previousDelegatedAmount)
+	if slashedRatio.LT(threshold) || !validator.Weight.IsPositive() {
+		return
+	}
+
+	// the weight can only be moved if there is another validator to receive it
+	for _, val := range hc.Validators {
+		if val.OperatorAddress != validator.OperatorAddress && val.Weight.IsPositive() {
+			k.RedistributeValidatorWeight(ctx, hc, validator)
+
+			k.Logger(ctx).Info(
+				"Redistributed slashed validator weight.",
+				"host_chain",
+				hc.ChainId,
+				"validator",
+				validator.OperatorAddress,
+				"slashed_ratio",
+				slashedRatio,
+			)
+			return
+		}
+	}
+
+	k.Logger(ctx).Error(
+		"could not redistribute slashed validator weight, no other validator has weight",
+		"host_chain",
+		hc.ChainId,
+		"validator",
+		validator.OperatorAddress,
+	)
+}
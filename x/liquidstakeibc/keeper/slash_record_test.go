@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestGetSetSlashRecord() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	slashRecords := []*types.SlashRecord{
+		{ChainId: suite.chainB.ChainID, ValidatorAddress: "val0", Height: 20, SlashedAmount: sdk.NewInt(20)},
+		{ChainId: suite.chainB.ChainID, ValidatorAddress: "val0", Height: 10, SlashedAmount: sdk.NewInt(10)},
+		{ChainId: suite.chainB.ChainID, ValidatorAddress: "val1", Height: 15, SlashedAmount: sdk.NewInt(15)},
+		{ChainId: suite.chainC.ChainID, ValidatorAddress: "val0", Height: 5, SlashedAmount: sdk.NewInt(5)},
+	}
+	for _, slashRecord := range slashRecords {
+		k.SetSlashRecord(suite.ctx, slashRecord)
+	}
+
+	found, ok := k.GetSlashRecord(suite.ctx, suite.chainB.ChainID, "val1", 15)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewInt(15), found.SlashedAmount)
+
+	_, ok = k.GetSlashRecord(suite.ctx, suite.chainB.ChainID, "val1", 16)
+	suite.Require().False(ok)
+
+	validatorSlashRecords := k.GetValidatorSlashRecords(suite.ctx, suite.chainB.ChainID, "val0")
+	suite.Require().Len(validatorSlashRecords, 2)
+	suite.Require().Equal(int64(10), validatorSlashRecords[0].Height)
+	suite.Require().Equal(int64(20), validatorSlashRecords[1].Height)
+
+	chainSlashRecords := k.FilterSlashRecords(
+		suite.ctx,
+		func(r types.SlashRecord) bool { return r.ChainId == suite.chainC.ChainID },
+	)
+	suite.Require().Len(chainSlashRecords, 1)
+}
+
+func (suite *IntegrationTestSuite) TestProcessValidatorSlash() {
+	tc := []struct {
+		name          string
+		threshold     sdk.Dec
+		slashedAmount sdk.Int
+		zeroWeight    bool
+	}{
+		{
+			name:          "threshold disabled",
+			threshold:     sdk.ZeroDec(),
+			slashedAmount: sdk.NewInt(900),
+			zeroWeight:    false,
+		},
+		{
+			name:          "below threshold",
+			threshold:     sdk.MustNewDecFromStr("0.05"),
+			slashedAmount: sdk.NewInt(10),
+			zeroWeight:    false,
+		},
+		{
+			name:          "threshold crossed",
+			threshold:     sdk.MustNewDecFromStr("0.05"),
+			slashedAmount: sdk.NewInt(50),
+			zeroWeight:    true,
+		},
+	}
+
+	for i, t := range tc {
+		suite.Run(t.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			k := suite.app.LiquidStakeIBCKeeper
+
+			hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+			suite.Require().True(found)
+			hc.Params.SlashThreshold = t.threshold
+			k.SetHostChain(ctx, hc)
+
+			validator := hc.Validators[0]
+			weight := validator.Weight
+
+			k.ProcessValidatorSlash(
+				ctx,
+				hc,
+				validator,
+				&types.SlashRecord{
+					ChainId:          hc.ChainId,
+					ValidatorAddress: validator.OperatorAddress,
+					Height:           int64(i),
+					SlashedAmount:    t.slashedAmount,
+				},
+				sdk.NewInt(1000),
+			)
+
+			_, found = k.GetSlashRecord(ctx, hc.ChainId, validator.OperatorAddress, int64(i))
+			suite.Require().True(found)
+
+			hc, _ = k.GetHostChain(ctx, suite.chainB.ChainID)
+			validator, _ = hc.GetValidator(validator.OperatorAddress)
+			if t.zeroWeight {
+				suite.Require().True(validator.Weight.IsZero())
+			} else {
+				suite.Require().Equal(weight, validator.Weight)
+			}
+
+			totalWeight := sdk.ZeroDec()
+			for _, val := range hc.Validators {
+				totalWeight = totalWeight.Add(val.Weight)
+			}
+			suite.Require().True(totalWeight.Sub(sdk.OneDec()).Abs().LT(sdk.MustNewDecFromStr("0.000001")))
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetTimelockedUpdate(ctx sdk.Context, update *types.TimelockedUpdate) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TimelockedUpdateKey)
+	bytes := k.cdc.MustMarshal(update)
+	store.Set(types.GetTimelockedUpdateStoreKey(update.ChainId, update.Id), bytes)
+}
+
+func (k *Keeper) GetTimelockedUpdate(ctx sdk.Context, chainID string, id uint64) (*types.TimelockedUpdate, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TimelockedUpdateKey)
+	bz := store.Get(types.GetTimelockedUpdateStoreKey(chainID, id))
+	if bz == nil {
+		return &types.TimelockedUpdate{}, false
+	}
+
+	var update types.TimelockedUpdate
+	k.cdc.MustUnmarshal(bz, &update)
+	return &update, true
+}
+
+func (k *Keeper) DeleteTimelockedUpdate(ctx sdk.Context, update *types.TimelockedUpdate) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TimelockedUpdateKey)
+	store.Delete(types.GetTimelockedUpdateStoreKey(update.ChainId, update.Id))
+}
+
+// GetTimelockedUpdates returns the timelocked updates of a chain id, or of all chains if the chain id is empty
+func (k *Keeper) GetTimelockedUpdates(ctx sdk.Context, chainID string) []*types.TimelockedUpdate {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TimelockedUpdateKey)
+
+	var prefixKey []byte
+	if chainID != "" {
+		prefixKey = types.GetTimelockedUpdateChainPrefix(chainID)
+	}
+
+	iterator := sdk.KVStorePrefixIterator(store, prefixKey)
+	defer iterator.Close()
+
+	updates := make([]*types.TimelockedUpdate, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		update := types.TimelockedUpdate{}
+		k.cdc.MustUnmarshal(iterator.Value(), &update)
+		updates = append(updates, &update)
+	}
+
+	return updates
+}
+
+// GetNextTimelockedUpdateID returns the id the next queued timelocked update will get
+func (k *Keeper) GetNextTimelockedUpdateID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.TimelockedUpdateIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k *Keeper) SetNextTimelockedUpdateID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.TimelockedUpdateIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SplitTimelockedUpdates splits the updates between the ones that can be applied right away and the sensitive ones
+// that need to wait for the update timelock. Nothing is timelocked if the timelock is disabled.
+func (k *Keeper) SplitTimelockedUpdates(
+	ctx sdk.Context,
+	updates []*types.KVUpdate,
+) ([]*types.KVUpdate, []*types.KVUpdate) {
+	if k.GetParams(ctx).UpdateTimelockEpochs == 0 {
+		return updates, nil
+	}
+
+	immediateUpdates := make([]*types.KVUpdate, 0)
+	timelockedUpdates := make([]*types.KVUpdate, 0)
+	for _, update := range updates {
+		if types.IsTimelockedKey(update.Key) {
+			timelockedUpdates = append(timelockedUpdates, update)
+		} else {
+			immediateUpdates = append(immediateUpdates, update)
+		}
+	}
+
+	return immediateUpdates, timelockedUpdates
+}
+
+// PinTimelockedUpdates pins the current validator set proposal to the timelocked validator set applies, so the
+// proposal applied once the timelock has passed is the one that was reviewed while it was queued
+func (k *Keeper) PinTimelockedUpdates(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	updates []*types.KVUpdate,
+) ([]*types.KVUpdate, error) {
+	pinnedUpdates := make([]*types.KVUpdate, 0, len(updates))
+	for _, update := range updates {
+		if update.Key == types.KeyApplyValidatorSet {
+			proposal, found := k.GetValidatorSetProposal(ctx, hc.ChainId)
+			if !found {
+				return nil, fmt.Errorf("host chain %s has no validator set proposal", hc.ChainId)
+			}
+
+			if err := k.ValidateValidatorSetProposal(ctx, hc, proposal); err != nil {
+				return nil, err
+			}
+
+			update = &types.KVUpdate{Key: update.Key, Value: types.MustMarshalPinnedValidatorSetProposal(proposal)}
+		}
+		pinnedUpdates = append(pinnedUpdates, update)
+	}
+
+	return pinnedUpdates, nil
+}
+
+// QueueTimelockedUpdate queues host chain updates to be applied once the update timelock has passed
+func (k *Keeper) QueueTimelockedUpdate(
+	ctx sdk.Context,
+	chainID string,
+	authority string,
+	updates []*types.KVUpdate,
+) *types.TimelockedUpdate {
+	id := k.GetNextTimelockedUpdateID(ctx)
+	k.SetNextTimelockedUpdateID(ctx, id+1)
+
+	update := &types.TimelockedUpdate{
+		Id:             id,
+		ChainId:        chainID,
+		Authority:      authority,
+		Updates:        updates,
+		ExecutionEpoch: k.GetEpochNumber(ctx, types.DelegationEpoch) + k.GetParams(ctx).UpdateTimelockEpochs,
+	}
+	k.SetTimelockedUpdate(ctx, update)
+
+	k.emitTimelockedUpdateEvent(ctx, update, types.AttributeValueQueued)
+
+	return update
+}
+
+// TimelockWorkflow applies the timelocked updates whose execution epoch has been reached. Updates that can't be
+// applied anymore are dropped.
+func (k *Keeper) TimelockWorkflow(ctx sdk.Context, epoch int64) {
+	if update, found := k.GetTimelockEpochsUpdate(ctx); found && update.ExecutionEpoch <= epoch {
+		k.DeleteTimelockEpochsUpdate(ctx)
+
+		params := k.GetParams(ctx)
+		params.UpdateTimelockEpochs = update.UpdateTimelockEpochs
+		k.SetParams(ctx, params)
+
+		k.emitTimelockEpochsUpdateEvent(ctx, update, types.AttributeValueExecuted)
+	}
+
+	for _, update := range k.GetTimelockedUpdates(ctx, "") {
+		if update.ExecutionEpoch > epoch {
+			continue
+		}
+
+		k.DeleteTimelockedUpdate(ctx, update)
+
+		hc, found := k.GetHostChain(ctx, update.ChainId)
+		if !found || hc.Deregistration != nil {
+			k.Logger(ctx).Info(
+				"Dropping timelocked update of a host chain that can't be updated.",
+				"host_chain",
+				update.ChainId,
+				"id",
+				update.Id,
+			)
+			k.emitTimelockedUpdateEvent(ctx, update, types.AttributeValueFailed)
+			continue
+		}
+
+		// apply all the updates or none of them
+		cachedCtx, write := ctx.CacheContext()
+		if err := k.ApplyHostChainUpdates(cachedCtx, hc, update.Updates); err != nil {
+			k.Logger(ctx).Error(
+				"Could not apply timelocked update.",
+				"host_chain",
+				update.ChainId,
+				"id",
+				update.Id,
+				"error",
+				err.Error(),
+			)
+			k.emitTimelockedUpdateEvent(ctx, update, types.AttributeValueFailed)
+			continue
+		}
+		k.SetHostChain(cachedCtx, hc)
+		write()
+
+		k.emitTimelockedUpdateEvent(ctx, update, types.AttributeValueExecuted)
+	}
+}
+
+func (k *Keeper) emitTimelockedUpdateEvent(ctx sdk.Context, update *types.TimelockedUpdate, state string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTimelockedUpdate,
+			sdk.NewAttribute(types.AttributeChainID, update.ChainId),
+			sdk.NewAttribute(types.AttributeUpdateID, fmt.Sprintf("%d", update.Id)),
+			sdk.NewAttribute(types.AttributeExecutionEpoch, fmt.Sprintf("%d", update.ExecutionEpoch)),
+			sdk.NewAttribute(types.AttributeState, state),
+		),
+	)
+}
+
+func (k *Keeper) SetTimelockEpochsUpdate(ctx sdk.Context, update *types.TimelockEpochsUpdate) {
+	ctx.KVStore(k.storeKey).Set(types.TimelockEpochsUpdateKey, k.cdc.MustMarshal(update))
+}
+
+func (k *Keeper) GetTimelockEpochsUpdate(ctx sdk.Context) (*types.TimelockEpochsUpdate, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.TimelockEpochsUpdateKey)
+	if bz == nil {
+		return &types.TimelockEpochsUpdate{}, false
+	}
+
+	var update types.TimelockEpochsUpdate
+	k.cdc.MustUnmarshal(bz, &update)
+	return &update, true
+}
+
+func (k *Keeper) DeleteTimelockEpochsUpdate(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Delete(types.TimelockEpochsUpdateKey)
+}
+
+// QueueTimelockEpochsUpdate queues the lowering of the update timelock to be applied once the current timelock has
+// passed, replacing any lowering already queued
+func (k *Keeper) QueueTimelockEpochsUpdate(
+	ctx sdk.Context,
+	authority string,
+	updateTimelockEpochs int64,
+) *types.TimelockEpochsUpdate {
+	k.CancelTimelockEpochsUpdate(ctx)
+
+	update := &types.TimelockEpochsUpdate{
+		UpdateTimelockEpochs: updateTimelockEpochs,
+		Authority:            authority,
+		ExecutionEpoch:       k.GetEpochNumber(ctx, types.DelegationEpoch) + k.GetParams(ctx).UpdateTimelockEpochs,
+	}
+	k.SetTimelockEpochsUpdate(ctx, update)
+
+	k.emitTimelockEpochsUpdateEvent(ctx, update, types.AttributeValueQueued)
+
+	return update
+}
+
+// CancelTimelockEpochsUpdate removes the queued lowering of the update timelock, if any
+func (k *Keeper) CancelTimelockEpochsUpdate(ctx sdk.Context) {
+	update, found := k.GetTimelockEpochsUpdate(ctx)
+	if !found {
+		return
+	}
+
+	k.DeleteTimelockEpochsUpdate(ctx)
+	k.emitTimelockEpochsUpdateEvent(ctx, update, types.AttributeValueCancelled)
+}
+
+func (k *Keeper) emitTimelockEpochsUpdateEvent(ctx sdk.Context, update *types.TimelockEpochsUpdate, state string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTimelockEpochsUpdate,
+			sdk.NewAttribute(types.AttributeTimelockEpochs, fmt.Sprintf("%d", update.UpdateTimelockEpochs)),
+			sdk.NewAttribute(types.AttributeExecutionEpoch, fmt.Sprintf("%d", update.ExecutionEpoch)),
+			sdk.NewAttribute(types.AttributeState, state),
+		),
+	)
+}
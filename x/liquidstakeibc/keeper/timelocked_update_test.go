@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestTimelockWorkflow() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	params := k.GetParams(ctx)
+	params.UpdateTimelockEpochs = 2
+	k.SetParams(ctx, params)
+
+	// the fee change is queued while the rest of the updates are applied right away
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err := msgServer.UpdateHostChain(ctx, types.NewMsgUpdateHostChain(
+		hc.ChainId,
+		suite.chainA.SenderAccount.GetAddress().String(),
+		[]*types.KVUpdate{{Key: types.KeyDepositFee, Value: "0.05"}, {Key: types.KeyMinimumDeposit, Value: "7"}},
+	))
+	suite.Require().NoError(err)
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(sdk.NewInt(7), hc.MinimumDeposit)
+	suite.Require().NotEqual(sdk.MustNewDecFromStr("0.05"), hc.Params.DepositFee)
+
+	epoch := k.GetEpochNumber(ctx, types.DelegationEpoch)
+	updates := k.GetTimelockedUpdates(ctx, hc.ChainId)
+	suite.Require().Len(updates, 1)
+	suite.Require().Equal(epoch+2, updates[0].ExecutionEpoch)
+	suite.Require().Equal([]*types.KVUpdate{{Key: types.KeyDepositFee, Value: "0.05"}}, updates[0].Updates)
+
+	// nothing is applied before the execution epoch
+	k.TimelockWorkflow(ctx, epoch+1)
+	suite.Require().Len(k.GetTimelockedUpdates(ctx, hc.ChainId), 1)
+
+	k.TimelockWorkflow(ctx, epoch+2)
+	suite.Require().Empty(k.GetTimelockedUpdates(ctx, hc.ChainId))
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.05"), hc.Params.DepositFee)
+
+	// updates that can't be applied anymore are dropped without applying any of them
+	k.QueueTimelockedUpdate(ctx, hc.ChainId, suite.chainA.SenderAccount.GetAddress().String(), []*types.KVUpdate{
+		{Key: types.KeyDepositFee, Value: "0.1"},
+		{Key: types.KeyRemoveValidator, Value: "cosmosvaloper1hcqg5wj9t42zawqkqucs7la85ffyv08le09ljt"},
+	})
+	k.TimelockWorkflow(ctx, epoch+2)
+	suite.Require().Empty(k.GetTimelockedUpdates(ctx, hc.ChainId))
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.05"), hc.Params.DepositFee)
+}
+
+func (suite *IntegrationTestSuite) TestTimelockValidatorSetApply() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	epoch := suite.setDelegationEpoch(10)
+	registered := hc.Validators[0].OperatorAddress
+	proposal := &types.ValidatorSetProposal{
+		ChainId: hc.ChainId,
+		Height:  10,
+		Validators: []*types.ValidatorScore{
+			{OperatorAddress: registered, Score: sdk.OneDec(), Weight: sdk.OneDec()},
+		},
+		Epoch: epoch - 1,
+	}
+	k.SetValidatorSetProposal(ctx, proposal)
+
+	// the apply is queued with the current proposal pinned to it
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err := msgServer.UpdateHostChain(ctx, types.NewMsgUpdateHostChain(
+		hc.ChainId,
+		suite.chainA.SenderAccount.GetAddress().String(),
+		[]*types.KVUpdate{{Key: types.KeyApplyValidatorSet}},
+	))
+	suite.Require().NoError(err)
+
+	updates := k.GetTimelockedUpdates(ctx, hc.ChainId)
+	suite.Require().Len(updates, 1)
+	suite.Require().Equal(
+		[]*types.KVUpdate{{Key: types.KeyApplyValidatorSet, Value: types.MustMarshalPinnedValidatorSetProposal(proposal)}},
+		updates[0].Updates,
+	)
+	suite.Require().NoError(updates[0].Validate())
+
+	// a newer proposal selected in the meantime is neither applied nor removed
+	newerProposal := &types.ValidatorSetProposal{
+		ChainId: hc.ChainId,
+		Height:  20,
+		Validators: []*types.ValidatorScore{
+			{OperatorAddress: sdk.ValAddress("validatorA").String(), Score: sdk.OneDec(), Weight: sdk.OneDec()},
+		},
+		Epoch: epoch,
+	}
+	k.SetValidatorSetProposal(ctx, newerProposal)
+
+	// the pinned proposal is applied even if it got older than the max age while queued
+	k.TimelockWorkflow(ctx, epoch+types.DefaultUpdateTimelockEpochs)
+	suite.Require().Empty(k.GetTimelockedUpdates(ctx, hc.ChainId))
+
+	hc, _ = k.GetHostChain(ctx, hc.ChainId)
+	for _, validator := range hc.Validators {
+		if validator.OperatorAddress == registered {
+			suite.Require().Equal(sdk.OneDec(), validator.Weight)
+		} else {
+			suite.Require().True(validator.Weight.IsZero())
+		}
+	}
+	_, found = hc.GetValidator(sdk.ValAddress("validatorA").String())
+	suite.Require().False(found)
+
+	latest, found := k.GetValidatorSetProposal(ctx, hc.ChainId)
+	suite.Require().True(found)
+	suite.Require().Equal(newerProposal.Height, latest.Height)
+
+	// a proposal too old to apply can't be queued
+	proposal.Epoch = epoch - types.MaxValidatorSetProposalAge - 1
+	k.SetValidatorSetProposal(ctx, proposal)
+	_, err = msgServer.UpdateHostChain(ctx, types.NewMsgUpdateHostChain(
+		hc.ChainId,
+		suite.chainA.SenderAccount.GetAddress().String(),
+		[]*types.KVUpdate{{Key: types.KeyApplyValidatorSet}},
+	))
+	suite.Require().ErrorIs(err, types.ErrInvalidValidatorSet)
+}
+
+func (suite *IntegrationTestSuite) TestTimelockEpochsUpdate() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	updateTimelockEpochs := func(epochs int64) {
+		params := k.GetParams(ctx)
+		params.UpdateTimelockEpochs = epochs
+		_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: params.AdminAddress, Params: params})
+		suite.Require().NoError(err)
+	}
+
+	// lowering the timelock waits for the current one
+	epoch := k.GetEpochNumber(ctx, types.DelegationEpoch)
+	updateTimelockEpochs(0)
+	suite.Require().Equal(types.DefaultUpdateTimelockEpochs, k.GetParams(ctx).UpdateTimelockEpochs)
+
+	update, found := k.GetTimelockEpochsUpdate(ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(0), update.UpdateTimelockEpochs)
+	suite.Require().Equal(epoch+types.DefaultUpdateTimelockEpochs, update.ExecutionEpoch)
+
+	k.TimelockWorkflow(ctx, epoch+types.DefaultUpdateTimelockEpochs-1)
+	suite.Require().Equal(types.DefaultUpdateTimelockEpochs, k.GetParams(ctx).UpdateTimelockEpochs)
+
+	k.TimelockWorkflow(ctx, epoch+types.DefaultUpdateTimelockEpochs)
+	suite.Require().Equal(int64(0), k.GetParams(ctx).UpdateTimelockEpochs)
+	_, found = k.GetTimelockEpochsUpdate(ctx)
+	suite.Require().False(found)
+
+	// raising the timelock applies right away and drops the lowering waiting for it
+	updateTimelockEpochs(3)
+	suite.Require().Equal(int64(3), k.GetParams(ctx).UpdateTimelockEpochs)
+
+	updateTimelockEpochs(1)
+	_, found = k.GetTimelockEpochsUpdate(ctx)
+	suite.Require().True(found)
+
+	updateTimelockEpochs(4)
+	suite.Require().Equal(int64(4), k.GetParams(ctx).UpdateTimelockEpochs)
+	_, found = k.GetTimelockEpochsUpdate(ctx)
+	suite.Require().False(found)
+}
@@ -12,8 +12,18 @@ import (
 
 func (k *Keeper) SetUnbonding(ctx sdk.Context, ub *types.Unbonding) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingKey)
+	storeKey := types.GetUnbondingStoreKey(ub.ChainId, ub.EpochNumber)
+
+	// remove the index entries of the previous version of the unbonding
+	if bz := store.Get(storeKey); bz != nil {
+		previous := types.Unbonding{}
+		k.cdc.MustUnmarshal(bz, &previous)
+		k.deleteUnbondingIndexes(ctx, &previous, storeKey)
+	}
+
 	bytes := k.cdc.MustMarshal(ub)
-	store.Set(types.GetUnbondingStoreKey(ub.ChainId, ub.EpochNumber), bytes)
+	store.Set(storeKey, bytes)
+	k.setUnbondingIndexes(ctx, ub, storeKey)
 }
 
 func (k *Keeper) GetUnbonding(ctx sdk.Context, chainID string, epochNumber int64) (*types.Unbonding, bool) {
@@ -30,7 +40,65 @@ func (k *Keeper) GetUnbonding(ctx sdk.Context, chainID string, epochNumber int64
 
 func (k *Keeper) DeleteUnbonding(ctx sdk.Context, ub *types.Unbonding) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingKey)
-	store.Delete(types.GetUnbondingStoreKey(ub.ChainId, ub.EpochNumber))
+	storeKey := types.GetUnbondingStoreKey(ub.ChainId, ub.EpochNumber)
+
+	// the indexes are built from the stored unbonding, which might differ from the one provided
+	if bz := store.Get(storeKey); bz != nil {
+		stored := types.Unbonding{}
+		k.cdc.MustUnmarshal(bz, &stored)
+		k.deleteUnbondingIndexes(ctx, &stored, storeKey)
+	}
+
+	store.Delete(storeKey)
+}
+
+func (k *Keeper) setUnbondingIndexes(ctx sdk.Context, ub *types.Unbonding, storeKey []byte) {
+	k.setSequenceIndex(ctx, types.UnbondingSequenceIndexKey, ub.IbcSequenceId, storeKey)
+	k.setStateIndex(ctx, types.UnbondingStateIndexKey, ub.ChainId, int32(ub.State), storeKey)
+}
+
+func (k *Keeper) deleteUnbondingIndexes(ctx sdk.Context, ub *types.Unbonding, storeKey []byte) {
+	k.deleteSequenceIndex(ctx, types.UnbondingSequenceIndexKey, ub.IbcSequenceId, storeKey)
+	k.deleteStateIndex(ctx, types.UnbondingStateIndexKey, ub.ChainId, int32(ub.State), storeKey)
+}
+
+// getUnbondingsFromStoreKeys loads the unbondings referenced by an index
+func (k *Keeper) getUnbondingsFromStoreKeys(ctx sdk.Context, storeKeys [][]byte) []*types.Unbonding {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingKey)
+
+	unbondings := make([]*types.Unbonding, 0, len(storeKeys))
+	for _, storeKey := range storeKeys {
+		bz := store.Get(storeKey)
+		if bz == nil {
+			continue
+		}
+
+		unbonding := &types.Unbonding{}
+		k.cdc.MustUnmarshal(bz, unbonding)
+		unbondings = append(unbondings, unbonding)
+	}
+
+	return unbondings
+}
+
+// GetUnbondingsWithSequenceID returns the unbondings associated with an ibc sequence id using the sequence index
+func (k *Keeper) GetUnbondingsWithSequenceID(ctx sdk.Context, sequenceID string) []*types.Unbonding {
+	return k.getUnbondingsFromStoreKeys(
+		ctx,
+		k.getIndexedStoreKeys(ctx, types.UnbondingSequenceIndexKey, types.GetSequenceIndexPrefix(sequenceID)),
+	)
+}
+
+// GetUnbondingsForChainAndState returns the unbondings of a host chain in a given state using the state index
+func (k *Keeper) GetUnbondingsForChainAndState(
+	ctx sdk.Context,
+	chainID string,
+	state types.Unbonding_UnbondingState,
+) []*types.Unbonding {
+	return k.getUnbondingsFromStoreKeys(
+		ctx,
+		k.getIndexedStoreKeys(ctx, types.UnbondingStateIndexKey, types.GetStateIndexPrefix(chainID, int32(state))),
+	)
 }
 
 func (k *Keeper) FilterUnbondings(ctx sdk.Context, filter func(u types.Unbonding) bool) []*types.Unbonding {
@@ -79,7 +147,7 @@ func (k *Keeper) IncreaseUndelegatingAmountForEpoch(
 }
 
 func (k *Keeper) FailAllUnbondingsForSequenceID(ctx sdk.Context, sequenceID string) {
-	unbondings := k.FilterUnbondings(ctx, func(u types.Unbonding) bool { return u.IbcSequenceId == sequenceID })
+	unbondings := k.GetUnbondingsWithSequenceID(ctx, sequenceID)
 
 	for _, unbonding := range unbondings {
 		unbonding.IbcSequenceId = ""
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// StakingParamsWorkflow queries the staking params of every active host chain to keep their unbonding period updated
+func (k *Keeper) StakingParamsWorkflow(ctx sdk.Context, epoch int64) {
+	k.Logger(ctx).Info("Running staking params workflow.", "epoch", epoch)
+
+	for _, hc := range k.GetAllHostChains(ctx) {
+		if !hc.Active {
+			continue
+		}
+
+		if err := k.QueryHostChainStakingParams(ctx, hc); err != nil {
+			k.Logger(ctx).Error(
+				"could not send ICQ query for the host chain staking params",
+				"host_chain",
+				hc.ChainId,
+			)
+		}
+	}
+}
+
+// EstimateUnbondingTimes estimates when the undelegation of an unbonding epoch is sent to the host chain, at the
+// end of the epoch, and when it matures on the host chain
+func (k *Keeper) EstimateUnbondingTimes(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	unbondingEpoch int64,
+) (time.Time, time.Time) {
+	epochInfo := k.epochsKeeper.GetEpochInfo(ctx, types.UndelegationEpoch)
+
+	remainingEpochs := unbondingEpoch - epochInfo.CurrentEpoch + 1
+	undelegationTime := epochInfo.CurrentEpochStartTime.Add(time.Duration(remainingEpochs) * epochInfo.Duration)
+
+	return undelegationTime, undelegationTime.Add(hc.HostUnbondingPeriod())
+}
+
+// EstimateUnbonding estimates the undelegation, maturity and claim times of the unbonding of a host chain epoch,
+// using the mature time reported by the host chain once the undelegation has been acknowledged
+func (k *Keeper) EstimateUnbonding(ctx sdk.Context, hc *types.HostChain, epoch int64) types.UnbondingEstimate {
+	estimate := types.UnbondingEstimate{
+		EpochNumber:     epoch,
+		UnbondingPeriod: hc.HostUnbondingPeriod(),
+	}
+
+	unbonding, found := k.GetUnbonding(ctx, hc.ChainId, epoch)
+	if found {
+		estimate.Unbonding = unbonding
+	}
+
+	switch {
+	case found && unbonding.State == types.Unbonding_UNBONDING_FAILED:
+		// nothing is undelegated, the stk tokens are claimed back on the next block
+		estimate.ClaimTime = ctx.BlockTime()
+		return estimate
+	case found && unbonding.MatureTime != (time.Time{}):
+		estimate.UndelegationTime = unbonding.MatureTime.Add(-estimate.UnbondingPeriod)
+		estimate.MatureTime = unbonding.MatureTime
+	default:
+		estimate.UndelegationTime, estimate.MatureTime = k.EstimateUnbondingTimes(ctx, hc, epoch)
+	}
+
+	// matured unbondings are transferred back and claimed within the ica timeout
+	estimate.ClaimTime = estimate.MatureTime.Add(types.ICATimeoutTimestamp)
+	if found && unbonding.State == types.Unbonding_UNBONDING_CLAIMABLE {
+		estimate.ClaimTime = ctx.BlockTime()
+	}
+
+	return estimate
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestEstimateUnbondingTimes() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	hc.UnbondingFactor = 4
+	hc.UnbondingPeriod = 14 * 24 * time.Hour
+
+	epochInfo := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, types.UndelegationEpoch)
+	unbondingEpoch := types.CurrentUnbondingEpoch(hc.UnbondingFactor, epochInfo.CurrentEpoch)
+
+	undelegationTime, matureTime := k.EstimateUnbondingTimes(suite.ctx, hc, unbondingEpoch)
+	suite.Require().Equal(
+		epochInfo.CurrentEpochStartTime.Add(
+			time.Duration(unbondingEpoch-epochInfo.CurrentEpoch+1)*epochInfo.Duration,
+		),
+		undelegationTime,
+	)
+	suite.Require().True(undelegationTime.After(suite.ctx.BlockTime()))
+	suite.Require().Equal(undelegationTime.Add(hc.UnbondingPeriod), matureTime)
+}
+
+func (suite *IntegrationTestSuite) TestEstimateUnbonding() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	hc.UnbondingPeriod = 14 * 24 * time.Hour
+	k.SetHostChain(suite.ctx, hc)
+
+	epoch := types.CurrentUnbondingEpoch(hc.UnbondingFactor, k.GetEpochNumber(suite.ctx, types.UndelegationEpoch))
+	undelegationTime, matureTime := k.EstimateUnbondingTimes(suite.ctx, hc, epoch)
+	reportedMatureTime := suite.ctx.BlockTime().Add(10 * 24 * time.Hour)
+
+	unbonding := &types.Unbonding{
+		ChainId:      hc.ChainId,
+		EpochNumber:  epoch,
+		BurnAmount:   sdk.NewInt64Coin(hc.MintDenom(), 100),
+		UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 100),
+	}
+
+	tc := []struct {
+		name      string
+		unbonding *types.Unbonding
+		expected  types.UnbondingEstimate
+	}{{
+		name: "NoUnbonding",
+		expected: types.UnbondingEstimate{
+			EpochNumber:      epoch,
+			UndelegationTime: undelegationTime,
+			UnbondingPeriod:  hc.UnbondingPeriod,
+			MatureTime:       matureTime,
+			ClaimTime:        matureTime.Add(types.ICATimeoutTimestamp),
+		},
+	}, {
+		name: "Pending",
+		unbonding: &types.Unbonding{
+			State: types.Unbonding_UNBONDING_PENDING,
+		},
+		expected: types.UnbondingEstimate{
+			EpochNumber:      epoch,
+			UndelegationTime: undelegationTime,
+			UnbondingPeriod:  hc.UnbondingPeriod,
+			MatureTime:       matureTime,
+			ClaimTime:        matureTime.Add(types.ICATimeoutTimestamp),
+		},
+	}, {
+		name: "Maturing",
+		unbonding: &types.Unbonding{
+			State:      types.Unbonding_UNBONDING_MATURING,
+			MatureTime: reportedMatureTime,
+		},
+		expected: types.UnbondingEstimate{
+			EpochNumber:      epoch,
+			UndelegationTime: reportedMatureTime.Add(-hc.UnbondingPeriod),
+			UnbondingPeriod:  hc.UnbondingPeriod,
+			MatureTime:       reportedMatureTime,
+			ClaimTime:        reportedMatureTime.Add(types.ICATimeoutTimestamp),
+		},
+	}, {
+		name: "Claimable",
+		unbonding: &types.Unbonding{
+			State:      types.Unbonding_UNBONDING_CLAIMABLE,
+			MatureTime: reportedMatureTime,
+		},
+		expected: types.UnbondingEstimate{
+			EpochNumber:      epoch,
+			UndelegationTime: reportedMatureTime.Add(-hc.UnbondingPeriod),
+			UnbondingPeriod:  hc.UnbondingPeriod,
+			MatureTime:       reportedMatureTime,
+			ClaimTime:        suite.ctx.BlockTime(),
+		},
+	}, {
+		name: "Failed",
+		unbonding: &types.Unbonding{
+			State: types.Unbonding_UNBONDING_FAILED,
+		},
+		expected: types.UnbondingEstimate{
+			EpochNumber:     epoch,
+			UnbondingPeriod: hc.UnbondingPeriod,
+			ClaimTime:       suite.ctx.BlockTime(),
+		},
+	}}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+
+			if t.unbonding != nil {
+				unbonding.State = t.unbonding.State
+				unbonding.MatureTime = t.unbonding.MatureTime
+				k.SetUnbonding(ctx, unbonding)
+
+				stored, _ := k.GetUnbonding(ctx, hc.ChainId, epoch)
+				t.expected.Unbonding = stored
+			}
+
+			suite.Require().Equal(t.expected, k.EstimateUnbonding(ctx, hc, epoch))
+		})
+	}
+}
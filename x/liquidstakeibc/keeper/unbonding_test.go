@@ -232,3 +232,81 @@ func (suite *IntegrationTestSuite) TestRevertUnbondingsState() {
 		}
 	}
 }
+
+func (suite *IntegrationTestSuite) TestUnbondingIndexes() {
+	epoch := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, types.DelegationEpoch).CurrentEpoch
+
+	unbonding := &types.Unbonding{
+		ChainId:       suite.chainB.ChainID,
+		EpochNumber:   epoch,
+		IbcSequenceId: "sequence-1",
+		State:         types.Unbonding_UNBONDING_INITIATED,
+	}
+	suite.app.LiquidStakeIBCKeeper.SetUnbonding(suite.ctx, unbonding)
+
+	suite.Require().Len(suite.app.LiquidStakeIBCKeeper.GetUnbondingsWithSequenceID(suite.ctx, "sequence-1"), 1)
+	suite.Require().Len(suite.app.LiquidStakeIBCKeeper.GetUnbondingsForChainAndState(
+		suite.ctx, suite.chainB.ChainID, types.Unbonding_UNBONDING_INITIATED,
+	), 1)
+
+	// the unbonding ack clears the sequence id and moves the unbonding to maturing
+	unbonding.IbcSequenceId = ""
+	unbonding.State = types.Unbonding_UNBONDING_MATURING
+	suite.app.LiquidStakeIBCKeeper.SetUnbonding(suite.ctx, unbonding)
+
+	suite.Require().Len(suite.app.LiquidStakeIBCKeeper.GetUnbondingsWithSequenceID(suite.ctx, "sequence-1"), 0)
+	suite.Require().Len(suite.app.LiquidStakeIBCKeeper.GetUnbondingsForChainAndState(
+		suite.ctx, suite.chainB.ChainID, types.Unbonding_UNBONDING_INITIATED,
+	), 0)
+	suite.Require().Equal(
+		[]*types.Unbonding{unbonding},
+		suite.app.LiquidStakeIBCKeeper.GetUnbondingsForChainAndState(
+			suite.ctx, suite.chainB.ChainID, types.Unbonding_UNBONDING_MATURING,
+		),
+	)
+
+	suite.app.LiquidStakeIBCKeeper.DeleteUnbonding(suite.ctx, unbonding)
+
+	suite.Require().Len(suite.app.LiquidStakeIBCKeeper.GetUnbondingsForChainAndState(
+		suite.ctx, suite.chainB.ChainID, types.Unbonding_UNBONDING_MATURING,
+	), 0)
+}
+
+func (suite *IntegrationTestSuite) TestUndelegationWorkflowICASendsPaused() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	hc.Pauses = &types.HostChainPauses{IcaSends: true}
+	k.SetHostChain(ctx, hc)
+
+	epoch := hc.UnbondingFactor * 100
+	k.SetUnbonding(ctx, &types.Unbonding{
+		ChainId:      hc.ChainId,
+		EpochNumber:  epoch,
+		BurnAmount:   sdk.NewInt64Coin(hc.MintDenom(), 100),
+		UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 100),
+		State:        types.Unbonding_UNBONDING_PENDING,
+	})
+
+	// the unbonding is postponed while the pause is on
+	k.UndelegationWorkflow(ctx, epoch)
+
+	unbonding, found := k.GetUnbonding(ctx, hc.ChainId, epoch)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Unbonding_UNBONDING_PENDING, unbonding.State)
+	suite.Require().Empty(unbonding.IbcSequenceId)
+
+	// and sent on the first unbonding epoch after the pause is lifted
+	hc.Pauses = &types.HostChainPauses{}
+	k.SetHostChain(ctx, hc)
+
+	k.UndelegationWorkflow(ctx, epoch+hc.UnbondingFactor)
+
+	unbonding, found = k.GetUnbonding(ctx, hc.ChainId, epoch)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Unbonding_UNBONDING_INITIATED, unbonding.State)
+	suite.Require().NotEmpty(unbonding.IbcSequenceId)
+}
@@ -9,8 +9,10 @@ import (
 
 func (k *Keeper) SetUserUnbonding(ctx sdk.Context, ub *types.UserUnbonding) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserUnbondingKey)
+	storeKey := types.GetUserUnbondingStoreKey(ub.ChainId, ub.Address, ub.EpochNumber)
 	bytes := k.cdc.MustMarshal(ub)
-	store.Set(types.GetUserUnbondingStoreKey(ub.ChainId, ub.Address, ub.EpochNumber), bytes)
+	store.Set(storeKey, bytes)
+	k.setEpochIndex(ctx, types.UserUnbondingEpochIndexKey, ub.ChainId, ub.EpochNumber, storeKey)
 }
 
 func (k *Keeper) GetUserUnbonding(
@@ -32,7 +34,9 @@ func (k *Keeper) GetUserUnbonding(
 
 func (k *Keeper) DeleteUserUnbonding(ctx sdk.Context, ub *types.UserUnbonding) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserUnbondingKey)
-	store.Delete(types.GetUserUnbondingStoreKey(ub.ChainId, ub.Address, ub.EpochNumber))
+	storeKey := types.GetUserUnbondingStoreKey(ub.ChainId, ub.Address, ub.EpochNumber)
+	store.Delete(storeKey)
+	k.deleteEpochIndex(ctx, types.UserUnbondingEpochIndexKey, ub.ChainId, ub.EpochNumber, storeKey)
 }
 
 func (k *Keeper) FilterUserUnbondings(ctx sdk.Context, filter func(u types.UserUnbonding) bool) []*types.UserUnbonding {
@@ -52,6 +56,52 @@ func (k *Keeper) FilterUserUnbondings(ctx sdk.Context, filter func(u types.UserU
 	return userUnbondings
 }
 
+// GetUserUnbondingsForEpoch returns all the user unbondings of a host chain epoch using the epoch index
+func (k *Keeper) GetUserUnbondingsForEpoch(ctx sdk.Context, chainID string, epochNumber int64) []*types.UserUnbonding {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserUnbondingKey)
+	storeKeys := k.getIndexedStoreKeys(
+		ctx,
+		types.UserUnbondingEpochIndexKey,
+		types.GetEpochIndexPrefix(chainID, epochNumber),
+	)
+
+	userUnbondings := make([]*types.UserUnbonding, 0, len(storeKeys))
+	for _, storeKey := range storeKeys {
+		bz := store.Get(storeKey)
+		if bz == nil {
+			continue
+		}
+
+		userUnbonding := &types.UserUnbonding{}
+		k.cdc.MustUnmarshal(bz, userUnbonding)
+		userUnbondings = append(userUnbondings, userUnbonding)
+	}
+
+	return userUnbondings
+}
+
+// GetUserUnbondingsForDelegator returns all the user unbondings of a delegator on a host chain
+func (k *Keeper) GetUserUnbondingsForDelegator(
+	ctx sdk.Context,
+	chainID string,
+	delegatorAddress string,
+) []*types.UserUnbonding {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserUnbondingKey)
+	iterator := sdk.KVStorePrefixIterator(store, append([]byte(chainID), []byte(delegatorAddress)...))
+	defer iterator.Close()
+
+	userUnbondings := make([]*types.UserUnbonding, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		userUnbonding := types.UserUnbonding{}
+		k.cdc.MustUnmarshal(iterator.Value(), &userUnbonding)
+		if userUnbonding.ChainId == chainID && userUnbonding.Address == delegatorAddress {
+			userUnbondings = append(userUnbondings, &userUnbonding)
+		}
+	}
+
+	return userUnbondings
+}
+
 func (k *Keeper) IncreaseUserUnbondingAmountForEpoch(
 	ctx sdk.Context,
 	chainID string,
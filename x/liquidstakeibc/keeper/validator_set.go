@@ -0,0 +1,790 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetValidatorSetProposal(ctx sdk.Context, proposal *types.ValidatorSetProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSetProposalKey)
+	bytes := k.cdc.MustMarshal(proposal)
+	store.Set([]byte(proposal.ChainId), bytes)
+}
+
+func (k *Keeper) GetValidatorSetProposal(ctx sdk.Context, chainID string) (*types.ValidatorSetProposal, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSetProposalKey)
+	bz := store.Get([]byte(chainID))
+	if bz == nil {
+		return &types.ValidatorSetProposal{}, false
+	}
+
+	var proposal types.ValidatorSetProposal
+	k.cdc.MustUnmarshal(bz, &proposal)
+	return &proposal, true
+}
+
+func (k *Keeper) DeleteValidatorSetProposal(ctx sdk.Context, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSetProposalKey)
+	store.Delete([]byte(chainID))
+}
+
+func (k *Keeper) GetAllValidatorSetProposals(ctx sdk.Context) []*types.ValidatorSetProposal {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSetProposalKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	proposals := make([]*types.ValidatorSetProposal, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		proposal := types.ValidatorSetProposal{}
+		k.cdc.MustUnmarshal(iterator.Value(), &proposal)
+		proposals = append(proposals, &proposal)
+	}
+
+	return proposals
+}
+
+// SetValidatorSetRound stores the validator set selection round in progress of a host chain
+func (k *Keeper) SetValidatorSetRound(ctx sdk.Context, round *types.ValidatorSetRound) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSetRoundKey)
+	store.Set(types.GetValidatorSetRoundStoreKey(round.ChainId), k.cdc.MustMarshal(round))
+}
+
+// GetValidatorSetRound returns the validator set selection round in progress of a host chain
+func (k *Keeper) GetValidatorSetRound(ctx sdk.Context, chainID string) (*types.ValidatorSetRound, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSetRoundKey)
+	bz := store.Get(types.GetValidatorSetRoundStoreKey(chainID))
+	if bz == nil {
+		return &types.ValidatorSetRound{}, false
+	}
+
+	var round types.ValidatorSetRound
+	k.cdc.MustUnmarshal(bz, &round)
+	return &round, true
+}
+
+// DeleteValidatorSetRound removes the validator set selection round of a host chain together with its candidates
+func (k *Keeper) DeleteValidatorSetRound(ctx sdk.Context, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSetRoundKey)
+	store.Delete(types.GetValidatorSetRoundStoreKey(chainID))
+
+	k.deletePrefixedRecords(ctx, types.ValidatorSetCandidateKey, types.GetValidatorSetCandidateChainPrefix(chainID))
+	k.deletePrefixedRecords(
+		ctx,
+		types.ValidatorSetCandidateConsIndexKey,
+		types.GetValidatorSetCandidateChainPrefix(chainID),
+	)
+}
+
+// GetAllValidatorSetRounds returns the validator set selection rounds in progress of all the host chains
+func (k *Keeper) GetAllValidatorSetRounds(ctx sdk.Context) []*types.ValidatorSetRound {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSetRoundKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	rounds := make([]*types.ValidatorSetRound, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		round := types.ValidatorSetRound{}
+		k.cdc.MustUnmarshal(iterator.Value(), &round)
+		rounds = append(rounds, &round)
+	}
+
+	return rounds
+}
+
+// SetValidatorSetCandidate stores a candidate of a validator set selection round, indexing it by consensus address
+// once it is known
+func (k *Keeper) SetValidatorSetCandidate(ctx sdk.Context, candidate *types.ValidatorSetCandidate) {
+	_, valAddr, err := bech32.DecodeAndConvert(candidate.OperatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSetCandidateKey)
+	store.Set(types.GetValidatorSetCandidateStoreKey(candidate.ChainId, valAddr), k.cdc.MustMarshal(candidate))
+
+	if candidate.ConsensusAddress != "" {
+		_, consAddr, err := bech32.DecodeAndConvert(candidate.ConsensusAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSetCandidateConsIndexKey)
+		indexStore.Set(types.GetValidatorSetCandidateConsIndexKey(candidate.ChainId, consAddr), valAddr)
+	}
+}
+
+// GetValidatorSetCandidate returns a candidate of the validator set selection round of a host chain
+func (k *Keeper) GetValidatorSetCandidate(
+	ctx sdk.Context,
+	chainID string,
+	valAddr []byte,
+) (*types.ValidatorSetCandidate, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSetCandidateKey)
+	bz := store.Get(types.GetValidatorSetCandidateStoreKey(chainID, valAddr))
+	if bz == nil {
+		return &types.ValidatorSetCandidate{}, false
+	}
+
+	var candidate types.ValidatorSetCandidate
+	k.cdc.MustUnmarshal(bz, &candidate)
+	return &candidate, true
+}
+
+// getValidatorSetCandidateByConsAddr returns a candidate of the validator set selection round of a host chain out
+// of its consensus address
+func (k *Keeper) getValidatorSetCandidateByConsAddr(
+	ctx sdk.Context,
+	chainID string,
+	consAddr []byte,
+) (*types.ValidatorSetCandidate, bool) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSetCandidateConsIndexKey)
+	valAddr := indexStore.Get(types.GetValidatorSetCandidateConsIndexKey(chainID, consAddr))
+	if valAddr == nil {
+		return &types.ValidatorSetCandidate{}, false
+	}
+
+	return k.GetValidatorSetCandidate(ctx, chainID, valAddr)
+}
+
+// deleteValidatorSetCandidate removes a candidate of a validator set selection round and its index entry
+func (k *Keeper) deleteValidatorSetCandidate(ctx sdk.Context, candidate *types.ValidatorSetCandidate) {
+	_, valAddr, err := bech32.DecodeAndConvert(candidate.OperatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSetCandidateKey)
+	store.Delete(types.GetValidatorSetCandidateStoreKey(candidate.ChainId, valAddr))
+
+	if candidate.ConsensusAddress != "" {
+		_, consAddr, err := bech32.DecodeAndConvert(candidate.ConsensusAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSetCandidateConsIndexKey)
+		indexStore.Delete(types.GetValidatorSetCandidateConsIndexKey(candidate.ChainId, consAddr))
+	}
+}
+
+// GetValidatorSetCandidates returns the candidates of the validator set selection round of a host chain
+func (k *Keeper) GetValidatorSetCandidates(ctx sdk.Context, chainID string) []*types.ValidatorSetCandidate {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSetCandidateKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetValidatorSetCandidateChainPrefix(chainID))
+	defer iterator.Close()
+
+	candidates := make([]*types.ValidatorSetCandidate, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		candidate := types.ValidatorSetCandidate{}
+		k.cdc.MustUnmarshal(iterator.Value(), &candidate)
+		candidates = append(candidates, &candidate)
+	}
+
+	return candidates
+}
+
+// GetAllValidatorSetCandidates returns the candidates of the validator set selection rounds of all the host chains
+func (k *Keeper) GetAllValidatorSetCandidates(ctx sdk.Context) []*types.ValidatorSetCandidate {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorSetCandidateKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	candidates := make([]*types.ValidatorSetCandidate, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		candidate := types.ValidatorSetCandidate{}
+		k.cdc.MustUnmarshal(iterator.Value(), &candidate)
+		candidates = append(candidates, &candidate)
+	}
+
+	return candidates
+}
+
+// ValidatorSetWorkflow starts a validator set selection round for every host chain with the automated selection
+// enabled
+func (k *Keeper) ValidatorSetWorkflow(ctx sdk.Context, epoch int64) {
+	k.Logger(ctx).Info("Running validator set workflow.", "epoch", epoch)
+
+	for _, hc := range k.GetAllHostChains(ctx) {
+		if !hc.Active || hc.ValidatorSetConfig == nil || !hc.ValidatorSetConfig.Enabled {
+			continue
+		}
+
+		if err := k.StartValidatorSetRound(ctx, hc, epoch); err != nil {
+			k.Logger(ctx).Error(
+				"could not send ICQ queries for the host chain validator set",
+				"host_chain",
+				hc.ChainId,
+			)
+		}
+	}
+}
+
+// StartValidatorSetRound replaces the validator set selection round of a host chain with a new one, and sends the
+// queries to discover the host chain bonded set and to retrieve its slashing params. The candidates discovered are
+// only scored once their validator records and signing infos are proof verified.
+func (k *Keeper) StartValidatorSetRound(ctx sdk.Context, hc *types.HostChain, epoch int64) error {
+	k.DeleteValidatorSetRound(ctx, hc.ChainId)
+	k.SetValidatorSetRound(ctx, &types.ValidatorSetRound{ChainId: hc.ChainId, Epoch: epoch})
+
+	if err := k.QueryHostChainSlashingParams(ctx, hc); err != nil {
+		return err
+	}
+
+	return k.QueryHostChainValidatorSet(ctx, hc, nil)
+}
+
+// ProcessHostChainValidatorSetPage adds the validators of a page of the host chain bonded set as candidates of the
+// validator set selection round and queries their records, then requests the next page if there is one
+func (k *Keeper) ProcessHostChainValidatorSetPage(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	validators []stakingtypes.Validator,
+	nextKey []byte,
+) error {
+	round, found := k.GetValidatorSetRound(ctx, hc.ChainId)
+	if !found || round.DiscoveryDone || hc.ValidatorSetConfig == nil || !hc.ValidatorSetConfig.Enabled {
+		return nil
+	}
+
+	for _, validator := range validators {
+		if validator.Status != stakingtypes.Bonded || round.Candidates >= types.MaxValidatorSetCandidates {
+			continue
+		}
+
+		// the page is not proof verified, only the operator address is taken from it
+		_, valAddr, err := bech32.DecodeAndConvert(validator.OperatorAddress)
+		if err != nil {
+			continue
+		}
+		if _, found := k.GetValidatorSetCandidate(ctx, hc.ChainId, valAddr); found {
+			continue
+		}
+
+		k.SetValidatorSetCandidate(ctx, &types.ValidatorSetCandidate{
+			ChainId:             hc.ChainId,
+			OperatorAddress:     validator.OperatorAddress,
+			Tokens:              sdk.ZeroInt(),
+			Commission:          sdk.ZeroDec(),
+			DelegatorShares:     sdk.ZeroDec(),
+			LiquidShares:        sdk.ZeroDec(),
+			ValidatorBondShares: sdk.ZeroDec(),
+		})
+		round.Candidates++
+
+		if err := k.QueryValidatorSetCandidate(ctx, hc, valAddr); err != nil {
+			return err
+		}
+	}
+
+	if len(nextKey) > 0 {
+		if err := k.QueryHostChainValidatorSet(ctx, hc, nextKey); err != nil {
+			return err
+		}
+	} else {
+		round.DiscoveryDone = true
+	}
+	k.SetValidatorSetRound(ctx, round)
+
+	return k.completeValidatorSetRound(ctx, hc, round)
+}
+
+// ProcessValidatorSetCandidate stores the proof verified record of a validator set candidate and queries its
+// signing info. Candidates the proof shows don't exist are dropped from the round.
+func (k *Keeper) ProcessValidatorSetCandidate(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	valAddr sdk.ValAddress,
+	validator *stakingtypes.Validator,
+) error {
+	round, found := k.GetValidatorSetRound(ctx, hc.ChainId)
+	if !found {
+		return nil
+	}
+	candidate, found := k.GetValidatorSetCandidate(ctx, hc.ChainId, valAddr)
+	if !found || candidate.ValidatorVerified {
+		return nil
+	}
+
+	if validator == nil {
+		k.deleteValidatorSetCandidate(ctx, candidate)
+		round.Candidates--
+		k.SetValidatorSetRound(ctx, round)
+		return k.completeValidatorSetRound(ctx, hc, round)
+	}
+
+	_, operatorAddr, err := bech32.DecodeAndConvert(validator.OperatorAddress)
+	if err != nil || !bytes.Equal(operatorAddr, valAddr) {
+		return fmt.Errorf("validator %s doesn't match the queried validator %X", validator.OperatorAddress, valAddr)
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	candidate.ConsensusAddress, err = validatorConsensusAddress(validator.OperatorAddress, consAddr)
+	if err != nil {
+		return err
+	}
+
+	candidate.OperatorAddress = validator.OperatorAddress
+	candidate.Status = validator.Status.String()
+	candidate.Jailed = validator.Jailed
+	candidate.Tokens = validator.Tokens
+	candidate.Commission = validator.Commission.Rate
+	candidate.DelegatorShares = validator.DelegatorShares
+	candidate.LiquidShares = validator.LiquidShares
+	candidate.ValidatorBondShares = validator.ValidatorBondShares
+	candidate.ValidatorVerified = true
+	k.SetValidatorSetCandidate(ctx, candidate)
+
+	return k.QueryValidatorSetCandidateSigningInfo(ctx, hc, consAddr)
+}
+
+// ProcessValidatorSetCandidateSigningInfo stores the missed blocks of a validator set candidate out of its proof
+// verified signing info. Candidates without signing info or tombstoned are dropped from the round.
+func (k *Keeper) ProcessValidatorSetCandidateSigningInfo(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	consAddr sdk.ConsAddress,
+	signingInfo *slashingtypes.ValidatorSigningInfo,
+) error {
+	round, found := k.GetValidatorSetRound(ctx, hc.ChainId)
+	if !found {
+		return nil
+	}
+	candidate, found := k.getValidatorSetCandidateByConsAddr(ctx, hc.ChainId, consAddr)
+	if !found || candidate.SigningInfoVerified {
+		return nil
+	}
+
+	if signingInfo == nil || signingInfo.Tombstoned {
+		k.deleteValidatorSetCandidate(ctx, candidate)
+		round.Candidates--
+	} else {
+		candidate.MissedBlocksCounter = signingInfo.MissedBlocksCounter
+		candidate.SigningInfoVerified = true
+		k.SetValidatorSetCandidate(ctx, candidate)
+		round.Verified++
+	}
+	k.SetValidatorSetRound(ctx, round)
+
+	return k.completeValidatorSetRound(ctx, hc, round)
+}
+
+// ProcessValidatorSetSignedBlocksWindow stores the proof verified host chain signed blocks window the candidates
+// uptime is computed against
+func (k *Keeper) ProcessValidatorSetSignedBlocksWindow(ctx sdk.Context, hc *types.HostChain, window int64) error {
+	round, found := k.GetValidatorSetRound(ctx, hc.ChainId)
+	if !found {
+		return nil
+	}
+
+	round.SignedBlocksWindow = window
+	k.SetValidatorSetRound(ctx, round)
+
+	return k.completeValidatorSetRound(ctx, hc, round)
+}
+
+// completeValidatorSetRound selects the validator set of a host chain once the whole bonded set has been discovered
+// and every candidate is proof verified, and removes the round
+func (k *Keeper) completeValidatorSetRound(ctx sdk.Context, hc *types.HostChain, round *types.ValidatorSetRound) error {
+	if !round.DiscoveryDone || round.SignedBlocksWindow == 0 || round.Verified < round.Candidates {
+		return nil
+	}
+
+	candidates := k.GetValidatorSetCandidates(ctx, hc.ChainId)
+	k.DeleteValidatorSetRound(ctx, hc.ChainId)
+
+	return k.ProcessHostChainValidatorSet(ctx, hc, round, candidates)
+}
+
+// ProcessHostChainValidatorSet selects the validator set of a host chain out of the verified candidates of a round,
+// stores it as the latest proposal and applies it if the host chain is configured to do so
+func (k *Keeper) ProcessHostChainValidatorSet(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	round *types.ValidatorSetRound,
+	candidates []*types.ValidatorSetCandidate,
+) error {
+	if hc.ValidatorSetConfig == nil || !hc.ValidatorSetConfig.Enabled {
+		return nil
+	}
+
+	proposal, err := k.SelectValidatorSet(ctx, hc, round, candidates)
+	if err != nil {
+		// a selection that doesn't fit the configured bounds is not an error on the query response
+		k.Logger(ctx).Error(
+			"could not select host chain validator set",
+			"host_chain",
+			hc.ChainId,
+			"reason",
+			err.Error(),
+		)
+		return nil
+	}
+
+	k.SetValidatorSetProposal(ctx, proposal)
+
+	applied := false
+	if hc.ValidatorSetConfig.AutoApply && k.GetParams(ctx).UpdateTimelockEpochs > 0 {
+		// the selected validator set waits for the update timelock, as the ones applied by the validator set manager
+		k.QueueTimelockedUpdate(
+			ctx,
+			hc.ChainId,
+			authtypes.NewModuleAddress(types.ModuleName).String(),
+			[]*types.KVUpdate{{Key: types.KeyApplyValidatorSet, Value: types.MustMarshalPinnedValidatorSetProposal(proposal)}},
+		)
+	} else if hc.ValidatorSetConfig.AutoApply {
+		if err := k.ApplyValidatorSetProposal(ctx, hc, proposal); err != nil {
+			k.Logger(ctx).Error(
+				"could not apply host chain validator set proposal",
+				"host_chain",
+				hc.ChainId,
+				"reason",
+				err.Error(),
+			)
+		} else {
+			applied = true
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorSet,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeValidatorCount, strconv.Itoa(len(proposal.Validators))),
+			sdk.NewAttribute(types.AttributeApplied, strconv.FormatBool(applied)),
+		),
+	)
+
+	return nil
+}
+
+// SelectValidatorSet scores the verified candidates of a host chain validator set round and assigns weights to the
+// best ones within the bounds of the host chain validator set config. Jailed validators, validators above the
+// commission bound and, for LSM chains, validators that reached their LSM caps are not eligible. The score favours
+// low commission, low voting power, high uptime over the host chain signed blocks window and, for LSM chains, room
+// left below the LSM caps.
+func (k *Keeper) SelectValidatorSet(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	round *types.ValidatorSetRound,
+	candidates []*types.ValidatorSetCandidate,
+) (*types.ValidatorSetProposal, error) {
+	config := hc.ValidatorSetConfig
+
+	if round.SignedBlocksWindow <= 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidValidatorSet, "host chain %s has no signed blocks window", hc.ChainId)
+	}
+
+	totalTokens := sdk.ZeroInt()
+	for _, candidate := range candidates {
+		if candidate.IsVerified() && candidate.Status == stakingtypes.BondStatusBonded {
+			totalTokens = totalTokens.Add(candidate.Tokens)
+		}
+	}
+	if !totalTokens.IsPositive() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidValidatorSet, "host chain %s has no bonded tokens", hc.ChainId)
+	}
+
+	scores := make([]*types.ValidatorScore, 0)
+	for _, candidate := range candidates {
+		if !candidate.IsVerified() || candidate.Status != stakingtypes.BondStatusBonded || candidate.Jailed {
+			continue
+		}
+
+		commission := candidate.Commission
+		if !config.MaxCommission.IsNil() && config.MaxCommission.IsPositive() && commission.GT(config.MaxCommission) {
+			continue
+		}
+
+		uptime := sdk.OneDec().Sub(sdk.NewDec(candidate.MissedBlocksCounter).QuoInt64(round.SignedBlocksWindow))
+		votingPower := sdk.NewDecFromInt(candidate.Tokens).QuoInt(totalTokens)
+		score := sdk.OneDec().Sub(commission).Mul(sdk.OneDec().Sub(votingPower)).Mul(uptime)
+
+		if hc.Flags.Lsm {
+			score = score.Mul(candidateLSMRoom(hc, candidate))
+		}
+
+		if !score.IsPositive() {
+			continue
+		}
+
+		scores = append(scores, &types.ValidatorScore{
+			OperatorAddress: candidate.OperatorAddress,
+			Score:           score,
+		})
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		if !scores[i].Score.Equal(scores[j].Score) {
+			return scores[i].Score.GT(scores[j].Score)
+		}
+		return scores[i].OperatorAddress < scores[j].OperatorAddress
+	})
+	if len(scores) > int(config.MaxValidators) {
+		scores = scores[:config.MaxValidators]
+	}
+
+	minWeight, maxWeight := config.WeightBounds()
+	weights, err := boundedWeights(scores, minWeight, maxWeight)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "host chain %s", hc.ChainId)
+	}
+	for i, score := range scores {
+		score.Weight = weights[i]
+	}
+
+	return &types.ValidatorSetProposal{
+		ChainId:    hc.ChainId,
+		Height:     ctx.BlockHeight(),
+		Validators: scores,
+		Epoch:      round.Epoch,
+	}, nil
+}
+
+// ValidateValidatorSetProposal checks that a validator set proposal can be applied to a host chain. Proposals
+// selected more than MaxValidatorSetProposalAge delegation epochs ago are rejected.
+func (k *Keeper) ValidateValidatorSetProposal(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	proposal *types.ValidatorSetProposal,
+) error {
+	if err := proposal.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidValidatorSet, err.Error())
+	}
+
+	epoch := k.GetEpochNumber(ctx, types.DelegationEpoch)
+	if proposal.Epoch > epoch {
+		return errorsmod.Wrapf(
+			types.ErrInvalidValidatorSet,
+			"validator set proposal for %s is from the future epoch %d",
+			hc.ChainId,
+			proposal.Epoch,
+		)
+	}
+	if epoch-proposal.Epoch > types.MaxValidatorSetProposalAge {
+		return errorsmod.Wrapf(
+			types.ErrInvalidValidatorSet,
+			"validator set proposal for %s from epoch %d is older than %d epochs",
+			hc.ChainId,
+			proposal.Epoch,
+			types.MaxValidatorSetProposalAge,
+		)
+	}
+
+	return nil
+}
+
+// ApplyValidatorSetProposal validates a validator set proposal and applies it to the host chain
+func (k *Keeper) ApplyValidatorSetProposal(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	proposal *types.ValidatorSetProposal,
+) error {
+	if err := k.ValidateValidatorSetProposal(ctx, hc, proposal); err != nil {
+		return err
+	}
+
+	k.applyValidatorSetProposal(ctx, hc, proposal)
+	return nil
+}
+
+// applyValidatorSetProposal sets the host chain validator weights to the ones of a validator set proposal and
+// removes the proposal if it is still the latest one. Validators not registered yet are added and queried,
+// registered validators not part of the proposal lose their weight.
+func (k *Keeper) applyValidatorSetProposal(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	proposal *types.ValidatorSetProposal,
+) {
+	weights := make(map[string]sdk.Dec)
+	for _, score := range proposal.Validators {
+		weights[score.OperatorAddress] = score.Weight
+	}
+
+	for _, validator := range hc.Validators {
+		weight, found := weights[validator.OperatorAddress]
+		if !found {
+			weight = sdk.ZeroDec()
+		}
+		validator.Weight = weight
+		delete(weights, validator.OperatorAddress)
+	}
+
+	// the rest of the validator details are filled by the validator ICQ once it is registered
+	newValidators := make([]string, 0)
+	for _, score := range proposal.Validators {
+		if _, isNew := weights[score.OperatorAddress]; !isNew {
+			continue
+		}
+
+		hc.Validators = append(hc.Validators, &types.Validator{
+			OperatorAddress: score.OperatorAddress,
+			Status:          stakingtypes.BondStatusBonded,
+			Weight:          score.Weight,
+			DelegatedAmount: sdk.ZeroInt(),
+			ExchangeRate:    sdk.OneDec(),
+			Delegable:       true,
+			Commission:      sdk.ZeroDec(),
+			Tokens:          sdk.ZeroInt(),
+		})
+		newValidators = append(newValidators, score.OperatorAddress)
+	}
+	k.SetHostChain(ctx, hc)
+
+	for _, validatorAddress := range newValidators {
+		if err := k.QueryHostChainValidator(ctx, hc, validatorAddress); err != nil {
+			k.Logger(ctx).Error(
+				"could not send ICQ query for validator",
+				"host_chain",
+				hc.ChainId,
+				"validator",
+				validatorAddress,
+			)
+		}
+	}
+
+	// a pinned proposal leaves a newer selection in place
+	if latest, found := k.GetValidatorSetProposal(ctx, hc.ChainId); found &&
+		latest.Height == proposal.Height && latest.Epoch == proposal.Epoch {
+		k.DeleteValidatorSetProposal(ctx, hc.ChainId)
+	}
+
+	k.Logger(ctx).Info(
+		"Applied host chain validator set proposal.",
+		"host_chain",
+		hc.ChainId,
+		"validators",
+		len(proposal.Validators),
+		"new_validators",
+		len(newValidators),
+	)
+}
+
+// candidateLSMRoom returns the fraction of room a validator set candidate has left below both its LSM validator cap
+// and its LSM bond factor cap, zero if it reached either of them
+func candidateLSMRoom(hc *types.HostChain, candidate *types.ValidatorSetCandidate) sdk.Dec {
+	room := sdk.OneDec()
+
+	if candidate.DelegatorShares.IsPositive() {
+		if !hc.Params.LsmValidatorCap.IsPositive() {
+			return sdk.ZeroDec()
+		}
+		capUsage := candidate.LiquidShares.Quo(candidate.DelegatorShares).Quo(hc.Params.LsmValidatorCap)
+		room = sdk.MinDec(room, sdk.OneDec().Sub(capUsage))
+	}
+
+	// this is the default value for the bond factor, which disables the functionality
+	if !hc.Params.LsmBondFactor.Equal(sdk.NewDec(-1)) {
+		bondCap := candidate.ValidatorBondShares.Mul(hc.Params.LsmBondFactor)
+		if !bondCap.IsPositive() {
+			return sdk.ZeroDec()
+		}
+		room = sdk.MinDec(room, sdk.OneDec().Sub(candidate.LiquidShares.Quo(bondCap)))
+	}
+
+	if room.IsNegative() {
+		return sdk.ZeroDec()
+	}
+	return room
+}
+
+// boundedWeights distributes a total weight of one proportionally to the validator scores, keeping every weight
+// within the given bounds. Weights out of bounds are fixed at the bound and the rest is distributed again among the
+// remaining validators.
+func boundedWeights(scores []*types.ValidatorScore, minWeight, maxWeight sdk.Dec) ([]sdk.Dec, error) {
+	n := int64(len(scores))
+	if n == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidValidatorSet, "no eligible validators")
+	}
+	if minWeight.MulInt64(n).GT(sdk.OneDec()) || maxWeight.MulInt64(n).LT(sdk.OneDec()) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidValidatorSet,
+			"%d eligible validators don't fit the weight bounds [%s, %s]",
+			n,
+			minWeight,
+			maxWeight,
+		)
+	}
+
+	weights := make([]sdk.Dec, n)
+	fixed := make([]bool, n)
+	for round := int64(0); round <= 2*n; round++ {
+		remaining, freeScore, freeCount := sdk.OneDec(), sdk.ZeroDec(), int64(0)
+		for i, score := range scores {
+			if fixed[i] {
+				remaining = remaining.Sub(weights[i])
+				continue
+			}
+			freeScore = freeScore.Add(score.Score)
+			freeCount++
+		}
+		if freeCount == 0 {
+			break
+		}
+
+		for i, score := range scores {
+			if fixed[i] {
+				continue
+			}
+			if freeScore.IsPositive() {
+				weights[i] = remaining.Mul(score.Score).Quo(freeScore)
+			} else {
+				weights[i] = remaining.QuoInt64(freeCount)
+			}
+		}
+
+		// fix the weights above the upper bound first, as lowering them raises the rest
+		outOfBounds := false
+		for i := range scores {
+			if !fixed[i] && weights[i].GT(maxWeight) {
+				weights[i], fixed[i], outOfBounds = maxWeight, true, true
+			}
+		}
+		if outOfBounds {
+			continue
+		}
+		for i := range scores {
+			if !fixed[i] && weights[i].LT(minWeight) {
+				weights[i], fixed[i], outOfBounds = minWeight, true, true
+			}
+		}
+		if !outOfBounds {
+			break
+		}
+	}
+
+	// assign the rounding remainder to the heaviest validator so the weights add up to exactly one
+	total, heaviest := sdk.ZeroDec(), 0
+	for i, weight := range weights {
+		total = total.Add(weight)
+		if weight.GT(weights[heaviest]) {
+			heaviest = i
+		}
+	}
+	if sdk.OneDec().Sub(total).Abs().GT(sdk.NewDecWithPrec(1, 9)) {
+		return nil, errorsmod.Wrap(types.ErrInvalidValidatorSet, "could not fit the weights within bounds")
+	}
+	weights[heaviest] = weights[heaviest].Add(sdk.OneDec().Sub(total))
+
+	for _, weight := range weights {
+		if weight.IsNegative() || weight.GT(sdk.OneDec()) {
+			return nil, errorsmod.Wrap(types.ErrInvalidValidatorSet, "could not fit the weights within bounds")
+		}
+	}
+
+	return weights, nil
+}
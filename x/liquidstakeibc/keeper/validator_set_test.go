@@ -0,0 +1,392 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func newCandidate(
+	chainID string,
+	name string,
+	status stakingtypes.BondStatus,
+	jailed bool,
+	commission string,
+	tokens int64,
+) *types.ValidatorSetCandidate {
+	return &types.ValidatorSetCandidate{
+		ChainId:             chainID,
+		OperatorAddress:     sdk.ValAddress(name).String(),
+		ConsensusAddress:    sdk.ConsAddress(name).String(),
+		Status:              status.String(),
+		Jailed:              jailed,
+		Tokens:              sdk.NewInt(tokens),
+		Commission:          decFromStr(commission),
+		DelegatorShares:     sdk.NewDec(tokens),
+		LiquidShares:        sdk.ZeroDec(),
+		ValidatorBondShares: sdk.ZeroDec(),
+		ValidatorVerified:   true,
+		SigningInfoVerified: true,
+	}
+}
+
+// hostCandidates returns verified candidates where only validatorA, validatorB and validatorF are eligible, ordered
+// by score
+func hostCandidates(chainID string) []*types.ValidatorSetCandidate {
+	return []*types.ValidatorSetCandidate{
+		newCandidate(chainID, "validatorA", stakingtypes.Bonded, false, "0.05", 100),
+		newCandidate(chainID, "validatorB", stakingtypes.Bonded, false, "0.05", 300),
+		newCandidate(chainID, "validatorC", stakingtypes.Bonded, true, "0", 100),
+		newCandidate(chainID, "validatorD", stakingtypes.Bonded, false, "0.2", 100),
+		newCandidate(chainID, "validatorE", stakingtypes.Unbonding, false, "0", 100),
+		newCandidate(chainID, "validatorF", stakingtypes.Bonded, false, "0.1", 400),
+	}
+}
+
+func (suite *IntegrationTestSuite) TestGetSetValidatorSetProposal() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	proposal := &types.ValidatorSetProposal{
+		ChainId: suite.chainB.ChainID,
+		Height:  10,
+		Validators: []*types.ValidatorScore{{
+			OperatorAddress: sdk.ValAddress("validatorA").String(),
+			Score:           sdk.OneDec(),
+			Weight:          sdk.OneDec(),
+		}},
+	}
+	k.SetValidatorSetProposal(suite.ctx, proposal)
+
+	found, ok := k.GetValidatorSetProposal(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(ok)
+	suite.Require().Equal(proposal, found)
+
+	_, ok = k.GetValidatorSetProposal(suite.ctx, suite.chainC.ChainID)
+	suite.Require().False(ok)
+
+	suite.Require().Len(k.GetAllValidatorSetProposals(suite.ctx), 1)
+}
+
+func (suite *IntegrationTestSuite) TestSelectValidatorSet() {
+	tc := []struct {
+		name     string
+		config   *types.ValidatorSetConfig
+		expected map[string]sdk.Dec
+		wantErr  bool
+	}{
+		{
+			name: "score proportional",
+			config: &types.ValidatorSetConfig{
+				Enabled:       true,
+				MaxValidators: 3,
+				MaxCommission: decFromStr("0.1"),
+			},
+			// scores over the 1000 bonded tokens: validatorA 0.95 * 0.9, validatorB 0.95 * 0.7, validatorF 0.9 * 0.6
+			expected: map[string]sdk.Dec{
+				sdk.ValAddress("validatorA").String(): decFromStr("0.415048543689320388"),
+				sdk.ValAddress("validatorB").String(): decFromStr("0.322815533980582524"),
+				sdk.ValAddress("validatorF").String(): decFromStr("0.262135922330097087"),
+			},
+		},
+		{
+			name: "max validators and max weight",
+			config: &types.ValidatorSetConfig{
+				Enabled:       true,
+				MaxValidators: 2,
+				MaxWeight:     decFromStr("0.55"),
+				MaxCommission: decFromStr("0.1"),
+			},
+			expected: map[string]sdk.Dec{
+				sdk.ValAddress("validatorA").String(): decFromStr("0.55"),
+				sdk.ValAddress("validatorB").String(): decFromStr("0.45"),
+			},
+		},
+		{
+			name: "min weight",
+			config: &types.ValidatorSetConfig{
+				Enabled:       true,
+				MaxValidators: 3,
+				MinWeight:     decFromStr("0.3"),
+				MaxCommission: decFromStr("0.1"),
+			},
+			expected: map[string]sdk.Dec{
+				sdk.ValAddress("validatorA").String(): decFromStr("0.39375"),
+				sdk.ValAddress("validatorB").String(): decFromStr("0.30625"),
+				sdk.ValAddress("validatorF").String(): decFromStr("0.3"),
+			},
+		},
+		{
+			name: "not enough eligible validators",
+			config: &types.ValidatorSetConfig{
+				Enabled:       true,
+				MaxValidators: 3,
+				MaxWeight:     decFromStr("0.4"),
+				MaxCommission: decFromStr("0.05"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+			suite.Require().True(found)
+			hc.Flags = &types.HostChainFlags{}
+			hc.ValidatorSetConfig = t.config
+
+			proposal, err := suite.app.LiquidStakeIBCKeeper.SelectValidatorSet(
+				suite.ctx,
+				hc,
+				&types.ValidatorSetRound{ChainId: hc.ChainId, Epoch: 1, SignedBlocksWindow: 100},
+				hostCandidates(hc.ChainId),
+			)
+			if t.wantErr {
+				suite.Require().ErrorIs(err, types.ErrInvalidValidatorSet)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().NoError(proposal.Validate())
+			suite.Require().Len(proposal.Validators, len(t.expected))
+
+			for i, validator := range proposal.Validators {
+				if i > 0 {
+					suite.Require().True(validator.Score.LTE(proposal.Validators[i-1].Score))
+				}
+				suite.Require().True(
+					validator.Weight.Sub(t.expected[validator.OperatorAddress]).Abs().LT(decFromStr("0.000000000001")),
+					"validator %s weight %s", validator.OperatorAddress, validator.Weight,
+				)
+			}
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestSelectValidatorSetScoringTerms() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	hc.ValidatorSetConfig = &types.ValidatorSetConfig{Enabled: true, MaxValidators: 3}
+	hc.Flags = &types.HostChainFlags{Lsm: true}
+	hc.Params.LsmValidatorCap = decFromStr("0.5")
+	hc.Params.LsmBondFactor = sdk.NewDec(-1)
+
+	// same commission and voting power, validatorB missed a tenth of the window, validatorC used half its lsm cap
+	// and validatorD reached it
+	validatorA := newCandidate(hc.ChainId, "validatorA", stakingtypes.Bonded, false, "0", 100)
+	validatorB := newCandidate(hc.ChainId, "validatorB", stakingtypes.Bonded, false, "0", 100)
+	validatorB.MissedBlocksCounter = 10
+	validatorC := newCandidate(hc.ChainId, "validatorC", stakingtypes.Bonded, false, "0", 100)
+	validatorC.LiquidShares = sdk.NewDec(25)
+	validatorD := newCandidate(hc.ChainId, "validatorD", stakingtypes.Bonded, false, "0", 100)
+	validatorD.LiquidShares = sdk.NewDec(50)
+
+	proposal, err := k.SelectValidatorSet(
+		suite.ctx,
+		hc,
+		&types.ValidatorSetRound{ChainId: hc.ChainId, Epoch: 1, SignedBlocksWindow: 100},
+		[]*types.ValidatorSetCandidate{validatorA, validatorB, validatorC, validatorD},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(1), proposal.Epoch)
+
+	// voting power term 0.75, uptime term 1, 0.9 and 1, lsm room term 1, 1 and 0.5
+	expected := []*types.ValidatorScore{
+		{OperatorAddress: validatorA.OperatorAddress, Score: decFromStr("0.75"), Weight: decFromStr("0.416666666666666667")},
+		{OperatorAddress: validatorB.OperatorAddress, Score: decFromStr("0.675"), Weight: decFromStr("0.375")},
+		{OperatorAddress: validatorC.OperatorAddress, Score: decFromStr("0.375"), Weight: decFromStr("0.208333333333333333")},
+	}
+	suite.Require().Equal(expected, proposal.Validators)
+
+	// unverified candidates are not scored
+	validatorB.SigningInfoVerified = false
+	proposal, err = k.SelectValidatorSet(
+		suite.ctx,
+		hc,
+		&types.ValidatorSetRound{ChainId: hc.ChainId, Epoch: 1, SignedBlocksWindow: 100},
+		[]*types.ValidatorSetCandidate{validatorA, validatorB, validatorC},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(proposal.Validators, 2)
+	for _, score := range proposal.Validators {
+		suite.Require().NotEqual(validatorB.OperatorAddress, score.OperatorAddress)
+	}
+
+	// the uptime can't be computed without the signed blocks window
+	_, err = k.SelectValidatorSet(
+		suite.ctx,
+		hc,
+		&types.ValidatorSetRound{ChainId: hc.ChainId, Epoch: 1},
+		[]*types.ValidatorSetCandidate{validatorA},
+	)
+	suite.Require().ErrorIs(err, types.ErrInvalidValidatorSet)
+}
+
+// verifiedHostValidator returns a bonded host chain validator with a consensus key, as returned by the staking store
+func verifiedHostValidator(name string, tokens int64) stakingtypes.Validator {
+	validator, err := stakingtypes.NewValidator(
+		sdk.ValAddress(name),
+		ed25519.GenPrivKey().PubKey(),
+		stakingtypes.Description{},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = sdk.NewInt(tokens)
+	validator.DelegatorShares = sdk.NewDec(tokens)
+	return validator
+}
+
+func (suite *IntegrationTestSuite) TestValidatorSetRound() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	hc.Flags = &types.HostChainFlags{}
+	hc.ValidatorSetConfig = &types.ValidatorSetConfig{Enabled: true, MaxValidators: 2}
+	k.SetHostChain(ctx, hc)
+
+	epoch := k.GetEpochNumber(ctx, types.DelegationEpoch)
+	suite.Require().NoError(k.StartValidatorSetRound(ctx, hc, epoch))
+
+	round, found := k.GetValidatorSetRound(ctx, hc.ChainId)
+	suite.Require().True(found)
+	suite.Require().Equal(&types.ValidatorSetRound{ChainId: hc.ChainId, Epoch: epoch}, round)
+
+	// the discovered validators wait for their verification
+	discovered := []stakingtypes.Validator{
+		{OperatorAddress: sdk.ValAddress("validatorA").String(), Status: stakingtypes.Bonded},
+		{OperatorAddress: sdk.ValAddress("validatorB").String(), Status: stakingtypes.Bonded},
+		{OperatorAddress: sdk.ValAddress("validatorC").String(), Status: stakingtypes.Bonded},
+		{OperatorAddress: sdk.ValAddress("validatorD").String(), Status: stakingtypes.Unbonded},
+	}
+	suite.Require().NoError(k.ProcessHostChainValidatorSetPage(ctx, hc, discovered[:2], []byte("next")))
+	round, _ = k.GetValidatorSetRound(ctx, hc.ChainId)
+	suite.Require().False(round.DiscoveryDone)
+
+	suite.Require().NoError(k.ProcessHostChainValidatorSetPage(ctx, hc, discovered[1:], nil))
+	round, _ = k.GetValidatorSetRound(ctx, hc.ChainId)
+	suite.Require().True(round.DiscoveryDone)
+	suite.Require().Equal(uint64(3), round.Candidates)
+	suite.Require().Equal(uint64(0), round.Verified)
+	suite.Require().Len(k.GetValidatorSetCandidates(ctx, hc.ChainId), 3)
+
+	// a candidate the proof shows doesn't exist is dropped
+	suite.Require().NoError(k.ProcessValidatorSetCandidate(ctx, hc, sdk.ValAddress("validatorC"), nil))
+	round, _ = k.GetValidatorSetRound(ctx, hc.ChainId)
+	suite.Require().Equal(uint64(2), round.Candidates)
+	_, found = k.GetValidatorSetCandidate(ctx, hc.ChainId, sdk.ValAddress("validatorC"))
+	suite.Require().False(found)
+
+	// a verified record which doesn't match the queried validator is rejected
+	validatorA := verifiedHostValidator("validatorA", 100)
+	validatorB := verifiedHostValidator("validatorB", 300)
+	suite.Require().Error(k.ProcessValidatorSetCandidate(ctx, hc, sdk.ValAddress("validatorB"), &validatorA))
+
+	for _, validator := range []stakingtypes.Validator{validatorA, validatorB} {
+		_, valAddr, err := bech32.DecodeAndConvert(validator.OperatorAddress)
+		suite.Require().NoError(err)
+		suite.Require().NoError(k.ProcessValidatorSetCandidate(ctx, hc, valAddr, &validator))
+
+		candidate, found := k.GetValidatorSetCandidate(ctx, hc.ChainId, valAddr)
+		suite.Require().True(found)
+		suite.Require().True(candidate.ValidatorVerified)
+		suite.Require().False(candidate.SigningInfoVerified)
+		suite.Require().Equal(validator.Tokens, candidate.Tokens)
+	}
+
+	for i, validator := range []stakingtypes.Validator{validatorA, validatorB} {
+		consAddr, err := validator.GetConsAddr()
+		suite.Require().NoError(err)
+		suite.Require().NoError(k.ProcessValidatorSetCandidateSigningInfo(
+			ctx,
+			hc,
+			consAddr,
+			&slashingtypes.ValidatorSigningInfo{MissedBlocksCounter: int64(5 * (1 - i))},
+		))
+	}
+	round, _ = k.GetValidatorSetRound(ctx, hc.ChainId)
+	suite.Require().Equal(uint64(2), round.Verified)
+
+	// nothing is selected until the signed blocks window is known
+	_, found = k.GetValidatorSetProposal(ctx, hc.ChainId)
+	suite.Require().False(found)
+
+	suite.Require().NoError(k.ProcessValidatorSetSignedBlocksWindow(ctx, hc, 100))
+	proposal, found := k.GetValidatorSetProposal(ctx, hc.ChainId)
+	suite.Require().True(found)
+	suite.Require().Equal(epoch, proposal.Epoch)
+
+	// validatorA scores 0.75 * 0.95 and validatorB 0.25 * 1
+	suite.Require().Len(proposal.Validators, 2)
+	suite.Require().Equal(validatorA.OperatorAddress, proposal.Validators[0].OperatorAddress)
+	suite.Require().Equal(decFromStr("0.7125"), proposal.Validators[0].Score)
+	suite.Require().Equal(decFromStr("0.740259740259740260"), proposal.Validators[0].Weight)
+	suite.Require().Equal(validatorB.OperatorAddress, proposal.Validators[1].OperatorAddress)
+	suite.Require().Equal(decFromStr("0.25"), proposal.Validators[1].Score)
+	suite.Require().Equal(decFromStr("0.259740259740259740"), proposal.Validators[1].Weight)
+
+	// the round and its candidates are removed once the validator set is selected
+	_, found = k.GetValidatorSetRound(ctx, hc.ChainId)
+	suite.Require().False(found)
+	suite.Require().Empty(k.GetValidatorSetCandidates(ctx, hc.ChainId))
+
+	// late responses of the removed round are ignored
+	suite.Require().NoError(k.ProcessValidatorSetSignedBlocksWindow(ctx, hc, 100))
+	_, found = k.GetValidatorSetRound(ctx, hc.ChainId)
+	suite.Require().False(found)
+}
+
+func (suite *IntegrationTestSuite) TestApplyValidatorSetProposal() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	epoch := suite.setDelegationEpoch(10)
+	registered := hc.Validators[0].OperatorAddress
+	newValidator := sdk.ValAddress("validatorA").String()
+	proposal := &types.ValidatorSetProposal{
+		ChainId: hc.ChainId,
+		Validators: []*types.ValidatorScore{
+			{OperatorAddress: registered, Score: sdk.OneDec(), Weight: decFromStr("0.7")},
+			{OperatorAddress: newValidator, Score: sdk.OneDec(), Weight: decFromStr("0.3")},
+		},
+	}
+
+	// proposals from a future epoch or older than the max age are rejected
+	proposal.Epoch = epoch + 1
+	suite.Require().ErrorIs(k.ApplyValidatorSetProposal(suite.ctx, hc, proposal), types.ErrInvalidValidatorSet)
+	proposal.Epoch = epoch - types.MaxValidatorSetProposalAge - 1
+	suite.Require().ErrorIs(k.ApplyValidatorSetProposal(suite.ctx, hc, proposal), types.ErrInvalidValidatorSet)
+
+	proposal.Epoch = epoch - types.MaxValidatorSetProposalAge
+	k.SetValidatorSetProposal(suite.ctx, proposal)
+	suite.Require().NoError(k.ApplyValidatorSetProposal(suite.ctx, hc, proposal))
+
+	hc, _ = k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	for _, validator := range hc.Validators {
+		switch validator.OperatorAddress {
+		case registered:
+			suite.Require().Equal(decFromStr("0.7"), validator.Weight)
+		case newValidator:
+			suite.Require().Equal(decFromStr("0.3"), validator.Weight)
+			suite.Require().True(validator.Delegable)
+		default:
+			suite.Require().True(validator.Weight.IsZero())
+		}
+	}
+	_, found = hc.GetValidator(newValidator)
+	suite.Require().True(found)
+
+	// the applied proposal is removed
+	_, found = k.GetValidatorSetProposal(suite.ctx, hc.ChainId)
+	suite.Require().False(found)
+}
@@ -13,8 +13,18 @@ import (
 
 func (k *Keeper) SetValidatorUnbonding(ctx sdk.Context, vu *types.ValidatorUnbonding) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorUnbondingKey)
+	storeKey := types.GetValidatorUnbondingStoreKey(vu.ChainId, vu.ValidatorAddress, vu.EpochNumber)
+
+	// remove the index entry of the previous version of the validator unbonding
+	if bz := store.Get(storeKey); bz != nil {
+		previous := types.ValidatorUnbonding{}
+		k.cdc.MustUnmarshal(bz, &previous)
+		k.deleteSequenceIndex(ctx, types.ValidatorUnbondingSequenceIndexKey, previous.IbcSequenceId, storeKey)
+	}
+
 	bytes := k.cdc.MustMarshal(vu)
-	store.Set(types.GetValidatorUnbondingStoreKey(vu.ChainId, vu.ValidatorAddress, vu.EpochNumber), bytes)
+	store.Set(storeKey, bytes)
+	k.setSequenceIndex(ctx, types.ValidatorUnbondingSequenceIndexKey, vu.IbcSequenceId, storeKey)
 }
 
 func (k *Keeper) GetValidatorUnbonding(
@@ -35,16 +45,11 @@ func (k *Keeper) GetValidatorUnbonding(
 }
 
 func (k *Keeper) GetAllValidatorUnbondedAmount(ctx sdk.Context, hc *types.HostChain) math.Int {
-	validatorUnbondings := k.FilterValidatorUnbondings(
-		ctx,
-		func(u types.ValidatorUnbonding) bool {
-			return u.ChainId == hc.ChainId && u.MatureTime != time.Time{}
-		},
-	)
-
 	amount := sdk.ZeroInt()
-	for _, validatorUnbonding := range validatorUnbondings {
-		amount = amount.Add(validatorUnbonding.Amount.Amount)
+	for _, validatorUnbonding := range k.GetValidatorUnbondingsForHostChain(ctx, hc.ChainId) {
+		if validatorUnbonding.MatureTime != (time.Time{}) {
+			amount = amount.Add(validatorUnbonding.Amount.Amount)
+		}
 	}
 
 	return amount
@@ -52,19 +57,48 @@ func (k *Keeper) GetAllValidatorUnbondedAmount(ctx sdk.Context, hc *types.HostCh
 
 func (k *Keeper) DeleteValidatorUnbonding(ctx sdk.Context, ub *types.ValidatorUnbonding) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorUnbondingKey)
-	store.Delete(types.GetValidatorUnbondingStoreKey(ub.ChainId, ub.ValidatorAddress, ub.EpochNumber))
+	storeKey := types.GetValidatorUnbondingStoreKey(ub.ChainId, ub.ValidatorAddress, ub.EpochNumber)
+
+	// the index is built from the stored validator unbonding, which might differ from the one provided
+	if bz := store.Get(storeKey); bz != nil {
+		stored := types.ValidatorUnbonding{}
+		k.cdc.MustUnmarshal(bz, &stored)
+		k.deleteSequenceIndex(ctx, types.ValidatorUnbondingSequenceIndexKey, stored.IbcSequenceId, storeKey)
+	}
+
+	store.Delete(storeKey)
 
 	telemetry.IncrCounter(float32(-1), ub.ChainId, "validator_unbondings")
 }
 
-func (k *Keeper) DeleteValidatorUnbondingsForSequenceID(ctx sdk.Context, sequenceID string) {
-	validatorUnbondings := k.FilterValidatorUnbondings(
+// GetValidatorUnbondingsWithSequenceID returns the validator unbondings associated with an ibc sequence id
+// using the sequence index
+func (k *Keeper) GetValidatorUnbondingsWithSequenceID(ctx sdk.Context, sequenceID string) []*types.ValidatorUnbonding {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorUnbondingKey)
+	storeKeys := k.getIndexedStoreKeys(
 		ctx,
-		func(u types.ValidatorUnbonding) bool {
-			return u.IbcSequenceId == sequenceID
-		},
+		types.ValidatorUnbondingSequenceIndexKey,
+		types.GetSequenceIndexPrefix(sequenceID),
 	)
 
+	validatorUnbondings := make([]*types.ValidatorUnbonding, 0, len(storeKeys))
+	for _, storeKey := range storeKeys {
+		bz := store.Get(storeKey)
+		if bz == nil {
+			continue
+		}
+
+		validatorUnbonding := &types.ValidatorUnbonding{}
+		k.cdc.MustUnmarshal(bz, validatorUnbonding)
+		validatorUnbondings = append(validatorUnbondings, validatorUnbonding)
+	}
+
+	return validatorUnbondings
+}
+
+func (k *Keeper) DeleteValidatorUnbondingsForSequenceID(ctx sdk.Context, sequenceID string) {
+	validatorUnbondings := k.GetValidatorUnbondingsWithSequenceID(ctx, sequenceID)
+
 	for _, validatorUnbonding := range validatorUnbondings {
 		k.DeleteValidatorUnbonding(ctx, validatorUnbonding)
 	}
@@ -89,3 +123,23 @@ func (k *Keeper) FilterValidatorUnbondings(
 
 	return validatorUnbondings
 }
+
+// GetValidatorUnbondingsForHostChain returns all the validator unbondings of a host chain iterating over its store
+// key prefix
+func (k *Keeper) GetValidatorUnbondingsForHostChain(ctx sdk.Context, chainID string) []*types.ValidatorUnbonding {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ValidatorUnbondingKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(chainID))
+	defer iterator.Close()
+
+	validatorUnbondings := make([]*types.ValidatorUnbonding, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		validatorUnbonding := types.ValidatorUnbonding{}
+		k.cdc.MustUnmarshal(iterator.Value(), &validatorUnbonding)
+		// the prefix is shared with the chain ids it is a prefix of
+		if validatorUnbonding.ChainId == chainID {
+			validatorUnbondings = append(validatorUnbondings, &validatorUnbonding)
+		}
+	}
+
+	return validatorUnbondings
+}
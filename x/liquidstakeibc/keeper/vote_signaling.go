@@ -0,0 +1,329 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetVoteSignaling(ctx sdk.Context, signaling *types.VoteSignaling) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteSignalingKey)
+
+	// keep the end time and closed indexes in sync with the signaling
+	if previous, found := k.GetVoteSignaling(ctx, signaling.ChainId, signaling.ProposalId); found {
+		k.deleteVoteSignalingIndexes(ctx, previous)
+	}
+
+	bytes := k.cdc.MustMarshal(signaling)
+	store.Set(types.GetHostChainVoteStoreKey(signaling.ChainId, signaling.ProposalId), bytes)
+	k.setVoteSignalingIndexes(ctx, signaling)
+}
+
+// DeleteVoteSignaling removes a vote signaling and its index entries, its signals are not removed
+func (k *Keeper) DeleteVoteSignaling(ctx sdk.Context, chainID string, proposalID uint64) {
+	signaling, found := k.GetVoteSignaling(ctx, chainID, proposalID)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteSignalingKey)
+	store.Delete(types.GetHostChainVoteStoreKey(chainID, proposalID))
+	k.deleteVoteSignalingIndexes(ctx, signaling)
+}
+
+// setVoteSignalingIndexes indexes the signalings not tallied yet by their end time, and the closed ones to be pruned
+func (k *Keeper) setVoteSignalingIndexes(ctx sdk.Context, signaling *types.VoteSignaling) {
+	if !signaling.Tallied {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteSignalingEndTimeIndexKey)
+		store.Set(
+			types.GetVoteSignalingEndTimeIndexKey(signaling.ChainId, signaling.EndTime, signaling.ProposalId),
+			[]byte{},
+		)
+	}
+	if signaling.Closed {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteSignalingClosedIndexKey)
+		store.Set(types.GetHostChainVoteStoreKey(signaling.ChainId, signaling.ProposalId), []byte{})
+	}
+}
+
+func (k *Keeper) deleteVoteSignalingIndexes(ctx sdk.Context, signaling *types.VoteSignaling) {
+	endTimeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteSignalingEndTimeIndexKey)
+	endTimeStore.Delete(types.GetVoteSignalingEndTimeIndexKey(signaling.ChainId, signaling.EndTime, signaling.ProposalId))
+
+	closedStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteSignalingClosedIndexKey)
+	closedStore.Delete(types.GetHostChainVoteStoreKey(signaling.ChainId, signaling.ProposalId))
+}
+
+func (k *Keeper) GetVoteSignaling(ctx sdk.Context, chainID string, proposalID uint64) (*types.VoteSignaling, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteSignalingKey)
+	bz := store.Get(types.GetHostChainVoteStoreKey(chainID, proposalID))
+	if bz == nil {
+		return &types.VoteSignaling{}, false
+	}
+
+	var signaling types.VoteSignaling
+	k.cdc.MustUnmarshal(bz, &signaling)
+	return &signaling, true
+}
+
+func (k *Keeper) FilterVoteSignalings(
+	ctx sdk.Context,
+	filter func(s types.VoteSignaling) bool,
+) []*types.VoteSignaling {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteSignalingKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	signalings := make([]*types.VoteSignaling, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		signaling := types.VoteSignaling{}
+		k.cdc.MustUnmarshal(iterator.Value(), &signaling)
+		if filter(signaling) {
+			signalings = append(signalings, &signaling)
+		}
+	}
+
+	return signalings
+}
+
+func (k *Keeper) SetVoteSignal(ctx sdk.Context, signal *types.VoteSignal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteSignalKey)
+	bytes := k.cdc.MustMarshal(signal)
+	store.Set(types.GetVoteSignalStoreKey(signal.ChainId, signal.ProposalId, signal.Signaler), bytes)
+}
+
+func (k *Keeper) DeleteVoteSignal(ctx sdk.Context, signal *types.VoteSignal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteSignalKey)
+	store.Delete(types.GetVoteSignalStoreKey(signal.ChainId, signal.ProposalId, signal.Signaler))
+}
+
+func (k *Keeper) GetVoteSignal(
+	ctx sdk.Context,
+	chainID string,
+	proposalID uint64,
+	signaler string,
+) (*types.VoteSignal, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteSignalKey)
+	bz := store.Get(types.GetVoteSignalStoreKey(chainID, proposalID, signaler))
+	if bz == nil {
+		return &types.VoteSignal{}, false
+	}
+
+	var signal types.VoteSignal
+	k.cdc.MustUnmarshal(bz, &signal)
+	return &signal, true
+}
+
+// GetVoteSignals returns all the signals of a proposal of a host chain
+func (k *Keeper) GetVoteSignals(ctx sdk.Context, chainID string, proposalID uint64) []*types.VoteSignal {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.VoteSignalKey, types.GetVoteSignalPrefix(chainID, proposalID)...),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	signals := make([]*types.VoteSignal, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		signal := types.VoteSignal{}
+		k.cdc.MustUnmarshal(iterator.Value(), &signal)
+		signals = append(signals, &signal)
+	}
+
+	return signals
+}
+
+func (k *Keeper) GetAllVoteSignals(ctx sdk.Context) []*types.VoteSignal {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteSignalKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	signals := make([]*types.VoteSignal, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		signal := types.VoteSignal{}
+		k.cdc.MustUnmarshal(iterator.Value(), &signal)
+		signals = append(signals, &signal)
+	}
+
+	return signals
+}
+
+// DoTallyVoteSignalings prunes a bounded batch of the closed signalings, and votes on the host chain the tally of a
+// bounded batch of the signalings that have ended. Each signaling is tallied once, if its vote can't be sent it is
+// closed without voting.
+func (k *Keeper) DoTallyVoteSignalings(ctx sdk.Context, hc *types.HostChain) {
+	k.pruneVoteSignalings(ctx, hc)
+
+	// the end time index is sorted by end time, the signalings not tallied yet leave it once tallied
+	tallied := 0
+	for _, storeKey := range k.getIndexedStoreKeysBatch(
+		ctx,
+		hc.ChainId,
+		types.WorkflowTallyVoteSignalings,
+		types.VoteSignalingEndTimeIndexKey,
+		types.GetVoteSignalingChainPrefix(hc.ChainId),
+	) {
+		endTime, err := sdk.ParseTimeBytes(storeKey[:len(storeKey)-8])
+		if err != nil || endTime.After(ctx.BlockTime()) {
+			break
+		}
+
+		signaling, found := k.GetVoteSignaling(ctx, hc.ChainId, binary.BigEndian.Uint64(storeKey[len(storeKey)-8:]))
+		if !found {
+			continue
+		}
+
+		k.tallyVoteSignaling(ctx, hc, signaling)
+		tallied++
+	}
+
+	setWorkflowGauge(hc.ChainId, types.WorkflowTallyVoteSignalings, tallied)
+}
+
+// tallyVoteSignaling votes the tally of an ended signaling. The signals are kept until the vote is acknowledged, a
+// signaling without power or whose vote can't be sent is closed right away.
+func (k *Keeper) tallyVoteSignaling(ctx sdk.Context, hc *types.HostChain, signaling *types.VoteSignaling) {
+	options := signaling.CurrentTally()
+
+	signaling.Tallied = true
+	signaling.Tally = options
+	if len(options) > 0 {
+		if err := k.VoteOnHostChainProposal(ctx, hc, signaling.ProposalId, options); err != nil {
+			k.Logger(ctx).Error(
+				"could not vote the vote signaling tally, closing the signaling",
+				"host_chain",
+				hc.ChainId,
+				"proposal",
+				signaling.ProposalId,
+				"error",
+				err,
+			)
+			signaling.Tally = []govv1beta1.WeightedVoteOption{}
+			signaling.Closed = true
+		} else {
+			vote, _ := k.GetHostChainVote(ctx, hc.ChainId, signaling.ProposalId)
+			signaling.IbcSequenceId = vote.IbcSequenceId
+		}
+	} else {
+		k.Logger(ctx).Info(
+			"Vote signaling ended without voting power, not voting",
+			"host_chain",
+			hc.ChainId,
+			"proposal",
+			signaling.ProposalId,
+		)
+		signaling.Closed = true
+	}
+	k.SetVoteSignaling(ctx, signaling)
+
+	totalPower := signaling.TotalPower
+	if totalPower.IsNil() {
+		totalPower = sdk.ZeroInt()
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoteSignaling,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeProposalID, fmt.Sprintf("%d", signaling.ProposalId)),
+			sdk.NewAttribute(types.AttributeVoteOptions, govv1beta1.WeightedVoteOptions(signaling.Tally).String()),
+			sdk.NewAttribute(types.AttributeAmount, totalPower.String()),
+		),
+	)
+}
+
+// CloseVoteSignalingForSequenceID closes the tallied signaling of a host chain proposal once the ICA transaction
+// voting its tally has been acknowledged or has failed, so it is pruned with its signals
+func (k *Keeper) CloseVoteSignalingForSequenceID(ctx sdk.Context, chainID string, proposalID uint64, sequenceID string) {
+	signaling, found := k.GetVoteSignaling(ctx, chainID, proposalID)
+	if !found || !signaling.Tallied || signaling.Closed || signaling.IbcSequenceId != sequenceID {
+		return
+	}
+
+	signaling.Closed = true
+	k.SetVoteSignaling(ctx, signaling)
+}
+
+// pruneVoteSignalings deletes up to MaxRecordsPerBlock signals of the closed signalings of a host chain, returning
+// their escrowed stk to the signalers, and each closed signaling once all its signals are gone
+func (k *Keeper) pruneVoteSignalings(ctx sdk.Context, hc *types.HostChain) {
+	chainID := hc.ChainId
+	limit := k.GetParams(ctx).MaxRecordsPerBlock
+
+	deleted := uint64(0)
+	for _, storeKey := range k.getIndexedStoreKeysBatch(
+		ctx,
+		chainID,
+		types.WorkflowPruneVoteSignalings,
+		types.VoteSignalingClosedIndexKey,
+		types.GetVoteSignalingChainPrefix(chainID),
+	) {
+		proposalID := binary.BigEndian.Uint64(storeKey)
+
+		signalStore := prefix.NewStore(
+			ctx.KVStore(k.storeKey),
+			append(types.VoteSignalKey, types.GetVoteSignalPrefix(chainID, proposalID)...),
+		)
+		iterator := sdk.KVStorePrefixIterator(signalStore, nil)
+		signals := make([]*types.VoteSignal, 0)
+		for ; iterator.Valid(); iterator.Next() {
+			if deleted == limit {
+				break
+			}
+			signal := &types.VoteSignal{}
+			k.cdc.MustUnmarshal(iterator.Value(), signal)
+			signals = append(signals, signal)
+			deleted++
+		}
+		remaining := iterator.Valid()
+		iterator.Close()
+
+		for _, signal := range signals {
+			k.refundVoteSignal(ctx, hc, signal)
+			k.DeleteVoteSignal(ctx, signal)
+		}
+
+		if remaining {
+			telemetry.IncrCounter(float32(1), chainID, types.WorkflowPruneVoteSignalings, "budget_exhausted")
+			return
+		}
+
+		k.DeleteVoteSignaling(ctx, chainID, proposalID)
+	}
+}
+
+// refundVoteSignal returns the stk escrowed by a signal to its signaler. A failed refund is logged and leaves the stk
+// in the module account, as the signal is deleted regardless.
+func (k *Keeper) refundVoteSignal(ctx sdk.Context, hc *types.HostChain, signal *types.VoteSignal) {
+	if signal.Power.IsNil() || !signal.Power.IsPositive() {
+		return
+	}
+
+	signaler, err := sdk.AccAddressFromBech32(signal.Signaler)
+	if err == nil {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx,
+			types.ModuleName,
+			signaler,
+			sdk.NewCoins(sdk.NewCoin(hc.MintDenom(), signal.Power)),
+		)
+	}
+	if err != nil {
+		k.Logger(ctx).Error(
+			"could not refund the escrowed stk of a vote signal",
+			"host_chain",
+			hc.ChainId,
+			"proposal_id",
+			signal.ProposalId,
+			"signaler",
+			signal.Signaler,
+			"error",
+			err.Error(),
+		)
+	}
+}
@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// setVoteSignaling stores a signaling with its signals, accumulating their power as SignalHostChainVote does
+func (suite *IntegrationTestSuite) setVoteSignaling(signaling *types.VoteSignaling, signals ...*types.VoteSignal) {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	for _, signal := range signals {
+		signal.ChainId, signal.ProposalId = signaling.ChainId, signaling.ProposalId
+		signaling.AddSignal(signal)
+		k.SetVoteSignal(suite.ctx, signal)
+	}
+	k.SetVoteSignaling(suite.ctx, signaling)
+}
+
+func (suite *IntegrationTestSuite) TestDoTallyVoteSignalings() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	signalers := []string{
+		authtypes.NewModuleAddress("signaler-a").String(),
+		authtypes.NewModuleAddress("signaler-b").String(),
+	}
+
+	// an ended signaling, an open signaling and an ended signaling without power
+	suite.setVoteSignaling(
+		&types.VoteSignaling{ChainId: hc.ChainId, ProposalId: 1, EndTime: suite.ctx.BlockTime()},
+		&types.VoteSignal{
+			Signaler: signalers[0],
+			Options:  govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes),
+			Power:    sdk.NewInt(300),
+		},
+		&types.VoteSignal{
+			Signaler: signalers[1],
+			Options:  govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionNo),
+			Power:    sdk.NewInt(100),
+		},
+	)
+	suite.setVoteSignaling(
+		&types.VoteSignaling{ChainId: hc.ChainId, ProposalId: 2, EndTime: suite.ctx.BlockTime().Add(time.Hour)},
+		&types.VoteSignal{
+			Signaler: signalers[0],
+			Options:  govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionAbstain),
+			Power:    sdk.NewInt(300),
+		},
+	)
+	suite.setVoteSignaling(&types.VoteSignaling{ChainId: hc.ChainId, ProposalId: 3, EndTime: suite.ctx.BlockTime()})
+
+	k.DoTallyVoteSignalings(suite.ctx, hc)
+
+	// the ended signaling is voted with its tally weighted by the signaled stk balances, the signals are kept until
+	// the vote is acknowledged
+	signaling, found := k.GetVoteSignaling(suite.ctx, hc.ChainId, 1)
+	suite.Require().True(found)
+	suite.Require().True(signaling.Tallied)
+	suite.Require().False(signaling.Closed)
+	expectedTally := []govv1beta1.WeightedVoteOption{
+		{Option: govv1beta1.OptionYes, Weight: sdk.MustNewDecFromStr("0.75")},
+		{Option: govv1beta1.OptionNo, Weight: sdk.MustNewDecFromStr("0.25")},
+	}
+	suite.Require().Equal(expectedTally, signaling.Tally)
+	suite.Require().Len(k.GetVoteSignals(suite.ctx, hc.ChainId, 1), 2)
+
+	vote, found := k.GetHostChainVote(suite.ctx, hc.ChainId, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(expectedTally, vote.Options)
+	suite.Require().Equal(types.HostChainVote_VOTE_INITIATED, vote.State)
+	suite.Require().Equal(vote.IbcSequenceId, signaling.IbcSequenceId)
+
+	// the open signaling is untouched
+	signaling, _ = k.GetVoteSignaling(suite.ctx, hc.ChainId, 2)
+	suite.Require().False(signaling.Tallied)
+	suite.Require().Len(k.GetVoteSignals(suite.ctx, hc.ChainId, 2), 1)
+
+	// the signaling without power is closed without voting
+	signaling, _ = k.GetVoteSignaling(suite.ctx, hc.ChainId, 3)
+	suite.Require().True(signaling.Tallied)
+	suite.Require().True(signaling.Closed)
+	suite.Require().Empty(signaling.Tally)
+	_, found = k.GetHostChainVote(suite.ctx, hc.ChainId, 3)
+	suite.Require().False(found)
+
+	// an acknowledgement of another transaction doesn't close the signaling
+	k.CloseVoteSignalingForSequenceID(suite.ctx, hc.ChainId, 1, "channel-0-sequence-999")
+	signaling, _ = k.GetVoteSignaling(suite.ctx, hc.ChainId, 1)
+	suite.Require().False(signaling.Closed)
+
+	k.CloseVoteSignalingForSequenceID(suite.ctx, hc.ChainId, 1, vote.IbcSequenceId)
+
+	// the next block prunes the closed signalings with their signals, and doesn't vote again
+	k.DoTallyVoteSignalings(suite.ctx, hc)
+
+	_, found = k.GetVoteSignaling(suite.ctx, hc.ChainId, 1)
+	suite.Require().False(found)
+	suite.Require().Empty(k.GetVoteSignals(suite.ctx, hc.ChainId, 1))
+	_, found = k.GetVoteSignaling(suite.ctx, hc.ChainId, 3)
+	suite.Require().False(found)
+	_, found = k.GetVoteSignaling(suite.ctx, hc.ChainId, 2)
+	suite.Require().True(found)
+}
+
+func (suite *IntegrationTestSuite) TestDoTallyVoteSignalingsNoRetry() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	suite.setVoteSignaling(
+		&types.VoteSignaling{ChainId: hc.ChainId, ProposalId: 1, EndTime: suite.ctx.BlockTime()},
+		&types.VoteSignal{
+			Signaler: authtypes.NewModuleAddress("signaler").String(),
+			Options:  govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes),
+			Power:    sdk.NewInt(100),
+		},
+	)
+
+	// the vote can't be sent, the signaling is closed instead of being retried every block
+	hc.DelegationAccount.ChannelState = types.ICAAccount_ICA_CHANNEL_CREATING
+	k.DoTallyVoteSignalings(suite.ctx, hc)
+
+	signaling, _ := k.GetVoteSignaling(suite.ctx, hc.ChainId, 1)
+	suite.Require().True(signaling.Tallied)
+	suite.Require().True(signaling.Closed)
+	suite.Require().Empty(signaling.Tally)
+	_, found = k.GetHostChainVote(suite.ctx, hc.ChainId, 1)
+	suite.Require().False(found)
+
+	hc.DelegationAccount.ChannelState = types.ICAAccount_ICA_CHANNEL_CREATED
+	k.DoTallyVoteSignalings(suite.ctx, hc)
+
+	_, found = k.GetVoteSignaling(suite.ctx, hc.ChainId, 1)
+	suite.Require().False(found)
+	_, found = k.GetHostChainVote(suite.ctx, hc.ChainId, 1)
+	suite.Require().False(found)
+}
+
+func (suite *IntegrationTestSuite) TestDoTallyVoteSignalingsBudget() {
+	k := suite.app.LiquidStakeIBCKeeper
+
+	hc, found := k.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	params := k.GetParams(suite.ctx)
+	params.MaxRecordsPerBlock = 2
+	k.SetParams(suite.ctx, params)
+
+	// three ended signalings, only two are tallied per block
+	for proposalID := uint64(1); proposalID <= 3; proposalID++ {
+		suite.setVoteSignaling(&types.VoteSignaling{
+			ChainId:    hc.ChainId,
+			ProposalId: proposalID,
+			EndTime:    suite.ctx.BlockTime().Add(-time.Duration(proposalID) * time.Minute),
+		})
+	}
+
+	k.DoTallyVoteSignalings(suite.ctx, hc)
+
+	// the earliest ended signalings go first
+	tallied := make(map[uint64]bool)
+	for proposalID := uint64(1); proposalID <= 3; proposalID++ {
+		signaling, _ := k.GetVoteSignaling(suite.ctx, hc.ChainId, proposalID)
+		tallied[proposalID] = signaling.Tallied
+	}
+	suite.Require().Equal(map[uint64]bool{1: false, 2: true, 3: true}, tallied)
+
+	// the next block prunes the closed signalings and tallies the one left
+	k.DoTallyVoteSignalings(suite.ctx, hc)
+	for _, proposalID := range []uint64{2, 3} {
+		_, found = k.GetVoteSignaling(suite.ctx, hc.ChainId, proposalID)
+		suite.Require().False(found)
+	}
+	signaling, _ := k.GetVoteSignaling(suite.ctx, hc.ChainId, 1)
+	suite.Require().True(signaling.Tallied)
+
+	// a closed signaling with more signals than the budget is pruned over several blocks
+	signals := make([]*types.VoteSignal, 0)
+	for _, name := range []string{"signaler-a", "signaler-b", "signaler-c"} {
+		signals = append(signals, &types.VoteSignal{
+			Signaler: authtypes.NewModuleAddress(name).String(),
+			Options:  govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes),
+			Power:    sdk.NewInt(100),
+		})
+	}
+	suite.setVoteSignaling(
+		&types.VoteSignaling{
+			ChainId:    hc.ChainId,
+			ProposalId: 4,
+			EndTime:    suite.ctx.BlockTime(),
+			Tallied:    true,
+			Closed:     true,
+		},
+		signals...,
+	)
+
+	k.DoTallyVoteSignalings(suite.ctx, hc)
+	suite.Require().Len(k.GetVoteSignals(suite.ctx, hc.ChainId, 4), 1)
+	_, found = k.GetVoteSignaling(suite.ctx, hc.ChainId, 4)
+	suite.Require().True(found)
+
+	k.DoTallyVoteSignalings(suite.ctx, hc)
+	suite.Require().Empty(k.GetVoteSignals(suite.ctx, hc.ChainId, 4))
+	_, found = k.GetVoteSignaling(suite.ctx, hc.ChainId, 4)
+	suite.Require().False(found)
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// SetWorkflowCursor stores the store key where a begin block workflow of a host chain resumes
+func (k *Keeper) SetWorkflowCursor(ctx sdk.Context, chainID, workflow string, cursor []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WorkflowCursorKey)
+	store.Set(types.GetWorkflowCursorStoreKey(chainID, workflow), cursor)
+}
+
+// GetWorkflowCursor returns the store key where a begin block workflow of a host chain resumes
+func (k *Keeper) GetWorkflowCursor(ctx sdk.Context, chainID, workflow string) ([]byte, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WorkflowCursorKey)
+	cursor := store.Get(types.GetWorkflowCursorStoreKey(chainID, workflow))
+	return cursor, cursor != nil
+}
+
+// DeleteWorkflowCursor restarts a begin block workflow of a host chain from its first record
+func (k *Keeper) DeleteWorkflowCursor(ctx sdk.Context, chainID, workflow string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WorkflowCursorKey)
+	store.Delete(types.GetWorkflowCursorStoreKey(chainID, workflow))
+}
+
+// GetAllWorkflowCursors returns the cursors of the begin block workflows of all the host chains
+func (k *Keeper) GetAllWorkflowCursors(ctx sdk.Context) []*types.WorkflowCursor {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WorkflowCursorKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	cursors := make([]*types.WorkflowCursor, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the length prefixed chain id followed by the workflow
+		key := iterator.Key()
+		chainIDLength := int(key[0])
+		cursors = append(cursors, &types.WorkflowCursor{
+			ChainId:  string(key[1 : 1+chainIDLength]),
+			Workflow: string(key[1+chainIDLength:]),
+			Cursor:   iterator.Value(),
+		})
+	}
+
+	return cursors
+}
+
+// nextWorkflowBatch returns up to MaxRecordsPerBlock entries of the store under the key prefix, starting at the
+// workflow cursor of the host chain. The cursor is moved past the returned entries, or removed once the end of the
+// prefix is reached so the next block starts a new pass.
+func (k *Keeper) nextWorkflowBatch(
+	ctx sdk.Context,
+	chainID string,
+	workflow string,
+	store storetypes.KVStore,
+	keyPrefix []byte,
+) kv.Pairs {
+	limit := k.GetParams(ctx).MaxRecordsPerBlock
+	batch, _ := k.nextWorkflowBatchWithLimit(ctx, chainID, workflow, store, keyPrefix, limit)
+	return batch
+}
+
+// nextWorkflowBatchWithLimit returns up to limit entries of the store under the key prefix like nextWorkflowBatch,
+// and whether the pass reached the end of the prefix
+func (k *Keeper) nextWorkflowBatchWithLimit(
+	ctx sdk.Context,
+	chainID string,
+	workflow string,
+	store storetypes.KVStore,
+	keyPrefix []byte,
+	limit uint64,
+) (kv.Pairs, bool) {
+	start, found := k.GetWorkflowCursor(ctx, chainID, workflow)
+	if !found {
+		start = keyPrefix
+	}
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(keyPrefix))
+	defer iterator.Close()
+
+	batch := kv.Pairs{Pairs: make([]kv.Pair, 0)}
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(batch.Pairs)) == limit {
+			k.SetWorkflowCursor(ctx, chainID, workflow, iterator.Key())
+			telemetry.IncrCounter(float32(1), chainID, workflow, "budget_exhausted")
+			return batch, false
+		}
+
+		batch.Pairs = append(batch.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+	}
+
+	k.DeleteWorkflowCursor(ctx, chainID, workflow)
+	return batch, true
+}
+
+// getIndexedStoreKeysBatch returns up to MaxRecordsPerBlock store keys of the index entries under the entry prefix.
+// It is meant for the workflows that move every processed record out of the indexed state, so the records left
+// behind are picked up by the next blocks without a cursor.
+func (k *Keeper) getIndexedStoreKeysBatch(
+	ctx sdk.Context,
+	chainID string,
+	workflow string,
+	indexKey []byte,
+	entryPrefix []byte,
+) [][]byte {
+	limit := k.GetParams(ctx).MaxRecordsPerBlock
+
+	store := prefix.NewStore(prefix.NewStore(ctx.KVStore(k.storeKey), indexKey), entryPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	storeKeys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(storeKeys)) == limit {
+			telemetry.IncrCounter(float32(1), chainID, workflow, "budget_exhausted")
+			break
+		}
+
+		storeKeys = append(storeKeys, iterator.Key())
+	}
+
+	return storeKeys
+}
+
+// setWorkflowGauge reports the records a begin block workflow of a host chain processed in the block, the records
+// left for the next blocks are signalled by the budget_exhausted counter of the batch
+func setWorkflowGauge(chainID, workflow string, processed int) {
+	telemetry.ModuleSetGauge(types.ModuleName, float32(processed), chainID, workflow, "processed")
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestWorkflowCursor() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+
+	_, found := k.GetWorkflowCursor(ctx, suite.chainB.ChainID, types.WorkflowClaim)
+	suite.Require().False(found)
+
+	k.SetWorkflowCursor(ctx, suite.chainB.ChainID, types.WorkflowClaim, []byte("cursor"))
+	cursor, found := k.GetWorkflowCursor(ctx, suite.chainB.ChainID, types.WorkflowClaim)
+	suite.Require().True(found)
+	suite.Require().Equal([]byte("cursor"), cursor)
+
+	// the cursors are kept per workflow
+	_, found = k.GetWorkflowCursor(ctx, suite.chainB.ChainID, types.WorkflowMaturedUnbondings)
+	suite.Require().False(found)
+
+	k.DeleteWorkflowCursor(ctx, suite.chainB.ChainID, types.WorkflowClaim)
+	_, found = k.GetWorkflowCursor(ctx, suite.chainB.ChainID, types.WorkflowClaim)
+	suite.Require().False(found)
+}
+
+func (suite *IntegrationTestSuite) TestDoClaimWithinBudget() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	addresses := make([]sdk.AccAddress, 0)
+	for i := 0; i < int(types.DefaultMaxRecordsPerBlock)+5; i++ {
+		addresses = append(addresses, authtypes.NewModuleAddress(fmt.Sprintf("delegator-%d", i)))
+	}
+	suite.setClaimableUnbondings(hc, 100, addresses)
+
+	params := k.GetParams(ctx)
+	params.MaxRecordsPerBlock = uint64(len(suite.chainUserUnbondings(hc.ChainId)))
+	k.SetParams(ctx, params)
+
+	// everything is claimed in a single block
+	k.DoClaim(ctx, hc)
+	suite.Require().Empty(suite.chainUserUnbondings(hc.ChainId))
+	_, found = k.GetWorkflowCursor(ctx, hc.ChainId, types.WorkflowClaim)
+	suite.Require().False(found)
+}
+
+func (suite *IntegrationTestSuite) TestDoProcessMaturedValidatorUnbondingsBounded() {
+	k := suite.app.LiquidStakeIBCKeeper
+	ctx := suite.ctx
+
+	hc, found := k.GetHostChain(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+
+	params := k.GetParams(ctx)
+	params.MaxRecordsPerBlock = 2
+	k.SetParams(ctx, params)
+
+	for _, validatorUnbonding := range k.FilterValidatorUnbondings(
+		ctx,
+		func(u types.ValidatorUnbonding) bool { return u.ChainId == hc.ChainId },
+	) {
+		k.DeleteValidatorUnbonding(ctx, validatorUnbonding)
+	}
+
+	// validator unbondings that haven't matured yet
+	for i := int64(1); i <= 3; i++ {
+		k.SetValidatorUnbonding(ctx, &types.ValidatorUnbonding{
+			ChainId:          hc.ChainId,
+			ValidatorAddress: hc.Validators[0].OperatorAddress,
+			EpochNumber:      i,
+			MatureTime:       ctx.BlockTime().Add(time.Hour),
+			Amount:           sdk.NewInt64Coin(hc.HostDenom, 100),
+		})
+	}
+
+	// the first block visits two of them and resumes at the third
+	k.DoProcessMaturedUndelegations(ctx, hc)
+	cursor, found := k.GetWorkflowCursor(ctx, hc.ChainId, types.WorkflowMaturedValidatorUnbondings)
+	suite.Require().True(found)
+	suite.Require().Equal(types.GetValidatorUnbondingStoreKey(hc.ChainId, hc.Validators[0].OperatorAddress, 3), cursor)
+
+	// the second block reaches the end and starts a new pass on the next one
+	k.DoProcessMaturedUndelegations(ctx, hc)
+	_, found = k.GetWorkflowCursor(ctx, hc.ChainId, types.WorkflowMaturedValidatorUnbondings)
+	suite.Require().False(found)
+
+	// none of them was transferred
+	for i := int64(1); i <= 3; i++ {
+		validatorUnbonding, found := k.GetValidatorUnbonding(ctx, hc.ChainId, hc.Validators[0].OperatorAddress, i)
+		suite.Require().True(found)
+		suite.Require().Empty(validatorUnbonding.IbcSequenceId)
+	}
+}
@@ -0,0 +1,113 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3.
+// The migration includes:
+//
+// - Backfill the ibc sequence id and state indexes of the deposits.
+// - Backfill the ibc sequence id and state indexes of the unbondings.
+// - Backfill the ibc sequence id and state indexes of the LSM deposits.
+// - Backfill the ibc sequence id index of the validator unbondings.
+// - Backfill the chain id and epoch index of the user unbondings.
+// - Set the default max records per block param, the begin block workflows can't be unbounded.
+// - Set the default update timelock epochs param, the sensitive host chain updates are timelocked by default.
+// - Set the default c value history length param, the c values are recorded by default.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	kvStore := ctx.KVStore(storeKey)
+
+	params := types.Params{}
+	if bz := kvStore.Get(types.ParamsKey); bz != nil {
+		cdc.MustUnmarshal(bz, &params)
+	}
+	if params.MaxRecordsPerBlock == 0 {
+		params.MaxRecordsPerBlock = types.DefaultMaxRecordsPerBlock
+	}
+	if params.UpdateTimelockEpochs == 0 {
+		params.UpdateTimelockEpochs = types.DefaultUpdateTimelockEpochs
+	}
+	if params.CValueHistoryLength == 0 {
+		params.CValueHistoryLength = types.DefaultCValueHistoryLength
+	}
+	kvStore.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	iterateStore(kvStore, types.DepositKey, func(key, value []byte) {
+		deposit := types.Deposit{}
+		cdc.MustUnmarshal(value, &deposit)
+
+		setSequenceIndex(kvStore, types.DepositSequenceIndexKey, deposit.IbcSequenceId, key)
+		setStateIndex(kvStore, types.DepositStateIndexKey, deposit.ChainId, int32(deposit.State), key)
+	})
+
+	iterateStore(kvStore, types.UnbondingKey, func(key, value []byte) {
+		unbonding := types.Unbonding{}
+		cdc.MustUnmarshal(value, &unbonding)
+
+		setSequenceIndex(kvStore, types.UnbondingSequenceIndexKey, unbonding.IbcSequenceId, key)
+		setStateIndex(kvStore, types.UnbondingStateIndexKey, unbonding.ChainId, int32(unbonding.State), key)
+	})
+
+	iterateStore(kvStore, types.LSMDepositKey, func(key, value []byte) {
+		deposit := types.LSMDeposit{}
+		cdc.MustUnmarshal(value, &deposit)
+
+		setSequenceIndex(kvStore, types.LSMDepositSequenceIndexKey, deposit.IbcSequenceId, key)
+		setStateIndex(kvStore, types.LSMDepositStateIndexKey, deposit.ChainId, int32(deposit.State), key)
+	})
+
+	iterateStore(kvStore, types.ValidatorUnbondingKey, func(key, value []byte) {
+		validatorUnbonding := types.ValidatorUnbonding{}
+		cdc.MustUnmarshal(value, &validatorUnbonding)
+
+		setSequenceIndex(kvStore, types.ValidatorUnbondingSequenceIndexKey, validatorUnbonding.IbcSequenceId, key)
+	})
+
+	iterateStore(kvStore, types.UserUnbondingKey, func(key, value []byte) {
+		userUnbonding := types.UserUnbonding{}
+		cdc.MustUnmarshal(value, &userUnbonding)
+
+		store := prefix.NewStore(kvStore, types.UserUnbondingEpochIndexKey)
+		store.Set(types.GetEpochIndexKey(userUnbonding.ChainId, userUnbonding.EpochNumber, key), []byte{})
+	})
+
+	return nil
+}
+
+// iterateStore calls the callback with the key and value of every record under the prefix, the keys are
+// collected first so the callback can write to the store
+func iterateStore(kvStore storetypes.KVStore, keyPrefix []byte, cb func(key, value []byte)) {
+	store := prefix.NewStore(kvStore, keyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+
+	keys, values := make([][]byte, 0), make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+
+	for i := range keys {
+		cb(keys[i], values[i])
+	}
+}
+
+func setSequenceIndex(kvStore storetypes.KVStore, indexKey []byte, sequenceID string, storeKey []byte) {
+	if sequenceID == "" {
+		return
+	}
+
+	store := prefix.NewStore(kvStore, indexKey)
+	store.Set(types.GetSequenceIndexKey(sequenceID, storeKey), []byte{})
+}
+
+func setStateIndex(kvStore storetypes.KVStore, indexKey []byte, chainID string, state int32, storeKey []byte) {
+	store := prefix.NewStore(kvStore, indexKey)
+	store.Set(types.GetStateIndexKey(chainID, state, storeKey), []byte{})
+}
@@ -130,10 +130,15 @@ func (a AppModule) RegisterServices(configurator module.Configurator) {
 	if err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
 	}
+
+	err = configurator.RegisterMigration(types.ModuleName, 2, keeper.NewMigrator(a.keeper).Migrate2to3)
+	if err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
 }
 
 func (a AppModule) ConsensusVersion() uint64 {
-	return 2
+	return 3
 }
 
 // TODO simulations
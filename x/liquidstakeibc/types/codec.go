@@ -19,6 +19,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgLiquidUnstake{}, "pstake/MsgLiquidUnstake")
 	legacy.RegisterAminoMsg(cdc, &MsgRedeem{}, "pstake/MsgRedeem")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "pstake/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgVoteOnHostChainProposal{}, "pstake/MsgVoteOnHostChainProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgSignalHostChainVote{}, "pstake/MsgSignalHostChainVote")
+	legacy.RegisterAminoMsg(cdc, &MsgDeregisterHostChain{}, "pstake/MsgDeregisterHostChain")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelTimelockedUpdate{}, "pstake/MsgCancelTimelockedUpdate")
+	legacy.RegisterAminoMsg(cdc, &MsgClaim{}, "pstake/MsgClaim")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoClaim{}, "pstake/MsgSetAutoClaim")
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -30,6 +36,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgLiquidUnstake{},
 		&MsgRedeem{},
 		&MsgUpdateParams{},
+		&MsgVoteOnHostChainProposal{},
+		&MsgSignalHostChainVote{},
+		&MsgDeregisterHostChain{},
+		&MsgCancelTimelockedUpdate{},
+		&MsgClaim{},
+		&MsgSetAutoClaim{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -27,4 +27,15 @@ var (
 	ErrLSMNotEnabled            = errorsmod.Register(ModuleName, 2019, "host chain has LSM staking disabled")
 	ErrLSMDepositProcessing     = errorsmod.Register(ModuleName, 2020, "already processing LSM deposit")
 	ErrLSMValidatorInvalidState = errorsmod.Register(ModuleName, 2021, "validator invalid state")
+	ErrRedelegationInProgress   = errorsmod.Register(ModuleName, 2022, "redelegation already in progress")
+	ErrLiquidStakeCapExceeded   = errorsmod.Register(ModuleName, 2023, "liquid stake cap exceeded")
+	ErrUnstakeCapExceeded       = errorsmod.Register(ModuleName, 2024, "liquid unstake cap exceeded")
+	ErrRedeemCapExceeded        = errorsmod.Register(ModuleName, 2025, "instant redeem cap exceeded")
+	ErrInvalidValidatorSet      = errorsmod.Register(ModuleName, 2026, "invalid validator set")
+	ErrInvalidVote              = errorsmod.Register(ModuleName, 2027, "invalid host chain vote")
+	ErrVoteSignalingClosed      = errorsmod.Register(ModuleName, 2028, "host chain vote signaling is not open")
+	ErrHostChainDeregistering   = errorsmod.Register(ModuleName, 2029, "host chain is being deregistered")
+	ErrOperationPaused          = errorsmod.Register(ModuleName, 2030, "host chain operation is paused")
+	ErrCValueCooldown           = errorsmod.Register(ModuleName, 2031, "host chain c value circuit breaker is in cooldown")
+	ErrNothingToClaim           = errorsmod.Register(ModuleName, 2032, "no claimable unbondings")
 )
@@ -1,15 +1,27 @@
 package types
 
 const (
-	EventTypeLiquidStake    = "liquid-stake"
-	EventTypeLiquidStakeLSM = "liquid-stake-lsm"
-	EventTypeLiquidUnstake  = "liquid-unstake"
-	EventTypeRedeem         = "redeem"
-	EventTypePacket         = "ics27_packet"
-	EventTypeTimeout        = "timeout"
-	EventTypeSlashing       = "slashing"
-	EventTypeUpdateParams   = "update_params"
-	EventTypeChainDisabled  = "chain_disabled"
+	EventTypeLiquidStake                 = "liquid-stake"
+	EventTypeLiquidStakeLSM              = "liquid-stake-lsm"
+	EventTypeLiquidUnstake               = "liquid-unstake"
+	EventTypeRedeem                      = "redeem"
+	EventTypePacket                      = "ics27_packet"
+	EventTypeTimeout                     = "timeout"
+	EventTypeSlashing                    = "slashing"
+	EventTypeUpdateParams                = "update_params"
+	EventTypeChainDisabled               = "chain_disabled"
+	EventTypeChainRecovered              = "chain_recovered"
+	EventTypeRedelegation                = "redelegation"
+	EventTypeValidatorSet                = "validator-set-proposal"
+	EventTypeValidatorCommissionExceeded = "validator-commission-exceeded"
+	EventTypeHostChainVote               = "host-chain-vote"
+	EventTypeVoteSignal                  = "vote-signal"
+	EventTypeVoteSignaling               = "vote-signaling"
+	EventTypeHostChainDeregistration     = "host-chain-deregistration"
+	EventTypeTimelockedUpdate            = "timelocked-update"
+	EventTypeTimelockEpochsUpdate        = "timelock-epochs-update"
+	EventTypeClaim                       = "claim"
+	EventTypeSetAutoClaim                = "set-auto-claim"
 
 	AttributeAmount             = "amount"
 	AttributeAmountReceived     = "received"
@@ -25,10 +37,30 @@ const (
 	AttributeExistingDelegation = "existing-delegation"
 	AttributeUpdatedDelegation  = "updated-delegation"
 	AttributeSlashedAmount      = "slashed-amount"
+	AttributeSrcValidator       = "source-validator"
+	AttributeDstValidator       = "destination-validator"
+	AttributeValidatorCount     = "validator-count"
+	AttributeApplied            = "applied"
+	AttributeCommission         = "commission"
+	AttributeMaxCommission      = "max-commission"
+	AttributeProposalID         = "proposal-id"
+	AttributeVoteOptions        = "vote-options"
+	AttributeEndTime            = "end-time"
+	AttributeState              = "state"
+	AttributeUpdateID           = "update-id"
+	AttributeExecutionEpoch     = "execution-epoch"
+	AttributeTimelockEpochs     = "update-timelock-epochs"
+	AttributeReason             = "reason"
+	AttributeRecipient          = "recipient"
+	AttributeAutoClaim          = "auto-claim"
 	AttributeKeyAuthority       = "authority"
 	AttributeKeyUpdatedParams   = "updated_params"
 	AttributeKeyAck             = "acknowledgement"
 	AttributeKeyAckSuccess      = "success"
 	AttributeKeyAckError        = "error"
 	AttributeValueCategory      = ModuleName
+	AttributeValueQueued        = "queued"
+	AttributeValueExecuted      = "executed"
+	AttributeValueFailed        = "failed"
+	AttributeValueCancelled     = "cancelled"
 )
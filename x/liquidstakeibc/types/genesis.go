@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Validate performs basic validation of supply genesis data returning an
@@ -108,6 +110,176 @@ func (gs *GenesisState) Validate() error {
 			return err
 		}
 	}
+	for _, lsmDeposit := range gs.LsmDeposits {
+		if _, ok := hostChainMap[lsmDeposit.ChainId]; !ok {
+			return fmt.Errorf("lsm deposit for chain %s doesnt have a valid chain id", lsmDeposit.ChainId)
+		}
+
+		if err := lsmDeposit.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, redelegation := range gs.Redelegations {
+		hc, ok := hostChainMap[redelegation.ChainId]
+		if !ok {
+			return fmt.Errorf("redelegation for chain %s doesnt have a valid chain id", redelegation.ChainId)
+		}
+		if redelegation.Amount.Denom != hc.HostDenom {
+			return fmt.Errorf("redelegation for chain %s doesnt have a valid amount denom", redelegation.ChainId)
+		}
+
+		if err := redelegation.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, slashRecord := range gs.SlashRecords {
+		if _, ok := hostChainMap[slashRecord.ChainId]; !ok {
+			return fmt.Errorf("slash record for chain %s doesnt have a valid chain id", slashRecord.ChainId)
+		}
+
+		if err := slashRecord.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, proposal := range gs.ValidatorSetProposals {
+		if _, ok := hostChainMap[proposal.ChainId]; !ok {
+			return fmt.Errorf("validator set proposal for chain %s doesnt have a valid chain id", proposal.ChainId)
+		}
+
+		if err := proposal.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, vote := range gs.HostChainVotes {
+		if _, ok := hostChainMap[vote.ChainId]; !ok {
+			return fmt.Errorf("host chain vote for chain %s doesnt have a valid chain id", vote.ChainId)
+		}
+
+		if err := vote.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, signaling := range gs.VoteSignalings {
+		if _, ok := hostChainMap[signaling.ChainId]; !ok {
+			return fmt.Errorf("vote signaling for chain %s doesnt have a valid chain id", signaling.ChainId)
+		}
+
+		if err := signaling.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, signal := range gs.VoteSignals {
+		if _, ok := hostChainMap[signal.ChainId]; !ok {
+			return fmt.Errorf("vote signal for chain %s doesnt have a valid chain id", signal.ChainId)
+		}
+
+		if err := signal.Validate(); err != nil {
+			return err
+		}
+	}
+	timelockedUpdateIDs := make(map[uint64]bool)
+	for _, update := range gs.TimelockedUpdates {
+		if _, ok := hostChainMap[update.ChainId]; !ok {
+			return fmt.Errorf("timelocked update for chain %s doesnt have a valid chain id", update.ChainId)
+		}
+		if timelockedUpdateIDs[update.Id] {
+			return fmt.Errorf("duplicated timelocked update id %d", update.Id)
+		}
+		timelockedUpdateIDs[update.Id] = true
+
+		if err := update.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, record := range gs.CValueRecords {
+		if _, ok := hostChainMap[record.ChainId]; !ok {
+			return fmt.Errorf("c value record for chain %s doesnt have a valid chain id", record.ChainId)
+		}
+
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, record := range gs.RewardRecords {
+		if _, ok := hostChainMap[record.ChainId]; !ok {
+			return fmt.Errorf("reward record for chain %s doesnt have a valid chain id", record.ChainId)
+		}
+
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, address := range gs.AutoClaimOptOuts {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid auto claim opt out address %s: %w", address, err)
+		}
+	}
+	for _, inflow := range gs.Inflows {
+		if _, ok := hostChainMap[inflow.ChainId]; !ok {
+			return fmt.Errorf("inflow for chain %s doesnt have a valid chain id", inflow.ChainId)
+		}
+
+		if err := inflow.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, outflow := range gs.RedeemOutflows {
+		if _, ok := hostChainMap[outflow.ChainId]; !ok {
+			return fmt.Errorf("redeem outflow for chain %s doesnt have a valid chain id", outflow.ChainId)
+		}
+
+		if err := outflow.Validate(); err != nil {
+			return err
+		}
+	}
+	roundMap := make(map[string]bool)
+	for _, round := range gs.ValidatorSetRounds {
+		if _, ok := hostChainMap[round.ChainId]; !ok {
+			return fmt.Errorf("validator set round for chain %s doesnt have a valid chain id", round.ChainId)
+		}
+		if roundMap[round.ChainId] {
+			return fmt.Errorf("duplicated validator set round for chain %s", round.ChainId)
+		}
+		roundMap[round.ChainId] = true
+
+		if err := round.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, candidate := range gs.ValidatorSetCandidates {
+		if !roundMap[candidate.ChainId] {
+			return fmt.Errorf("validator set candidate for chain %s doesnt have a validator set round", candidate.ChainId)
+		}
+
+		if err := candidate.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, cursor := range gs.WorkflowCursors {
+		if _, ok := hostChainMap[cursor.ChainId]; !ok {
+			return fmt.Errorf("workflow cursor for chain %s doesnt have a valid chain id", cursor.ChainId)
+		}
+
+		if err := cursor.Validate(); err != nil {
+			return err
+		}
+	}
+	if gs.TimelockEpochsUpdate != nil {
+		if err := gs.TimelockEpochsUpdate.Validate(); err != nil {
+			return err
+		}
+	}
+	redemptionRecordMap := make(map[string]bool)
+	for _, record := range gs.RedemptionRecords {
+		if redemptionRecordMap[record.MintDenom] {
+			return fmt.Errorf("duplicated redemption record for denom %s", record.MintDenom)
+		}
+		redemptionRecordMap[record.MintDenom] = true
+
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -115,11 +287,28 @@ func (gs *GenesisState) Validate() error {
 // DefaultGenesisState returns a default liquidstakeibc module genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:              DefaultParams(),
-		HostChains:          []*HostChain{},
-		Deposits:            []*Deposit{},
-		Unbondings:          []*Unbonding{},
-		UserUnbondings:      []*UserUnbonding{},
-		ValidatorUnbondings: []*ValidatorUnbonding{},
+		Params:                 DefaultParams(),
+		HostChains:             []*HostChain{},
+		Deposits:               []*Deposit{},
+		Unbondings:             []*Unbonding{},
+		UserUnbondings:         []*UserUnbonding{},
+		ValidatorUnbondings:    []*ValidatorUnbonding{},
+		LsmDeposits:            []*LSMDeposit{},
+		Redelegations:          []*Redelegation{},
+		SlashRecords:           []*SlashRecord{},
+		ValidatorSetProposals:  []*ValidatorSetProposal{},
+		HostChainVotes:         []*HostChainVote{},
+		VoteSignalings:         []*VoteSignaling{},
+		VoteSignals:            []*VoteSignal{},
+		TimelockedUpdates:      []*TimelockedUpdate{},
+		CValueRecords:          []*CValueRecord{},
+		RewardRecords:          []*RewardRecord{},
+		AutoClaimOptOuts:       []string{},
+		Inflows:                []*Inflow{},
+		RedeemOutflows:         []*RedeemOutflow{},
+		ValidatorSetRounds:     []*ValidatorSetRound{},
+		ValidatorSetCandidates: []*ValidatorSetCandidate{},
+		WorkflowCursors:        []*WorkflowCursor{},
+		RedemptionRecords:      []*RedemptionRecord{},
 	}
 }
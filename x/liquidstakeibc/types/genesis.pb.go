@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -36,6 +37,41 @@ type GenesisState struct {
 	UserUnbondings []*UserUnbonding `protobuf:"bytes,5,rep,name=user_unbondings,json=userUnbondings,proto3" json:"user_unbondings,omitempty"`
 	// validator unbondings
 	ValidatorUnbondings []*ValidatorUnbonding `protobuf:"bytes,6,rep,name=validator_unbondings,json=validatorUnbondings,proto3" json:"validator_unbondings,omitempty"`
+	// initial lsm deposits
+	LsmDeposits []*LSMDeposit `protobuf:"bytes,7,rep,name=lsm_deposits,json=lsmDeposits,proto3" json:"lsm_deposits,omitempty"`
+	// initial redelegations
+	Redelegations []*Redelegation `protobuf:"bytes,8,rep,name=redelegations,proto3" json:"redelegations,omitempty"`
+	// validator slash records
+	SlashRecords []*SlashRecord `protobuf:"bytes,9,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records,omitempty"`
+	// latest validator set proposals
+	ValidatorSetProposals []*ValidatorSetProposal `protobuf:"bytes,10,rep,name=validator_set_proposals,json=validatorSetProposals,proto3" json:"validator_set_proposals,omitempty"`
+	// host chain governance votes
+	HostChainVotes []*HostChainVote `protobuf:"bytes,11,rep,name=host_chain_votes,json=hostChainVotes,proto3" json:"host_chain_votes,omitempty"`
+	// stk holder vote signalings and their signals
+	VoteSignalings []*VoteSignaling `protobuf:"bytes,12,rep,name=vote_signalings,json=voteSignalings,proto3" json:"vote_signalings,omitempty"`
+	VoteSignals    []*VoteSignal    `protobuf:"bytes,13,rep,name=vote_signals,json=voteSignals,proto3" json:"vote_signals,omitempty"`
+	// host chain updates waiting for their timelock
+	TimelockedUpdates []*TimelockedUpdate `protobuf:"bytes,14,rep,name=timelocked_updates,json=timelockedUpdates,proto3" json:"timelocked_updates,omitempty"`
+	// c value history of the host chains
+	CValueRecords []*CValueRecord `protobuf:"bytes,15,rep,name=c_value_records,json=cValueRecords,proto3" json:"c_value_records,omitempty"`
+	// autocompounded rewards of the host chains
+	RewardRecords []*RewardRecord `protobuf:"bytes,16,rep,name=reward_records,json=rewardRecords,proto3" json:"reward_records,omitempty"`
+	// addresses that opted out of the automatic claim of their unbondings
+	AutoClaimOptOuts []string `protobuf:"bytes,17,rep,name=auto_claim_opt_outs,json=autoClaimOptOuts,proto3" json:"auto_claim_opt_outs,omitempty"`
+	// liquid stake inflows of the current rate limit windows
+	Inflows []*Inflow `protobuf:"bytes,18,rep,name=inflows,proto3" json:"inflows,omitempty"`
+	// instant redeem outflows of the current rate limit windows
+	RedeemOutflows []*RedeemOutflow `protobuf:"bytes,19,rep,name=redeem_outflows,json=redeemOutflows,proto3" json:"redeem_outflows,omitempty"`
+	// validator set selection rounds in progress
+	ValidatorSetRounds []*ValidatorSetRound `protobuf:"bytes,20,rep,name=validator_set_rounds,json=validatorSetRounds,proto3" json:"validator_set_rounds,omitempty"`
+	// candidates of the validator set selection rounds in progress
+	ValidatorSetCandidates []*ValidatorSetCandidate `protobuf:"bytes,21,rep,name=validator_set_candidates,json=validatorSetCandidates,proto3" json:"validator_set_candidates,omitempty"`
+	// store keys where the begin block workflows of the host chains resume
+	WorkflowCursors []*WorkflowCursor `protobuf:"bytes,22,rep,name=workflow_cursors,json=workflowCursors,proto3" json:"workflow_cursors,omitempty"`
+	// lowering of the update timelock waiting for the current timelock, if any
+	TimelockEpochsUpdate *TimelockEpochsUpdate `protobuf:"bytes,23,opt,name=timelock_epochs_update,json=timelockEpochsUpdate,proto3" json:"timelock_epochs_update,omitempty"`
+	// redemption records of the deregistered host chains
+	RedemptionRecords []*RedemptionRecord `protobuf:"bytes,24,rep,name=redemption_records,json=redemptionRecords,proto3" json:"redemption_records,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -113,6 +149,132 @@ func (m *GenesisState) GetValidatorUnbondings() []*ValidatorUnbonding {
 	return nil
 }
 
+func (m *GenesisState) GetLsmDeposits() []*LSMDeposit {
+	if m != nil {
+		return m.LsmDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedelegations() []*Redelegation {
+	if m != nil {
+		return m.Redelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashRecords() []*SlashRecord {
+	if m != nil {
+		return m.SlashRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorSetProposals() []*ValidatorSetProposal {
+	if m != nil {
+		return m.ValidatorSetProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHostChainVotes() []*HostChainVote {
+	if m != nil {
+		return m.HostChainVotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoteSignalings() []*VoteSignaling {
+	if m != nil {
+		return m.VoteSignalings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoteSignals() []*VoteSignal {
+	if m != nil {
+		return m.VoteSignals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimelockedUpdates() []*TimelockedUpdate {
+	if m != nil {
+		return m.TimelockedUpdates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCValueRecords() []*CValueRecord {
+	if m != nil {
+		return m.CValueRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardRecords() []*RewardRecord {
+	if m != nil {
+		return m.RewardRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoClaimOptOuts() []string {
+	if m != nil {
+		return m.AutoClaimOptOuts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInflows() []*Inflow {
+	if m != nil {
+		return m.Inflows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedeemOutflows() []*RedeemOutflow {
+	if m != nil {
+		return m.RedeemOutflows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorSetRounds() []*ValidatorSetRound {
+	if m != nil {
+		return m.ValidatorSetRounds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorSetCandidates() []*ValidatorSetCandidate {
+	if m != nil {
+		return m.ValidatorSetCandidates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWorkflowCursors() []*WorkflowCursor {
+	if m != nil {
+		return m.WorkflowCursors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimelockEpochsUpdate() *TimelockEpochsUpdate {
+	if m != nil {
+		return m.TimelockEpochsUpdate
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedemptionRecords() []*RedemptionRecord {
+	if m != nil {
+		return m.RedemptionRecords
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "pstake.liquidstakeibc.v1beta1.GenesisState")
 }
@@ -122,31 +284,61 @@ func init() {
 }
 
 var fileDescriptor_1d650226665335af = []byte{
-	// 373 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x4b, 0xe3, 0x40,
-	0x18, 0xc7, 0x93, 0x6d, 0xb7, 0x2c, 0xd3, 0x65, 0x17, 0xb2, 0x3d, 0x84, 0xc2, 0xc6, 0x22, 0x28,
-	0xc5, 0x97, 0x0c, 0x8d, 0x9f, 0xc0, 0x56, 0xb0, 0x9e, 0x94, 0x48, 0x3d, 0xe8, 0xa1, 0x4c, 0x92,
-	0x21, 0x19, 0x6c, 0x67, 0x62, 0x9e, 0x49, 0xd0, 0x6f, 0xe1, 0xc7, 0xea, 0xb1, 0x47, 0x4f, 0x22,
-	0xed, 0xc9, 0x6f, 0x21, 0x9d, 0xb4, 0xf6, 0x45, 0x68, 0xbc, 0x3d, 0x33, 0xfc, 0x7f, 0xbf, 0xff,
-	0x73, 0x78, 0xd0, 0x61, 0x0c, 0x92, 0xdc, 0x53, 0x3c, 0x60, 0x0f, 0x29, 0x0b, 0xd4, 0xcc, 0x3c,
-	0x1f, 0x67, 0x2d, 0x8f, 0x4a, 0xd2, 0xc2, 0x21, 0xe5, 0x14, 0x18, 0xd8, 0x71, 0x22, 0xa4, 0x30,
-	0xfe, 0xe7, 0x61, 0x7b, 0x3d, 0x6c, 0xcf, 0xc3, 0xf5, 0x5a, 0x28, 0x42, 0xa1, 0x92, 0x78, 0x36,
-	0xe5, 0x50, 0xfd, 0x60, 0x7b, 0x43, 0x4c, 0x12, 0x32, 0x9c, 0x17, 0xd4, 0x9d, 0xed, 0xd9, 0x8d,
-	0x5e, 0xc5, 0xec, 0xbe, 0x97, 0xd0, 0xef, 0xf3, 0x7c, 0xcd, 0x6b, 0x49, 0x24, 0x35, 0x3a, 0xa8,
-	0x92, 0x4b, 0x4d, 0xbd, 0xa1, 0x37, 0xab, 0xce, 0x9e, 0xbd, 0x75, 0x6d, 0xfb, 0x4a, 0x85, 0xdb,
-	0xe5, 0xd1, 0xeb, 0x8e, 0xe6, 0xce, 0x51, 0xe3, 0x02, 0x55, 0x23, 0x01, 0xb2, 0xef, 0x47, 0x84,
-	0x71, 0x30, 0x7f, 0x34, 0x4a, 0xcd, 0xaa, 0xd3, 0x2c, 0x30, 0x75, 0x05, 0xc8, 0xce, 0x0c, 0x70,
-	0x51, 0xb4, 0x18, 0xc1, 0x68, 0xa3, 0x5f, 0x01, 0x8d, 0x05, 0x30, 0x09, 0x66, 0x49, 0x79, 0xf6,
-	0x0b, 0x3c, 0x67, 0x79, 0xdc, 0xfd, 0xe4, 0x8c, 0x2e, 0x42, 0x29, 0xf7, 0x04, 0x0f, 0x18, 0x0f,
-	0xc1, 0x2c, 0x7f, 0x6b, 0x9b, 0xde, 0x02, 0x70, 0x57, 0x58, 0xa3, 0x87, 0xfe, 0xa6, 0x40, 0x93,
-	0xfe, 0x8a, 0xee, 0xa7, 0xd2, 0x1d, 0x15, 0xe9, 0x80, 0x26, 0x4b, 0xe5, 0x9f, 0x74, 0xf5, 0x09,
-	0x46, 0x80, 0x6a, 0x19, 0x19, 0xb0, 0x80, 0x48, 0xb1, 0xe6, 0xae, 0x28, 0x77, 0xab, 0xc0, 0x7d,
-	0xb3, 0x40, 0x97, 0x05, 0xff, 0xb2, 0x2f, 0x7f, 0xd0, 0xbe, 0x1b, 0x4d, 0x2c, 0x7d, 0x3c, 0xb1,
-	0xf4, 0xb7, 0x89, 0xa5, 0x3f, 0x4f, 0x2d, 0x6d, 0x3c, 0xb5, 0xb4, 0x97, 0xa9, 0xa5, 0xdd, 0x9e,
-	0x86, 0x4c, 0x46, 0xa9, 0x67, 0xfb, 0x62, 0x88, 0x63, 0x9a, 0x00, 0x03, 0x49, 0xb9, 0x4f, 0x2f,
-	0x39, 0xc5, 0x79, 0xf5, 0x31, 0x27, 0x92, 0x65, 0x14, 0x67, 0x0e, 0x7e, 0xdc, 0xbc, 0x2f, 0xf9,
-	0x14, 0x53, 0xf0, 0x2a, 0xea, 0x9e, 0x4e, 0x3e, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x9d, 0xaf,
-	0x0f, 0x13, 0x03, 0x00, 0x00,
+	// 864 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xdf, 0x6e, 0x1b, 0x45,
+	0x14, 0xc6, 0x63, 0x52, 0xd2, 0x76, 0x9c, 0x38, 0xce, 0xc4, 0x4d, 0x87, 0x4a, 0x18, 0x0b, 0x09,
+	0x14, 0x5a, 0xe2, 0x25, 0x29, 0xf7, 0xa8, 0x71, 0x51, 0x5b, 0xa9, 0xc8, 0x65, 0x4c, 0x02, 0x02,
+	0x89, 0xd5, 0x78, 0x77, 0xb0, 0x47, 0xd9, 0xdd, 0x59, 0xe6, 0xcc, 0xae, 0xe1, 0x2d, 0x78, 0x0c,
+	0x1e, 0x80, 0x87, 0xe8, 0x65, 0xc5, 0x15, 0x57, 0x08, 0x25, 0x2f, 0x82, 0x66, 0xf6, 0x8f, 0xd7,
+	0x6e, 0x94, 0xdd, 0xde, 0xed, 0x39, 0x7b, 0xbe, 0xdf, 0x99, 0x99, 0xfd, 0xce, 0x68, 0xd1, 0xa3,
+	0x18, 0x34, 0xbb, 0xe0, 0x4e, 0x20, 0x7e, 0x4d, 0x84, 0x6f, 0x9f, 0xc5, 0xd4, 0x73, 0xd2, 0xe3,
+	0x29, 0xd7, 0xec, 0xd8, 0x99, 0xf1, 0x88, 0x83, 0x80, 0x61, 0xac, 0xa4, 0x96, 0xf8, 0xc3, 0xac,
+	0x78, 0xb8, 0x5a, 0x3c, 0xcc, 0x8b, 0x1f, 0xf4, 0x66, 0x72, 0x26, 0x6d, 0xa5, 0x63, 0x9e, 0x32,
+	0xd1, 0x83, 0x0f, 0x3c, 0x09, 0xa1, 0x04, 0x37, 0x7b, 0x91, 0x05, 0xf9, 0xab, 0x87, 0x37, 0x37,
+	0x8f, 0x99, 0x62, 0x61, 0x51, 0x7b, 0x72, 0x73, 0xed, 0xda, 0x92, 0xac, 0xe6, 0xe3, 0x3f, 0xbb,
+	0x68, 0xfb, 0x59, 0xb6, 0x83, 0x89, 0x66, 0x9a, 0xe3, 0x11, 0xda, 0xca, 0xa0, 0xa4, 0x35, 0x68,
+	0x1d, 0xb6, 0x4f, 0x3e, 0x19, 0xde, 0xb8, 0xa3, 0xe1, 0x2b, 0x5b, 0x7c, 0x7a, 0xeb, 0xf5, 0xbf,
+	0x1f, 0x6d, 0xd0, 0x5c, 0x8a, 0x5f, 0xa0, 0xf6, 0x5c, 0x82, 0x76, 0xbd, 0x39, 0x13, 0x11, 0x90,
+	0xf7, 0x06, 0x9b, 0x87, 0xed, 0x93, 0xc3, 0x1a, 0xd2, 0x73, 0x09, 0x7a, 0x64, 0x04, 0x14, 0xcd,
+	0x8b, 0x47, 0xc0, 0xa7, 0xe8, 0x8e, 0xcf, 0x63, 0x09, 0x42, 0x03, 0xd9, 0xb4, 0x9c, 0x4f, 0x6b,
+	0x38, 0x4f, 0xb3, 0x72, 0x5a, 0xea, 0xf0, 0x73, 0x84, 0x92, 0x68, 0x2a, 0x23, 0x5f, 0x44, 0x33,
+	0x20, 0xb7, 0x1a, 0xad, 0xe6, 0xac, 0x10, 0xd0, 0x8a, 0x16, 0x9f, 0xa1, 0xdd, 0x04, 0xb8, 0x72,
+	0x2b, 0xb8, 0xf7, 0x2d, 0xee, 0xf3, 0x3a, 0x1c, 0x70, 0xb5, 0x44, 0x76, 0x92, 0x6a, 0x08, 0xd8,
+	0x47, 0xbd, 0x94, 0x05, 0xc2, 0x67, 0x5a, 0xae, 0xb0, 0xb7, 0x2c, 0xfb, 0xb8, 0x86, 0x7d, 0x5e,
+	0x48, 0x97, 0x0d, 0xf6, 0xd3, 0xb7, 0x72, 0x80, 0x5f, 0xa2, 0xed, 0x00, 0x42, 0xb7, 0x3c, 0xce,
+	0xdb, 0x96, 0xfe, 0x59, 0x0d, 0xfd, 0xe5, 0xe4, 0x9b, 0xe2, 0x44, 0xdb, 0x01, 0x84, 0x4f, 0x8b,
+	0x43, 0xfd, 0x16, 0xed, 0x28, 0xee, 0xf3, 0x80, 0xcf, 0x98, 0x16, 0x32, 0x02, 0x72, 0xc7, 0xe2,
+	0x1e, 0xd5, 0xe0, 0x68, 0x45, 0x43, 0x57, 0x09, 0x78, 0x8c, 0x76, 0x20, 0x60, 0x30, 0x77, 0x15,
+	0xf7, 0xa4, 0xf2, 0x81, 0xdc, 0xb5, 0xc8, 0x87, 0x35, 0xc8, 0x89, 0xd1, 0x50, 0x2b, 0xa1, 0xdb,
+	0xb0, 0x0c, 0x00, 0x5f, 0xa0, 0xfb, 0xcb, 0x73, 0x05, 0xae, 0xcd, 0x84, 0xc5, 0x12, 0x58, 0x00,
+	0x04, 0x59, 0xf4, 0xe3, 0xa6, 0x47, 0x3b, 0xe1, 0xfa, 0x55, 0xae, 0xa5, 0xf7, 0xd2, 0x6b, 0xb2,
+	0x80, 0xcf, 0x51, 0x77, 0x69, 0x7a, 0x37, 0x95, 0x9a, 0x03, 0x69, 0x37, 0x32, 0x47, 0xe9, 0xfc,
+	0x73, 0xa9, 0x39, 0xed, 0xcc, 0xab, 0xa1, 0xf5, 0x9c, 0x81, 0xb9, 0x20, 0x66, 0x11, 0x0b, 0xac,
+	0x2f, 0xb6, 0x1b, 0x61, 0x8d, 0x7c, 0x52, 0x88, 0x68, 0x27, 0xad, 0x86, 0xd6, 0x0d, 0x15, 0x2c,
+	0x90, 0x9d, 0x46, 0x6e, 0x58, 0x32, 0x69, 0x7b, 0x09, 0x04, 0xfc, 0x33, 0xc2, 0x5a, 0x84, 0x3c,
+	0x90, 0xde, 0x05, 0xf7, 0xdd, 0x24, 0xf6, 0x99, 0xd9, 0x7e, 0xc7, 0x32, 0x9d, 0x1a, 0xe6, 0x77,
+	0xa5, 0xf0, 0xcc, 0xea, 0xe8, 0x9e, 0x5e, 0xcb, 0x00, 0x9e, 0xa0, 0x5d, 0xcf, 0x4d, 0x59, 0x90,
+	0xf0, 0xd2, 0x1c, 0xbb, 0x8d, 0xfc, 0x36, 0x3a, 0x37, 0xa2, 0xdc, 0x1d, 0x3b, 0x5e, 0x25, 0x02,
+	0x4c, 0x51, 0x47, 0xf1, 0x05, 0x53, 0x7e, 0xc9, 0xec, 0x36, 0xf4, 0xb0, 0x11, 0x15, 0x4c, 0x55,
+	0x89, 0x00, 0x3f, 0x43, 0xfb, 0x2c, 0xd1, 0xd2, 0xf5, 0x02, 0x26, 0x42, 0x57, 0xc6, 0xda, 0x95,
+	0x89, 0x06, 0xb2, 0x37, 0xd8, 0x3c, 0xbc, 0x7b, 0x4a, 0xfe, 0xfe, 0xeb, 0xa8, 0x97, 0xdf, 0xef,
+	0x4f, 0x7c, 0x5f, 0x71, 0x80, 0x89, 0x56, 0xe6, 0xeb, 0x74, 0x8d, 0x68, 0x64, 0x34, 0xe3, 0x58,
+	0x8f, 0x13, 0x0d, 0xf8, 0x2b, 0x74, 0x5b, 0x44, 0xbf, 0x04, 0x72, 0x01, 0x04, 0x0f, 0x36, 0x1b,
+	0xdc, 0xc4, 0x2f, 0x6c, 0x35, 0x2d, 0x54, 0xc6, 0x37, 0x66, 0xbc, 0x78, 0x68, 0x56, 0x90, 0x81,
+	0xf6, 0x1b, 0xf9, 0x86, 0x5a, 0xd5, 0x38, 0x13, 0xd1, 0x8e, 0xaa, 0x86, 0x80, 0xa7, 0xa8, 0xb7,
+	0x3a, 0x53, 0x4a, 0x26, 0x91, 0x0f, 0xa4, 0x67, 0xd9, 0x5f, 0xbc, 0xc3, 0x40, 0x51, 0x23, 0xa4,
+	0x38, 0x5d, 0x4f, 0x01, 0x8e, 0x10, 0x59, 0xed, 0xe1, 0xb1, 0xc8, 0x17, 0x99, 0xa7, 0xee, 0xd9,
+	0x3e, 0x5f, 0xbe, 0x43, 0x9f, 0x51, 0x21, 0xa6, 0x07, 0xe9, 0x75, 0x69, 0xc0, 0x3f, 0xa0, 0xee,
+	0x42, 0xaa, 0x0b, 0xb3, 0x41, 0xd7, 0x4b, 0x14, 0x48, 0x05, 0xe4, 0xc0, 0xf6, 0x39, 0xaa, 0xe9,
+	0xf3, 0x7d, 0x2e, 0x1b, 0x59, 0x15, 0xdd, 0x5d, 0xac, 0xc4, 0x80, 0x05, 0x3a, 0x28, 0xcc, 0xec,
+	0xf2, 0x58, 0x7a, 0x73, 0xc8, 0x87, 0x83, 0xdc, 0x1f, 0xb4, 0x1a, 0x5c, 0x40, 0xc5, 0x6c, 0x7c,
+	0x6d, 0xb5, 0xf9, 0x7c, 0xf4, 0xf4, 0x35, 0x59, 0x33, 0x82, 0xe6, 0x53, 0x85, 0xb1, 0xb9, 0x4c,
+	0x4b, 0x47, 0x93, 0x46, 0x23, 0x48, 0x4b, 0x61, 0xee, 0xea, 0x3d, 0xb5, 0x96, 0x81, 0xd3, 0x9f,
+	0x5e, 0x5f, 0xf6, 0x5b, 0x6f, 0x2e, 0xfb, 0xad, 0xff, 0x2e, 0xfb, 0xad, 0x3f, 0xae, 0xfa, 0x1b,
+	0x6f, 0xae, 0xfa, 0x1b, 0xff, 0x5c, 0xf5, 0x37, 0x7e, 0x7c, 0x32, 0x13, 0x7a, 0x9e, 0x4c, 0x87,
+	0x9e, 0x0c, 0x9d, 0x98, 0x2b, 0x10, 0xa0, 0x79, 0xe4, 0xf1, 0x71, 0xc4, 0x9d, 0xac, 0xed, 0x51,
+	0xc4, 0xb4, 0x48, 0xb9, 0x93, 0x9e, 0x38, 0xbf, 0xad, 0xff, 0x9e, 0xe8, 0xdf, 0x63, 0x0e, 0xd3,
+	0x2d, 0xfb, 0x3b, 0xf2, 0xf8, 0xff, 0x01, 0x00, 0x4f, 0xb8, 0x4c, 0x84, 0x6d, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -169,10 +361,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorUnbondings) > 0 {
-		for iNdEx := len(m.ValidatorUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RedemptionRecords) > 0 {
+		for iNdEx := len(m.RedemptionRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ValidatorUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RedemptionRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -180,168 +372,1146 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.UserUnbondings) > 0 {
-		for iNdEx := len(m.UserUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+	if m.TimelockEpochsUpdate != nil {
+		{
+			size, err := m.TimelockEpochsUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.WorkflowCursors) > 0 {
+		for iNdEx := len(m.WorkflowCursors) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UserUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.WorkflowCursors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.ValidatorSetCandidates) > 0 {
+		for iNdEx := len(m.ValidatorSetCandidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSetCandidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.ValidatorSetRounds) > 0 {
+		for iNdEx := len(m.ValidatorSetRounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSetRounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.RedeemOutflows) > 0 {
+		for iNdEx := len(m.RedeemOutflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedeemOutflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.Inflows) > 0 {
+		for iNdEx := len(m.Inflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.AutoClaimOptOuts) > 0 {
+		for iNdEx := len(m.AutoClaimOptOuts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoClaimOptOuts[iNdEx])
+			copy(dAtA[i:], m.AutoClaimOptOuts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoClaimOptOuts[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.RewardRecords) > 0 {
+		for iNdEx := len(m.RewardRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.CValueRecords) > 0 {
+		for iNdEx := len(m.CValueRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CValueRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.TimelockedUpdates) > 0 {
+		for iNdEx := len(m.TimelockedUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimelockedUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.VoteSignals) > 0 {
+		for iNdEx := len(m.VoteSignals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteSignals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.VoteSignalings) > 0 {
+		for iNdEx := len(m.VoteSignalings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteSignalings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.HostChainVotes) > 0 {
+		for iNdEx := len(m.HostChainVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostChainVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ValidatorSetProposals) > 0 {
+		for iNdEx := len(m.ValidatorSetProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSetProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SlashRecords) > 0 {
+		for iNdEx := len(m.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LsmDeposits) > 0 {
+		for iNdEx := len(m.LsmDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LsmDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ValidatorUnbondings) > 0 {
+		for iNdEx := len(m.ValidatorUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.UserUnbondings) > 0 {
+		for iNdEx := len(m.UserUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.HostChains) > 0 {
+		for iNdEx := len(m.HostChains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.HostChains) > 0 {
+		for _, e := range m.HostChains {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UserUnbondings) > 0 {
+		for _, e := range m.UserUnbondings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorUnbondings) > 0 {
+		for _, e := range m.ValidatorUnbondings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LsmDeposits) > 0 {
+		for _, e := range m.LsmDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashRecords) > 0 {
+		for _, e := range m.SlashRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorSetProposals) > 0 {
+		for _, e := range m.ValidatorSetProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HostChainVotes) > 0 {
+		for _, e := range m.HostChainVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoteSignalings) > 0 {
+		for _, e := range m.VoteSignalings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoteSignals) > 0 {
+		for _, e := range m.VoteSignals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TimelockedUpdates) > 0 {
+		for _, e := range m.TimelockedUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CValueRecords) > 0 {
+		for _, e := range m.CValueRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardRecords) > 0 {
+		for _, e := range m.RewardRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoClaimOptOuts) > 0 {
+		for _, s := range m.AutoClaimOptOuts {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Inflows) > 0 {
+		for _, e := range m.Inflows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RedeemOutflows) > 0 {
+		for _, e := range m.RedeemOutflows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorSetRounds) > 0 {
+		for _, e := range m.ValidatorSetRounds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorSetCandidates) > 0 {
+		for _, e := range m.ValidatorSetCandidates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WorkflowCursors) > 0 {
+		for _, e := range m.WorkflowCursors {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TimelockEpochsUpdate != nil {
+		l = m.TimelockEpochsUpdate.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.RedemptionRecords) > 0 {
+		for _, e := range m.RedemptionRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostChains = append(m.HostChains, &HostChain{})
+			if err := m.HostChains[len(m.HostChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, &Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, &Unbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserUnbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserUnbondings = append(m.UserUnbondings, &UserUnbonding{})
+			if err := m.UserUnbondings[len(m.UserUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUnbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorUnbondings = append(m.ValidatorUnbondings, &ValidatorUnbonding{})
+			if err := m.ValidatorUnbondings[len(m.ValidatorUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LsmDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LsmDeposits = append(m.LsmDeposits, &LSMDeposit{})
+			if err := m.LsmDeposits[len(m.LsmDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redelegations = append(m.Redelegations, &Redelegation{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecords = append(m.SlashRecords, &SlashRecord{})
+			if err := m.SlashRecords[len(m.SlashRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSetProposals = append(m.ValidatorSetProposals, &ValidatorSetProposal{})
+			if err := m.ValidatorSetProposals[len(m.ValidatorSetProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostChainVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostChainVotes = append(m.HostChainVotes, &HostChainVote{})
+			if err := m.HostChainVotes[len(m.HostChainVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteSignalings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteSignalings = append(m.VoteSignalings, &VoteSignaling{})
+			if err := m.VoteSignalings[len(m.VoteSignalings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteSignals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteSignals = append(m.VoteSignals, &VoteSignal{})
+			if err := m.VoteSignals[len(m.VoteSignals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockedUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimelockedUpdates = append(m.TimelockedUpdates, &TimelockedUpdate{})
+			if err := m.TimelockedUpdates[len(m.TimelockedUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CValueRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Unbondings) > 0 {
-		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CValueRecords = append(m.CValueRecords, &CValueRecord{})
+			if err := m.CValueRecords[len(m.CValueRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Deposits) > 0 {
-		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardRecords = append(m.RewardRecords, &RewardRecord{})
+			if err := m.RewardRecords[len(m.RewardRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoClaimOptOuts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.HostChains) > 0 {
-		for iNdEx := len(m.HostChains) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HostChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoClaimOptOuts = append(m.AutoClaimOptOuts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.HostChains) > 0 {
-		for _, e := range m.HostChains {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Deposits) > 0 {
-		for _, e := range m.Deposits {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Unbondings) > 0 {
-		for _, e := range m.Unbondings {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.UserUnbondings) > 0 {
-		for _, e := range m.UserUnbondings {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.ValidatorUnbondings) > 0 {
-		for _, e := range m.ValidatorUnbondings {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Inflows = append(m.Inflows, &Inflow{})
+			if err := m.Inflows[len(m.Inflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemOutflows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -368,13 +1538,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RedeemOutflows = append(m.RedeemOutflows, &RedeemOutflow{})
+			if err := m.RedeemOutflows[len(m.RedeemOutflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostChains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetRounds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -401,14 +1572,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostChains = append(m.HostChains, &HostChain{})
-			if err := m.HostChains[len(m.HostChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorSetRounds = append(m.ValidatorSetRounds, &ValidatorSetRound{})
+			if err := m.ValidatorSetRounds[len(m.ValidatorSetRounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCandidates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -435,14 +1606,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposits = append(m.Deposits, &Deposit{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorSetCandidates = append(m.ValidatorSetCandidates, &ValidatorSetCandidate{})
+			if err := m.ValidatorSetCandidates[len(m.ValidatorSetCandidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowCursors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -469,14 +1640,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unbondings = append(m.Unbondings, &Unbonding{})
-			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkflowCursors = append(m.WorkflowCursors, &WorkflowCursor{})
+			if err := m.WorkflowCursors[len(m.WorkflowCursors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserUnbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockEpochsUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -503,14 +1674,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserUnbondings = append(m.UserUnbondings, &UserUnbonding{})
-			if err := m.UserUnbondings[len(m.UserUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TimelockEpochsUpdate == nil {
+				m.TimelockEpochsUpdate = &TimelockEpochsUpdate{}
+			}
+			if err := m.TimelockEpochsUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUnbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -537,8 +1710,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorUnbondings = append(m.ValidatorUnbondings, &ValidatorUnbonding{})
-			if err := m.ValidatorUnbondings[len(m.ValidatorUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RedemptionRecords = append(m.RedemptionRecords, &RedemptionRecord{})
+			if err := m.RedemptionRecords[len(m.RedemptionRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -248,6 +248,66 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "lsm deposit of non existent chain-id",
+			genState: func() *types.GenesisState {
+				genesis := ValidGenesis()
+				genesis.LsmDeposits = append(genesis.LsmDeposits, &types.LSMDeposit{ChainId: "nonExistent-1"})
+				return genesis
+			},
+			valid: false,
+		},
+		{
+			desc: "lsm deposit invalid",
+			genState: func() *types.GenesisState {
+				genesis := ValidGenesis()
+				genesis.LsmDeposits = append(genesis.LsmDeposits,
+					&types.LSMDeposit{
+						ChainId:          "chainA-1",
+						Amount:           sdk.NewInt(-1),
+						Shares:           sdk.NewDec(1000),
+						Denom:            "cosmosvaloper1hcqg5wj9t42zawqkqucs7la85ffyv08le09ljt/1",
+						IbcDenom:         "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9",
+						DelegatorAddress: authtypes.NewModuleAddressOrBech32Address("test").String(),
+						State:            types.LSMDeposit_DEPOSIT_PENDING,
+					})
+				return genesis
+			},
+			valid: false,
+		},
+		{
+			desc: "redemption record duplicated",
+			genState: func() *types.GenesisState {
+				genesis := ValidGenesis()
+				record := &types.RedemptionRecord{
+					ChainId:   "deregistered-1",
+					MintDenom: "stk/uatom",
+					IbcDenom:  "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9",
+					CValue:    sdk.OneDec(),
+					Amount:    sdk.NewInt(100),
+					StkAmount: sdk.NewInt(100),
+				}
+				genesis.RedemptionRecords = append(genesis.RedemptionRecords, record, record)
+				return genesis
+			},
+			valid: false,
+		},
+		{
+			desc: "redemption record without stk tokens",
+			genState: func() *types.GenesisState {
+				genesis := ValidGenesis()
+				genesis.RedemptionRecords = append(genesis.RedemptionRecords, &types.RedemptionRecord{
+					ChainId:   "deregistered-1",
+					MintDenom: "stk/uatom",
+					IbcDenom:  "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9",
+					CValue:    sdk.OneDec(),
+					Amount:    sdk.NewInt(100),
+					StkAmount: sdk.ZeroInt(),
+				})
+				return genesis
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState().Validate()
@@ -335,5 +395,15 @@ func ValidGenesis() *types.GenesisState {
 			Amount:           sdk.NewInt64Coin("uatom", 1000),
 			IbcSequenceId:    "",
 		}},
+		LsmDeposits: []*types.LSMDeposit{{
+			ChainId:          "chainA-1",
+			Amount:           sdk.NewInt(1000),
+			Shares:           sdk.NewDec(1000),
+			Denom:            "cosmosvaloper1hcqg5wj9t42zawqkqucs7la85ffyv08le09ljt/1",
+			IbcDenom:         "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9",
+			DelegatorAddress: authtypes.NewModuleAddressOrBech32Address("test").String(),
+			State:            types.LSMDeposit_DEPOSIT_PENDING,
+			IbcSequenceId:    "",
+		}},
 	}
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"cosmossdk.io/math"
@@ -34,3 +35,54 @@ func (hc *HostChain) GetHostChainTotalDelegations() math.Int {
 
 	return totalDelegations
 }
+
+// pauseFlows sets the pause of each host chain flow, by its name in the KeyPauses update values
+var pauseFlows = map[string]func(pauses *HostChainPauses, paused bool){
+	"liquid_stake": func(pauses *HostChainPauses, paused bool) { pauses.LiquidStake = paused },
+	"lsm_stake":    func(pauses *HostChainPauses, paused bool) { pauses.LsmStake = paused },
+	"unstake":      func(pauses *HostChainPauses, paused bool) { pauses.Unstake = paused },
+	"redeem":       func(pauses *HostChainPauses, paused bool) { pauses.Redeem = paused },
+	"autocompound": func(pauses *HostChainPauses, paused bool) { pauses.Autocompound = paused },
+	"ica_sends":    func(pauses *HostChainPauses, paused bool) { pauses.IcaSends = paused },
+}
+
+// ParsePausesUpdate returns the flows a KeyPauses update value pauses or unpauses, the flows not present are left
+// as they are
+func ParsePausesUpdate(value string) (map[string]bool, error) {
+	var flows map[string]bool
+	if err := json.Unmarshal([]byte(value), &flows); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal pauses update string")
+	}
+	if len(flows) == 0 {
+		return nil, fmt.Errorf("pauses update doesn't update any flow")
+	}
+	for flow := range flows {
+		if _, ok := pauseFlows[flow]; !ok {
+			return nil, fmt.Errorf("pauses update has an unknown flow %s", flow)
+		}
+	}
+
+	return flows, nil
+}
+
+// IsPauseOnly returns whether a pauses update only pauses flows, without unpausing any of them
+func IsPauseOnly(flows map[string]bool) bool {
+	for _, paused := range flows {
+		if !paused {
+			return false
+		}
+	}
+
+	return true
+}
+
+// UpdatePauses pauses or unpauses the flows of the update, keeping the pauses of the rest of the flows
+func (hc *HostChain) UpdatePauses(flows map[string]bool) {
+	if hc.Pauses == nil {
+		hc.Pauses = &HostChainPauses{}
+	}
+
+	for flow, paused := range flows {
+		pauseFlows[flow](hc.Pauses, paused)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
 
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 )
@@ -133,3 +134,25 @@ func makeVal(valoperAddr string) *types.Validator {
 		UnbondingEpoch:  0,
 	}
 }
+
+func TestHostChain_UpdatePauses(t *testing.T) {
+	hc := validHostChain()
+
+	flows, err := types.ParsePausesUpdate(`{"liquid_stake":true,"redeem":true}`)
+	require.NoError(t, err)
+	require.True(t, types.IsPauseOnly(flows))
+	hc.UpdatePauses(flows)
+	require.Equal(t, &types.HostChainPauses{LiquidStake: true, Redeem: true}, hc.Pauses)
+
+	// only the flows present are updated
+	flows, err = types.ParsePausesUpdate(`{"redeem":false,"ica_sends":true}`)
+	require.NoError(t, err)
+	require.False(t, types.IsPauseOnly(flows))
+	hc.UpdatePauses(flows)
+	require.Equal(t, &types.HostChainPauses{LiquidStake: true, IcaSends: true}, hc.Pauses)
+
+	_, err = types.ParsePausesUpdate(`{}`)
+	require.Error(t, err)
+	_, err = types.ParsePausesUpdate(`{"deposit":true}`)
+	require.Error(t, err)
+}
@@ -1,9 +1,13 @@
 package types
 
 import (
+	"encoding/binary"
+	"fmt"
 	"strconv"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 )
 
@@ -29,14 +33,19 @@ const (
 	RewardsEpochIdentifier = "day"
 	CValueEpoch            = "hour"
 
+	// Trailing reward rate windows, in delegation epochs
+	RewardRateShortWindow int64 = 7
+	RewardRateLongWindow  int64 = 30
+
 	// ICA types
 	DelegateICAType = "delegate"
 	RewardsICAType  = "rewards"
 
 	// ICQ query types
 	// /key is required for proof generation
-	StakingStoreQuery = "store/staking/key"
-	BankStoreQuery    = "store/bank/key"
+	StakingStoreQuery  = "store/staking/key"
+	BankStoreQuery     = "store/bank/key"
+	SlashingStoreQuery = "store/slashing/key"
 
 	// Host chain flags
 	LSMFlag = "lsm"
@@ -47,28 +56,91 @@ const (
 
 	ICATimeoutTimestamp = 15 * time.Minute
 
+	// DefaultHostUnbondingPeriod is the unbonding period assumed for the host chains until it is queried
+	DefaultHostUnbondingPeriod = 21 * 24 * time.Hour
+
 	IBCPrefix = transfertypes.DenomPrefix + "/"
 
 	UnbondingStateEpochLimit = 4
+
+	// DefaultMaxRedelegationEntries is the default staking max entries of the host chains
+	DefaultMaxRedelegationEntries = 7
+
+	// MaxRedelegationMessages is the maximum number of redelegations sent in a single rebalance
+	MaxRedelegationMessages = 10
+
+	// StakingValidatorsQuery is the ICQ query type used to discover the host chain bonded set, it is a gRPC query so
+	// the response is not proof verified and only the operator addresses are taken from it
+	StakingValidatorsQuery = "cosmos.staking.v1beta1.Query/Validators"
+
+	// StakingParamsQuery is the ICQ query type used to fetch the host chain staking params, it is a gRPC query so
+	// the response is not proof verified
+	StakingParamsQuery = "cosmos.staking.v1beta1.Query/Params"
+
+	// MaxValidatorSetQueryLimit is the page size used to discover the host chain bonded set
+	MaxValidatorSetQueryLimit = 100
+
+	// MaxValidatorSetCandidates is the maximum number of candidates of a validator set selection round
+	MaxValidatorSetCandidates = 1000
+
+	// MaxValidatorSetProposalAge is the number of delegation epochs a validator set proposal can be applied for
+	MaxValidatorSetProposalAge = 2
+
+	// DeregistrationGraceEpochs is the number of delegation epochs the settled unbondings of a host chain being
+	// deregistered can be claimed for before the host chain is deleted
+	DeregistrationGraceEpochs = 7
+
+	// WorkflowClaim is the begin block workflow claiming the matured user unbondings
+	WorkflowClaim = "claim"
+	// WorkflowMaturedUnbondings is the begin block workflow transferring the matured unbondings back
+	WorkflowMaturedUnbondings = "matured_unbondings"
+	// WorkflowMaturedValidatorUnbondings is the begin block workflow transferring the matured validator unbondings back
+	WorkflowMaturedValidatorUnbondings = "matured_validator_unbondings"
+	// WorkflowDelegate is the begin block workflow delegating the received deposits
+	WorkflowDelegate = "delegate"
+	// WorkflowRedeemLSMTokens is the begin block workflow untokenizing the received lsm deposits
+	WorkflowRedeemLSMTokens = "redeem_lsm_tokens"
+	// WorkflowTallyVoteSignalings is the begin block workflow voting the tally of the ended vote signalings
+	WorkflowTallyVoteSignalings = "tally_vote_signalings"
+	// WorkflowPruneVoteSignalings is the begin block workflow deleting the closed vote signalings and their signals
+	WorkflowPruneVoteSignalings = "prune_vote_signalings"
 )
 
 // Consts for KV updates, update host chain
 const (
-	KeyAddValidator       string = "add_validator"
-	KeyRemoveValidator    string = "remove_validator"
-	KeyValidatorUpdate    string = "validator_update"
-	KeyValidatorWeight    string = "validator_weight"
-	KeyDepositFee         string = "deposit_fee"
-	KeyRestakeFee         string = "restake_fee"
-	KeyUnstakeFee         string = "unstake_fee"
-	KeyRedemptionFee      string = "redemption_fee"
-	KeyLSMValidatorCap    string = "lsm_validator_cap"
-	KeyLSMBondFactor      string = "lsm_bond_factor"
-	KeyMinimumDeposit     string = "min_deposit"
-	KeyActive             string = "active"
-	KeySetWithdrawAddress string = "set_withdraw_address"
-	KeyAutocompoundFactor string = "autocompound_factor"
-	KeyFlags              string = "flags"
+	KeyAddValidator           string = "add_validator"
+	KeyRemoveValidator        string = "remove_validator"
+	KeyValidatorUpdate        string = "validator_update"
+	KeyValidatorWeight        string = "validator_weight"
+	KeyDepositFee             string = "deposit_fee"
+	KeyRestakeFee             string = "restake_fee"
+	KeyUnstakeFee             string = "unstake_fee"
+	KeyRedemptionFee          string = "redemption_fee"
+	KeyLSMValidatorCap        string = "lsm_validator_cap"
+	KeyLSMBondFactor          string = "lsm_bond_factor"
+	KeyMinimumDeposit         string = "min_deposit"
+	KeyActive                 string = "active"
+	KeySetWithdrawAddress     string = "set_withdraw_address"
+	KeyAutocompoundFactor     string = "autocompound_factor"
+	KeyFlags                  string = "flags"
+	KeyDelegationStrategy     string = "delegation_strategy"
+	KeyRebalance              string = "rebalance"
+	KeyMaxRedelegationEntries string = "max_redelegation_entries"
+	KeyMaxTVL                 string = "max_tvl"
+	KeyMaxEpochInflow         string = "max_epoch_inflow"
+	KeyMaxAddressEpochInflow  string = "max_address_epoch_inflow"
+	KeyMaxEpochUnstake        string = "max_epoch_unstake"
+	KeyMaxEpochRedeemRatio    string = "max_epoch_redeem_ratio"
+	KeySlashThreshold         string = "slash_threshold"
+	KeyMaxValidatorCommission string = "max_validator_commission"
+	KeyValidatorSetConfig     string = "validator_set_config"
+	KeyApplyValidatorSet      string = "apply_validator_set"
+	KeyVoteSignaling          string = "vote_signaling"
+	KeyPauses                 string = "pauses"
+	KeyUpperCValueLimit       string = "upper_c_value_limit"
+	KeyLowerCValueLimit       string = "lower_c_value_limit"
+	KeyMaxCValueDelta         string = "max_c_value_delta"
+	KeyCValueRecoveryCount    string = "c_value_recovery_count"
 )
 
 var (
@@ -79,6 +151,69 @@ var (
 	ValidatorUnbondingKey = []byte{0x05}
 	ParamsKey             = []byte{0x06}
 	LSMDepositKey         = []byte{0x07}
+	RedelegationKey       = []byte{0x08}
+
+	// secondary indexes, mapping an ibc sequence id or a chain id and state to the store keys of the records
+	DepositSequenceIndexKey            = []byte{0x09}
+	DepositStateIndexKey               = []byte{0x0A}
+	UnbondingSequenceIndexKey          = []byte{0x0B}
+	UnbondingStateIndexKey             = []byte{0x0C}
+	LSMDepositSequenceIndexKey         = []byte{0x0D}
+	LSMDepositStateIndexKey            = []byte{0x0E}
+	ValidatorUnbondingSequenceIndexKey = []byte{0x0F}
+
+	// liquid stake inflows of a host chain and its delegators per delegation epoch
+	InflowKey = []byte{0x10}
+
+	// instant redeem outflows of a host chain per delegation epoch
+	RedeemOutflowKey = []byte{0x11}
+
+	// slashes detected on the host chain validators
+	SlashRecordKey = []byte{0x12}
+
+	// latest validator set proposal of each host chain
+	ValidatorSetProposalKey = []byte{0x13}
+
+	// governance votes cast on the host chains
+	HostChainVoteKey = []byte{0x14}
+
+	// stk holder vote signalings on the host chain proposals and their signals
+	VoteSignalingKey = []byte{0x15}
+	VoteSignalKey    = []byte{0x16}
+
+	// host chain updates waiting for their timelock and the id of the next one
+	TimelockedUpdateKey   = []byte{0x17}
+	TimelockedUpdateIDKey = []byte{0x18}
+
+	// c value history of each host chain
+	CValueRecordKey = []byte{0x19}
+
+	// autocompounded rewards of each host chain per delegation epoch
+	RewardRecordKey = []byte{0x1A}
+
+	// addresses that opted out of the automatic claim
+	AutoClaimOptOutKey = []byte{0x1B}
+
+	// validator set selection rounds in progress, their candidates and the consensus address index of the candidates
+	ValidatorSetRoundKey              = []byte{0x1C}
+	ValidatorSetCandidateKey          = []byte{0x1D}
+	ValidatorSetCandidateConsIndexKey = []byte{0x1E}
+
+	// end time index of the vote signalings not tallied yet, and index of the closed vote signalings to be pruned
+	VoteSignalingEndTimeIndexKey = []byte{0x1F}
+	VoteSignalingClosedIndexKey  = []byte{0x20}
+
+	// store key where each begin block workflow of a host chain resumes
+	WorkflowCursorKey = []byte{0x21}
+
+	// lowering of the update timelock waiting for the current timelock
+	TimelockEpochsUpdateKey = []byte{0x22}
+
+	// chain id and unbonding epoch index of the user unbondings
+	UserUnbondingEpochIndexKey = []byte{0x23}
+
+	// redemption records of the deregistered host chains
+	RedemptionRecordKey = []byte{0x24}
 )
 
 func GetUnbondingStoreKey(chainID string, epochNumber int64) []byte {
@@ -97,6 +232,208 @@ func GetDepositStoreKey(chainID string, epochNumber int64) []byte {
 	return append([]byte(chainID), []byte(strconv.FormatInt(epochNumber, 10))...)
 }
 
+func GetRedelegationStoreKey(chainID, srcValidatorAddress, dstValidatorAddress, sequenceID string) []byte {
+	return append(
+		append([]byte(chainID), []byte(srcValidatorAddress)...),
+		append([]byte(dstValidatorAddress), []byte(sequenceID)...)...,
+	)
+}
+
 func GetLSMDepositStoreKey(chainID, delegatorAddress, denom string) []byte {
 	return append(append([]byte(chainID), []byte(delegatorAddress)...), []byte(denom)...)
 }
+
+// GetSequenceIndexPrefix returns the prefix of all the index entries of an ibc sequence id
+func GetSequenceIndexPrefix(sequenceID string) []byte {
+	return address.MustLengthPrefix([]byte(sequenceID))
+}
+
+// GetSequenceIndexKey returns the index entry of a record with the given ibc sequence id and store key
+func GetSequenceIndexKey(sequenceID string, storeKey []byte) []byte {
+	return append(GetSequenceIndexPrefix(sequenceID), storeKey...)
+}
+
+// GetStateIndexPrefix returns the prefix of all the index entries of a chain id and a record state
+func GetStateIndexPrefix(chainID string, state int32) []byte {
+	return binary.BigEndian.AppendUint32(address.MustLengthPrefix([]byte(chainID)), uint32(state))
+}
+
+// GetStateIndexKey returns the index entry of a record with the given chain id, state and store key
+func GetStateIndexKey(chainID string, state int32, storeKey []byte) []byte {
+	return append(GetStateIndexPrefix(chainID, state), storeKey...)
+}
+
+// GetEpochIndexPrefix returns the prefix of all the index entries of a chain id and an epoch
+func GetEpochIndexPrefix(chainID string, epochNumber int64) []byte {
+	return binary.BigEndian.AppendUint64(address.MustLengthPrefix([]byte(chainID)), uint64(epochNumber))
+}
+
+// GetEpochIndexKey returns the index entry of a record with the given chain id, epoch and store key
+func GetEpochIndexKey(chainID string, epochNumber int64, storeKey []byte) []byte {
+	return append(GetEpochIndexPrefix(chainID, epochNumber), storeKey...)
+}
+
+// GetRedemptionRecordStoreKey returns the redemption record entry of an stk denom
+func GetRedemptionRecordStoreKey(mintDenom string) []byte {
+	return []byte(mintDenom)
+}
+
+// GetInflowChainPrefix returns the prefix of all the inflow entries of a chain id
+func GetInflowChainPrefix(chainID string) []byte {
+	return address.MustLengthPrefix([]byte(chainID))
+}
+
+// GetInflowEpochPrefix returns the prefix of all the inflow entries of a chain id and epoch, which is also the
+// key of the total chain inflow for the epoch
+func GetInflowEpochPrefix(chainID string, epochNumber int64) []byte {
+	return binary.BigEndian.AppendUint64(GetInflowChainPrefix(chainID), uint64(epochNumber))
+}
+
+// GetInflowStoreKey returns the inflow entry of an address for the given chain id and epoch
+func GetInflowStoreKey(chainID string, epochNumber int64, delegatorAddress string) []byte {
+	return append(GetInflowEpochPrefix(chainID, epochNumber), []byte(delegatorAddress)...)
+}
+
+// ParseInflowStoreKey returns the chain id, epoch and address of an inflow entry, the address is empty for the
+// total chain inflow of the epoch
+func ParseInflowStoreKey(key []byte) (string, int64, string, error) {
+	if len(key) == 0 || len(key) < 1+int(key[0])+8 {
+		return "", 0, "", fmt.Errorf("invalid inflow key %X", key)
+	}
+
+	chainIDEnd := 1 + int(key[0])
+	chainID := string(key[1:chainIDEnd])
+	epochNumber := int64(binary.BigEndian.Uint64(key[chainIDEnd : chainIDEnd+8]))
+	return chainID, epochNumber, string(key[chainIDEnd+8:]), nil
+}
+
+// GetRedeemOutflowChainPrefix returns the prefix of all the redeem outflow entries of a chain id
+func GetRedeemOutflowChainPrefix(chainID string) []byte {
+	return address.MustLengthPrefix([]byte(chainID))
+}
+
+// GetRedeemOutflowStoreKey returns the redeem outflow entry of a chain id and epoch
+func GetRedeemOutflowStoreKey(chainID string, epochNumber int64) []byte {
+	return binary.BigEndian.AppendUint64(GetRedeemOutflowChainPrefix(chainID), uint64(epochNumber))
+}
+
+// ParseRedeemOutflowStoreKey returns the chain id and epoch of a redeem outflow entry
+func ParseRedeemOutflowStoreKey(key []byte) (string, int64, error) {
+	if len(key) == 0 || len(key) != 1+int(key[0])+8 {
+		return "", 0, fmt.Errorf("invalid redeem outflow key %X", key)
+	}
+
+	chainIDEnd := 1 + int(key[0])
+	return string(key[1:chainIDEnd]), int64(binary.BigEndian.Uint64(key[chainIDEnd:])), nil
+}
+
+// GetSlashRecordChainPrefix returns the prefix of all the slash records of a chain id
+func GetSlashRecordChainPrefix(chainID string) []byte {
+	return address.MustLengthPrefix([]byte(chainID))
+}
+
+// GetSlashRecordValidatorPrefix returns the prefix of all the slash records of a validator of a chain id
+func GetSlashRecordValidatorPrefix(chainID, validatorAddress string) []byte {
+	return append(GetSlashRecordChainPrefix(chainID), address.MustLengthPrefix([]byte(validatorAddress))...)
+}
+
+// GetSlashRecordStoreKey returns the slash record entry of a validator of a chain id at a block height
+func GetSlashRecordStoreKey(chainID, validatorAddress string, height int64) []byte {
+	return binary.BigEndian.AppendUint64(GetSlashRecordValidatorPrefix(chainID, validatorAddress), uint64(height))
+}
+
+// GetHostChainVoteChainPrefix returns the prefix of all the governance votes cast on a chain id
+func GetHostChainVoteChainPrefix(chainID string) []byte {
+	return address.MustLengthPrefix([]byte(chainID))
+}
+
+// GetHostChainVoteStoreKey returns the governance vote entry of a proposal of a chain id
+func GetHostChainVoteStoreKey(chainID string, proposalID uint64) []byte {
+	return binary.BigEndian.AppendUint64(GetHostChainVoteChainPrefix(chainID), proposalID)
+}
+
+// GetVoteSignalPrefix returns the prefix of all the signals of a proposal of a chain id
+func GetVoteSignalPrefix(chainID string, proposalID uint64) []byte {
+	return GetHostChainVoteStoreKey(chainID, proposalID)
+}
+
+// GetVoteSignalStoreKey returns the signal entry of an address on a proposal of a chain id
+func GetVoteSignalStoreKey(chainID string, proposalID uint64, signaler string) []byte {
+	return append(GetVoteSignalPrefix(chainID, proposalID), address.MustLengthPrefix([]byte(signaler))...)
+}
+
+// GetVoteSignalingChainPrefix returns the prefix of all the vote signalings of a chain id
+func GetVoteSignalingChainPrefix(chainID string) []byte {
+	return address.MustLengthPrefix([]byte(chainID))
+}
+
+// GetVoteSignalingEndTimePrefix returns the prefix of the end time index entries of the vote signalings of a chain id
+// ending at a time
+func GetVoteSignalingEndTimePrefix(chainID string, endTime time.Time) []byte {
+	return append(GetVoteSignalingChainPrefix(chainID), sdk.FormatTimeBytes(endTime)...)
+}
+
+// GetVoteSignalingEndTimeIndexKey returns the end time index entry of a vote signaling of a chain id
+func GetVoteSignalingEndTimeIndexKey(chainID string, endTime time.Time, proposalID uint64) []byte {
+	return binary.BigEndian.AppendUint64(GetVoteSignalingEndTimePrefix(chainID, endTime), proposalID)
+}
+
+// GetTimelockedUpdateChainPrefix returns the prefix of all the timelocked updates of a chain id
+func GetTimelockedUpdateChainPrefix(chainID string) []byte {
+	return address.MustLengthPrefix([]byte(chainID))
+}
+
+// GetTimelockedUpdateStoreKey returns the timelocked update entry of a chain id and id
+func GetTimelockedUpdateStoreKey(chainID string, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(GetTimelockedUpdateChainPrefix(chainID), id)
+}
+
+// GetCValueRecordChainPrefix returns the prefix of all the c value records of a chain id
+func GetCValueRecordChainPrefix(chainID string) []byte {
+	return address.MustLengthPrefix([]byte(chainID))
+}
+
+// GetCValueRecordStoreKey returns the c value record entry of a chain id and c value epoch
+func GetCValueRecordStoreKey(chainID string, epochNumber int64) []byte {
+	return binary.BigEndian.AppendUint64(GetCValueRecordChainPrefix(chainID), uint64(epochNumber))
+}
+
+// GetRewardRecordChainPrefix returns the prefix of all the reward records of a chain id
+func GetRewardRecordChainPrefix(chainID string) []byte {
+	return address.MustLengthPrefix([]byte(chainID))
+}
+
+// GetRewardRecordStoreKey returns the reward record entry of a chain id and delegation epoch
+func GetRewardRecordStoreKey(chainID string, epochNumber int64) []byte {
+	return binary.BigEndian.AppendUint64(GetRewardRecordChainPrefix(chainID), uint64(epochNumber))
+}
+
+// GetWorkflowCursorChainPrefix returns the prefix of all the workflow cursors of a chain id
+func GetWorkflowCursorChainPrefix(chainID string) []byte {
+	return address.MustLengthPrefix([]byte(chainID))
+}
+
+// GetWorkflowCursorStoreKey returns the cursor entry of a begin block workflow of a chain id
+func GetWorkflowCursorStoreKey(chainID, workflow string) []byte {
+	return append(GetWorkflowCursorChainPrefix(chainID), []byte(workflow)...)
+}
+
+// GetValidatorSetRoundStoreKey returns the validator set selection round entry of a chain id
+func GetValidatorSetRoundStoreKey(chainID string) []byte {
+	return address.MustLengthPrefix([]byte(chainID))
+}
+
+// GetValidatorSetCandidateChainPrefix returns the prefix of all the validator set candidates of a chain id
+func GetValidatorSetCandidateChainPrefix(chainID string) []byte {
+	return address.MustLengthPrefix([]byte(chainID))
+}
+
+// GetValidatorSetCandidateStoreKey returns the validator set candidate entry of a validator of a chain id
+func GetValidatorSetCandidateStoreKey(chainID string, valAddr []byte) []byte {
+	return append(GetValidatorSetCandidateChainPrefix(chainID), valAddr...)
+}
+
+// GetValidatorSetCandidateConsIndexKey returns the index entry of a validator set candidate consensus address
+func GetValidatorSetCandidateConsIndexKey(chainID string, consAddr []byte) []byte {
+	return append(GetValidatorSetCandidateChainPrefix(chainID), consAddr...)
+}
@@ -1,12 +1,17 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -41,6 +46,7 @@ func DefaultRewardsAccountPortOwner(chainID string) string {
 
 func (deposit *Deposit) Validate() error {
 	if deposit.State != Deposit_DEPOSIT_PENDING &&
+		deposit.State != Deposit_DEPOSIT_SENT &&
 		deposit.State != Deposit_DEPOSIT_RECEIVED &&
 		deposit.State != Deposit_DEPOSIT_DELEGATING {
 		return fmt.Errorf(
@@ -66,6 +72,29 @@ func (hc *HostChain) Validate() error {
 	if hc.CValue.LT(sdk.ZeroDec()) { //GT limits should be checked by module level params, invariants.
 		return fmt.Errorf("host chain %s has c value out of bounds: %d", hc.ChainId, hc.CValue)
 	}
+	if _, ok := HostChain_DelegationStrategy_name[int32(hc.DelegationStrategy)]; !ok {
+		return fmt.Errorf("host chain %s has an invalid delegation strategy: %d", hc.ChainId, hc.DelegationStrategy)
+	}
+
+	if hc.UnbondingPeriod < 0 {
+		return fmt.Errorf("host chain %s has negative unbonding period", hc.ChainId)
+	}
+
+	if hc.RegistrationEpoch < 0 {
+		return fmt.Errorf("host chain %s has negative registration epoch", hc.ChainId)
+	}
+
+	if hc.CValueCooldown != nil {
+		if _, ok := CValueCooldown_CooldownReason_name[int32(hc.CValueCooldown.Reason)]; !ok {
+			return fmt.Errorf("host chain %s has an invalid c value cooldown reason: %d", hc.ChainId, hc.CValueCooldown.Reason)
+		}
+	}
+
+	if hc.ValidatorSetConfig != nil {
+		if err := hc.ValidatorSetConfig.Validate(); err != nil {
+			return fmt.Errorf("host chain %s validator set config is invalid, err: %s", hc.ChainId, err)
+		}
+	}
 
 	for _, validator := range hc.Validators {
 		err := validator.Validate()
@@ -89,9 +118,96 @@ func (params *HostChainLSParams) Validate() error {
 	if params.UnstakeFee.LT(sdk.ZeroDec()) || params.UnstakeFee.GT(sdk.OneDec()) {
 		return fmt.Errorf("host chain lsparams has invalid unstake fee, should be 0<=fee<=1\"")
 	}
+	if !params.MaxTvl.IsNil() && params.MaxTvl.IsNegative() {
+		return fmt.Errorf("host chain lsparams has negative max tvl")
+	}
+	if !params.MaxEpochInflow.IsNil() && params.MaxEpochInflow.IsNegative() {
+		return fmt.Errorf("host chain lsparams has negative max epoch inflow")
+	}
+	if !params.MaxAddressEpochInflow.IsNil() && params.MaxAddressEpochInflow.IsNegative() {
+		return fmt.Errorf("host chain lsparams has negative max address epoch inflow")
+	}
+	if !params.MaxEpochUnstake.IsNil() && params.MaxEpochUnstake.IsNegative() {
+		return fmt.Errorf("host chain lsparams has negative max epoch unstake")
+	}
+	if !params.MaxEpochRedeemRatio.IsNil() &&
+		(params.MaxEpochRedeemRatio.IsNegative() || params.MaxEpochRedeemRatio.GT(sdk.OneDec())) {
+		return fmt.Errorf("host chain lsparams has invalid max epoch redeem ratio, should be 0<=ratio<=1")
+	}
+	if !params.SlashThreshold.IsNil() &&
+		(params.SlashThreshold.IsNegative() || params.SlashThreshold.GT(sdk.OneDec())) {
+		return fmt.Errorf("host chain lsparams has invalid slash threshold, should be 0<=threshold<=1")
+	}
+	if !params.MaxValidatorCommission.IsNil() &&
+		(params.MaxValidatorCommission.IsNegative() || params.MaxValidatorCommission.GT(sdk.OneDec())) {
+		return fmt.Errorf("host chain lsparams has invalid max validator commission, should be 0<=commission<=1")
+	}
+	if !params.UpperCValueLimit.IsNil() && params.UpperCValueLimit.IsNegative() {
+		return fmt.Errorf("host chain lsparams has negative upper c value limit")
+	}
+	if !params.LowerCValueLimit.IsNil() &&
+		(params.LowerCValueLimit.IsNegative() || params.LowerCValueLimit.GT(sdk.OneDec())) {
+		return fmt.Errorf("host chain lsparams has invalid lower c value limit, should be 0<=limit<=1")
+	}
+	if !params.UpperCValueLimit.IsNil() && params.UpperCValueLimit.IsPositive() &&
+		!params.LowerCValueLimit.IsNil() && params.LowerCValueLimit.GTE(params.UpperCValueLimit) {
+		return fmt.Errorf("host chain lsparams lower c value limit should be less than the upper c value limit")
+	}
+	if !params.MaxCValueDelta.IsNil() && params.MaxCValueDelta.IsNegative() {
+		return fmt.Errorf("host chain lsparams has negative max c value delta")
+	}
+	return nil
+}
+
+// CValueLimits returns the c value limits of the host chain, falling back to the
+// given module limits for the ones that are not set
+func (hc *HostChain) CValueLimits(lower, upper sdk.Dec) (sdk.Dec, sdk.Dec) {
+	if hc.Params == nil {
+		return lower, upper
+	}
+	if !hc.Params.LowerCValueLimit.IsNil() && hc.Params.LowerCValueLimit.IsPositive() {
+		lower = hc.Params.LowerCValueLimit
+	}
+	if !hc.Params.UpperCValueLimit.IsNil() && hc.Params.UpperCValueLimit.IsPositive() {
+		upper = hc.Params.UpperCValueLimit
+	}
+	return lower, upper
+}
+
+// ValidateCValueLimits checks that the c value limits of the host chain, combined with the given module limits,
+// keep the lower limit below the upper one
+func (hc *HostChain) ValidateCValueLimits(lower, upper sdk.Dec) error {
+	lower, upper = hc.CValueLimits(lower, upper)
+	if lower.GTE(upper) {
+		return fmt.Errorf(
+			"host chain %s lower c value limit %s should be less than the upper c value limit %s",
+			hc.ChainId, lower, upper,
+		)
+	}
 	return nil
 }
 
+// CValueDeltaExceeded returns true if the change between the last two c values of the
+// host chain is larger than the host chain max c value delta
+func (hc *HostChain) CValueDeltaExceeded() bool {
+	if hc.Params == nil || hc.Params.MaxCValueDelta.IsNil() || !hc.Params.MaxCValueDelta.IsPositive() {
+		return false
+	}
+	if hc.LastCValue.IsNil() || !hc.LastCValue.IsPositive() || hc.CValue.IsNil() {
+		return false
+	}
+	return hc.CValue.Sub(hc.LastCValue).Abs().Quo(hc.LastCValue).GT(hc.Params.MaxCValueDelta)
+}
+
+// HostUnbondingPeriod returns the staking unbonding period of the host chain, falling back
+// to the default one if it hasn't been queried yet
+func (hc *HostChain) HostUnbondingPeriod() time.Duration {
+	if hc.UnbondingPeriod <= 0 {
+		return DefaultHostUnbondingPeriod
+	}
+	return hc.UnbondingPeriod
+}
+
 func (validator *Validator) Validate() error {
 	if validator.Status != stakingtypes.Unspecified.String() &&
 		validator.Status != stakingtypes.Unbonded.String() &&
@@ -172,3 +288,499 @@ func (vb *ValidatorUnbonding) Validate() error {
 	}
 	return nil
 }
+
+func (deposit *LSMDeposit) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(deposit.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if err := sdk.ValidateDenom(deposit.Denom); err != nil {
+		return fmt.Errorf("lsm deposit for chain %s has an invalid denom: %s", deposit.ChainId, err)
+	}
+	if err := sdk.ValidateDenom(deposit.IbcDenom); err != nil {
+		return fmt.Errorf("lsm deposit for chain %s has an invalid ibc denom: %s", deposit.ChainId, err)
+	}
+	if deposit.Amount.IsNegative() {
+		return fmt.Errorf("lsm deposit for chain %s has negative amount", deposit.ChainId)
+	}
+	if deposit.Shares.IsNegative() {
+		return fmt.Errorf("lsm deposit for chain %s has negative shares", deposit.ChainId)
+	}
+	if deposit.State != LSMDeposit_DEPOSIT_PENDING &&
+		deposit.State != LSMDeposit_DEPOSIT_SENT &&
+		deposit.State != LSMDeposit_DEPOSIT_RECEIVED &&
+		deposit.State != LSMDeposit_DEPOSIT_UNTOKENIZING {
+		return fmt.Errorf(
+			"host chain %s lsm deposit has an invalid state: %s",
+			deposit.ChainId,
+			deposit.State,
+		)
+	}
+	return nil
+}
+
+func (r *Redelegation) Validate() error {
+	if _, _, err := bech32.DecodeAndConvert(r.SrcValidatorAddress); err != nil {
+		return err
+	}
+	if _, _, err := bech32.DecodeAndConvert(r.DstValidatorAddress); err != nil {
+		return err
+	}
+	if r.SrcValidatorAddress == r.DstValidatorAddress {
+		return fmt.Errorf("redelegation %s has the same source and destination validator", r.String())
+	}
+	if !r.Amount.IsValid() {
+		return fmt.Errorf("redelegation %s has an invalid amount, amount: %s", r.String(), r.Amount)
+	}
+	if r.State != Redelegation_REDELEGATION_INITIATED &&
+		r.State != Redelegation_REDELEGATION_MATURING {
+		return fmt.Errorf(
+			"host chain %s redelegation has an invalid state: %s",
+			r.ChainId,
+			r.State,
+		)
+	}
+	return nil
+}
+
+func (r *SlashRecord) Validate() error {
+	if _, _, err := bech32.DecodeAndConvert(r.ValidatorAddress); err != nil {
+		return err
+	}
+	if r.Height < 0 {
+		return fmt.Errorf("slash record %s has a negative height", r.String())
+	}
+	if r.SlashedAmount.IsNil() || !r.SlashedAmount.IsPositive() {
+		return fmt.Errorf("slash record %s has an invalid slashed amount", r.String())
+	}
+	if r.ExchangeRateBefore.IsNil() || r.ExchangeRateBefore.IsNegative() ||
+		r.ExchangeRateAfter.IsNil() || r.ExchangeRateAfter.IsNegative() {
+		return fmt.Errorf("slash record %s has an invalid exchange rate", r.String())
+	}
+	return nil
+}
+
+func (r *CValueRecord) Validate() error {
+	if r.Epoch < 0 || r.Height < 0 {
+		return fmt.Errorf("c value record %s has a negative epoch or height", r.String())
+	}
+	if r.CValue.IsNil() || r.CValue.IsNegative() {
+		return fmt.Errorf("c value record %s has an invalid c value", r.String())
+	}
+	for _, amount := range []math.Int{
+		r.MintedAmount,
+		r.StakedAmount,
+		r.AmountOnPersistence,
+		r.AmountOnHostChain,
+		r.TokenizedStakedAmount,
+		r.ValidatorUnbondingAmount,
+	} {
+		if amount.IsNil() || amount.IsNegative() {
+			return fmt.Errorf("c value record %s has an invalid amount", r.String())
+		}
+	}
+	return nil
+}
+
+func (r *RewardRecord) Validate() error {
+	if r.Epoch < 0 {
+		return fmt.Errorf("reward record %s has a negative epoch", r.String())
+	}
+	if r.Amount.IsNil() || r.Amount.IsNegative() || r.Fee.IsNil() || r.Fee.IsNegative() {
+		return fmt.Errorf("reward record %s has an invalid amount", r.String())
+	}
+	if r.Fee.GT(r.Amount) {
+		return fmt.Errorf("reward record %s has a fee greater than its amount", r.String())
+	}
+	return nil
+}
+
+func (i *Inflow) Validate() error {
+	if i.Epoch < 0 {
+		return fmt.Errorf("inflow %s has a negative epoch", i.String())
+	}
+	if i.Address != "" {
+		if _, err := sdk.AccAddressFromBech32(i.Address); err != nil {
+			return fmt.Errorf("inflow %s has an invalid address: %w", i.String(), err)
+		}
+	}
+	if i.Amount.IsNil() || i.Amount.IsNegative() {
+		return fmt.Errorf("inflow %s has an invalid amount", i.String())
+	}
+	return nil
+}
+
+func (o *RedeemOutflow) Validate() error {
+	if o.Epoch < 0 {
+		return fmt.Errorf("redeem outflow %s has a negative epoch", o.String())
+	}
+	if o.Amount.IsNil() || o.Amount.IsNegative() {
+		return fmt.Errorf("redeem outflow %s has an invalid amount", o.String())
+	}
+	return nil
+}
+
+func (c *WorkflowCursor) Validate() error {
+	if c.Workflow == "" {
+		return fmt.Errorf("workflow cursor %s has an empty workflow", c.String())
+	}
+	if len(c.Cursor) == 0 {
+		return fmt.Errorf("workflow cursor %s has an empty cursor", c.String())
+	}
+	return nil
+}
+
+func (r *RedemptionRecord) Validate() error {
+	if err := sdk.ValidateDenom(r.MintDenom); err != nil {
+		return fmt.Errorf("redemption record %s has an invalid mint denom: %w", r.String(), err)
+	}
+	if err := sdk.ValidateDenom(r.IbcDenom); err != nil {
+		return fmt.Errorf("redemption record %s has an invalid ibc denom: %w", r.String(), err)
+	}
+	if r.CValue.IsNil() || r.CValue.IsNegative() {
+		return fmt.Errorf("redemption record %s has an invalid c value", r.String())
+	}
+	if r.Amount.IsNil() || r.Amount.IsNegative() || r.StkAmount.IsNil() || !r.StkAmount.IsPositive() {
+		return fmt.Errorf("redemption record %s has an invalid amount", r.String())
+	}
+	return nil
+}
+
+// LiquidStakedAmount returns the total amount liquid staked when the c value was computed
+func (r *CValueRecord) LiquidStakedAmount() math.Int {
+	return r.StakedAmount.
+		Add(r.AmountOnPersistence).
+		Add(r.AmountOnHostChain).
+		Add(r.TokenizedStakedAmount).
+		Add(r.ValidatorUnbondingAmount)
+}
+
+func (c *ValidatorSetConfig) Validate() error {
+	if c.Enabled && c.MaxValidators == 0 {
+		return fmt.Errorf("validator set config has max validators equal to zero")
+	}
+	minWeight, maxWeight := c.WeightBounds()
+	if minWeight.IsNegative() || minWeight.GT(sdk.OneDec()) {
+		return fmt.Errorf("validator set config has invalid min weight, should be 0<=weight<=1")
+	}
+	if maxWeight.IsNegative() || maxWeight.GT(sdk.OneDec()) {
+		return fmt.Errorf("validator set config has invalid max weight, should be 0<=weight<=1")
+	}
+	if minWeight.GT(maxWeight) {
+		return fmt.Errorf("validator set config min weight %s is greater than max weight %s", minWeight, maxWeight)
+	}
+	if minWeight.MulInt64(int64(c.MaxValidators)).GT(sdk.OneDec()) {
+		return fmt.Errorf("validator set config min weight %s is too high for %d validators", minWeight, c.MaxValidators)
+	}
+	if c.MaxValidators > 0 && maxWeight.MulInt64(int64(c.MaxValidators)).LT(sdk.OneDec()) {
+		return fmt.Errorf("validator set config max weight %s is too low for %d validators", maxWeight, c.MaxValidators)
+	}
+	if !c.MaxCommission.IsNil() && (c.MaxCommission.IsNegative() || c.MaxCommission.GT(sdk.OneDec())) {
+		return fmt.Errorf("validator set config has invalid max commission, should be 0<=commission<=1")
+	}
+	return nil
+}
+
+// WeightBounds returns the minimum and maximum weight of a selected validator, unset or zero bounds are disabled
+func (c *ValidatorSetConfig) WeightBounds() (sdk.Dec, sdk.Dec) {
+	minWeight, maxWeight := sdk.ZeroDec(), sdk.OneDec()
+	if !c.MinWeight.IsNil() {
+		minWeight = c.MinWeight
+	}
+	if !c.MaxWeight.IsNil() && !c.MaxWeight.IsZero() {
+		maxWeight = c.MaxWeight
+	}
+	return minWeight, maxWeight
+}
+
+func (p *ValidatorSetProposal) Validate() error {
+	totalWeight := sdk.ZeroDec()
+	for _, validator := range p.Validators {
+		if _, _, err := bech32.DecodeAndConvert(validator.OperatorAddress); err != nil {
+			return err
+		}
+		if validator.Weight.IsNil() || validator.Weight.IsNegative() || validator.Weight.GT(sdk.OneDec()) {
+			return fmt.Errorf(
+				"validator set proposal for %s has an invalid weight for validator %s",
+				p.ChainId,
+				validator.OperatorAddress,
+			)
+		}
+		totalWeight = totalWeight.Add(validator.Weight)
+	}
+	if len(p.Validators) > 0 && !totalWeight.Equal(sdk.OneDec()) {
+		return fmt.Errorf("validator set proposal for %s weights add up to %s", p.ChainId, totalWeight)
+	}
+	if p.Epoch < 0 {
+		return fmt.Errorf("validator set proposal for %s has a negative epoch", p.ChainId)
+	}
+	return nil
+}
+
+func (r *ValidatorSetRound) Validate() error {
+	if r.Epoch < 0 {
+		return fmt.Errorf("validator set round for %s has a negative epoch", r.ChainId)
+	}
+	if r.SignedBlocksWindow < 0 {
+		return fmt.Errorf("validator set round for %s has a negative signed blocks window", r.ChainId)
+	}
+	if r.Verified > r.Candidates {
+		return fmt.Errorf("validator set round for %s has more verified candidates than candidates", r.ChainId)
+	}
+	return nil
+}
+
+func (c *ValidatorSetCandidate) Validate() error {
+	if _, _, err := bech32.DecodeAndConvert(c.OperatorAddress); err != nil {
+		return err
+	}
+	if c.ConsensusAddress != "" {
+		if _, _, err := bech32.DecodeAndConvert(c.ConsensusAddress); err != nil {
+			return err
+		}
+	}
+	if c.SigningInfoVerified && !c.ValidatorVerified {
+		return fmt.Errorf("validator set candidate %s has its signing info verified before its record", c.OperatorAddress)
+	}
+	if c.ValidatorVerified {
+		if c.ConsensusAddress == "" {
+			return fmt.Errorf("validator set candidate %s is verified without a consensus address", c.OperatorAddress)
+		}
+		if c.Tokens.IsNil() || c.Tokens.IsNegative() {
+			return fmt.Errorf("validator set candidate %s has invalid tokens", c.OperatorAddress)
+		}
+		for _, dec := range []sdk.Dec{c.Commission, c.DelegatorShares, c.LiquidShares, c.ValidatorBondShares} {
+			if dec.IsNil() || dec.IsNegative() {
+				return fmt.Errorf("validator set candidate %s has an invalid decimal field", c.OperatorAddress)
+			}
+		}
+	}
+	if c.MissedBlocksCounter < 0 {
+		return fmt.Errorf("validator set candidate %s has a negative missed blocks counter", c.OperatorAddress)
+	}
+	return nil
+}
+
+// IsVerified returns whether both the validator record and the signing info of a candidate are proof verified
+func (c *ValidatorSetCandidate) IsVerified() bool {
+	return c.ValidatorVerified && c.SigningInfoVerified
+}
+
+func (v *HostChainVote) Validate() error {
+	if v.ChainId == "" {
+		return fmt.Errorf("host chain vote has an empty chain id")
+	}
+	if v.ProposalId == 0 {
+		return fmt.Errorf("host chain vote for %s has an invalid proposal id", v.ChainId)
+	}
+	if v.Height < 0 {
+		return fmt.Errorf("host chain vote for %s has a negative height", v.ChainId)
+	}
+	return ValidateVoteOptions(v.Options)
+}
+
+func (s *VoteSignaling) Validate() error {
+	if s.ChainId == "" {
+		return fmt.Errorf("vote signaling has an empty chain id")
+	}
+	if s.ProposalId == 0 {
+		return fmt.Errorf("vote signaling for %s has an invalid proposal id", s.ChainId)
+	}
+	if s.EndTime.IsZero() {
+		return fmt.Errorf("vote signaling for %s has an empty end time", s.ChainId)
+	}
+	if !s.TotalPower.IsNil() && s.TotalPower.IsNegative() {
+		return fmt.Errorf("vote signaling for %s has a negative total power", s.ChainId)
+	}
+	for _, optionPower := range s.OptionPowers {
+		if optionPower.Power.IsNil() || optionPower.Power.IsNegative() {
+			return fmt.Errorf("vote signaling for %s has an invalid %s power", s.ChainId, optionPower.Option)
+		}
+	}
+	if s.Closed && !s.Tallied {
+		return fmt.Errorf("vote signaling for %s is closed without being tallied", s.ChainId)
+	}
+	if len(s.Tally) > 0 {
+		return ValidateVoteOptions(s.Tally)
+	}
+	return nil
+}
+
+func (s *VoteSignal) Validate() error {
+	if s.ChainId == "" {
+		return fmt.Errorf("vote signal has an empty chain id")
+	}
+	if s.ProposalId == 0 {
+		return fmt.Errorf("vote signal for %s has an invalid proposal id", s.ChainId)
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Signaler); err != nil {
+		return fmt.Errorf("vote signal for %s has an invalid signaler address: %w", s.ChainId, err)
+	}
+	if s.Power.IsNil() || !s.Power.IsPositive() {
+		return fmt.Errorf("vote signal for %s has a non positive power", s.ChainId)
+	}
+	return ValidateVoteOptions(s.Options)
+}
+
+func (u *TimelockedUpdate) Validate() error {
+	if u.Id == 0 {
+		return fmt.Errorf("timelocked update for %s has an invalid id", u.ChainId)
+	}
+	if len(u.Updates) == 0 {
+		return fmt.Errorf("timelocked update %d for %s has no updates", u.Id, u.ChainId)
+	}
+
+	// the validator set proposal to apply is pinned to the update when it is queued
+	updates := make([]*KVUpdate, 0, len(u.Updates))
+	for _, update := range u.Updates {
+		if update.Key == KeyApplyValidatorSet && update.Value != "" {
+			proposal, err := UnmarshalPinnedValidatorSetProposal(update.Value)
+			if err != nil {
+				return fmt.Errorf("timelocked update %d for %s is invalid: %w", u.Id, u.ChainId, err)
+			}
+			if proposal.ChainId != u.ChainId {
+				return fmt.Errorf("timelocked update %d for %s pins a proposal of %s", u.Id, u.ChainId, proposal.ChainId)
+			}
+			update = &KVUpdate{Key: update.Key}
+		}
+		updates = append(updates, update)
+	}
+
+	if err := NewMsgUpdateHostChain(u.ChainId, u.Authority, updates).ValidateBasic(); err != nil {
+		return fmt.Errorf("timelocked update %d for %s is invalid: %w", u.Id, u.ChainId, err)
+	}
+	return nil
+}
+
+// MustMarshalPinnedValidatorSetProposal returns the value of a timelocked validator set apply, pinning the proposal
+func MustMarshalPinnedValidatorSetProposal(proposal *ValidatorSetProposal) string {
+	bz, err := json.Marshal(proposal)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bz)
+}
+
+// UnmarshalPinnedValidatorSetProposal returns the validator set proposal pinned to a timelocked update
+func UnmarshalPinnedValidatorSetProposal(value string) (*ValidatorSetProposal, error) {
+	var proposal ValidatorSetProposal
+	if err := json.Unmarshal([]byte(value), &proposal); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal pinned validator set proposal")
+	}
+	if err := proposal.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &proposal, nil
+}
+
+func (u *TimelockEpochsUpdate) Validate() error {
+	if u.UpdateTimelockEpochs < 0 {
+		return fmt.Errorf("timelock epochs update has negative update timelock epochs %d", u.UpdateTimelockEpochs)
+	}
+	if _, err := sdk.AccAddressFromBech32(u.Authority); err != nil {
+		return fmt.Errorf("timelock epochs update has an invalid authority: %w", err)
+	}
+	return nil
+}
+
+// AddSignal accumulates the power of a signal into the option powers of the signaling
+func (s *VoteSignaling) AddSignal(signal *VoteSignal) {
+	s.addPower(signal.Options, signal.Power)
+}
+
+// RemoveSignal takes the power of a signal out of the option powers of the signaling, used when the signal is replaced
+func (s *VoteSignaling) RemoveSignal(signal *VoteSignal) {
+	s.addPower(signal.Options, signal.Power.Neg())
+}
+
+func (s *VoteSignaling) addPower(options []govv1beta1.WeightedVoteOption, power math.Int) {
+	if s.TotalPower.IsNil() {
+		s.TotalPower = sdk.ZeroInt()
+	}
+	s.TotalPower = s.TotalPower.Add(power)
+
+	for _, option := range options {
+		found := false
+		for i := range s.OptionPowers {
+			if s.OptionPowers[i].Option == option.Option {
+				s.OptionPowers[i].Power = s.OptionPowers[i].Power.Add(option.Weight.MulInt(power))
+				found = true
+				break
+			}
+		}
+		if !found {
+			s.OptionPowers = append(s.OptionPowers, VoteOptionPower{Option: option.Option, Power: option.Weight.MulInt(power)})
+		}
+	}
+}
+
+// CurrentTally turns the option powers of the signaling into a set of weighted vote options. The resulting weights add
+// up to exactly one, any rounding dust is given to the heaviest option. Returns no options when the total power is zero.
+func (s *VoteSignaling) CurrentTally() []govv1beta1.WeightedVoteOption {
+	if s.TotalPower.IsNil() || !s.TotalPower.IsPositive() {
+		return []govv1beta1.WeightedVoteOption{}
+	}
+
+	optionPowers := make(map[govv1beta1.VoteOption]sdk.Dec)
+	for _, optionPower := range s.OptionPowers {
+		optionPowers[optionPower.Option] = optionPower.Power
+	}
+
+	// iterate the options in their enum order so the tally is deterministic
+	options := make([]govv1beta1.WeightedVoteOption, 0)
+	totalWeight, heaviest := sdk.ZeroDec(), 0
+	for _, option := range []govv1beta1.VoteOption{
+		govv1beta1.OptionYes,
+		govv1beta1.OptionAbstain,
+		govv1beta1.OptionNo,
+		govv1beta1.OptionNoWithVeto,
+	} {
+		optionPower, ok := optionPowers[option]
+		if !ok {
+			continue
+		}
+		weight := optionPower.QuoInt(s.TotalPower)
+		if !weight.IsPositive() {
+			continue
+		}
+		if len(options) > 0 && weight.GT(options[heaviest].Weight) {
+			heaviest = len(options)
+		}
+		options = append(options, govv1beta1.WeightedVoteOption{Option: option, Weight: weight})
+		totalWeight = totalWeight.Add(weight)
+	}
+	if len(options) == 0 {
+		return options
+	}
+	options[heaviest].Weight = options[heaviest].Weight.Add(sdk.OneDec().Sub(totalWeight))
+
+	return options
+}
+
+// ValidateVoteOptions checks that a set of weighted vote options can be used in a host chain vote, the same way the
+// host chain governance module does
+func ValidateVoteOptions(options []govv1beta1.WeightedVoteOption) error {
+	if len(options) == 0 {
+		return errorsmod.Wrap(ErrInvalidVote, "no vote options")
+	}
+
+	totalWeight := sdk.ZeroDec()
+	usedOptions := make(map[govv1beta1.VoteOption]bool)
+	for _, option := range options {
+		if option.Weight.IsNil() || !govv1beta1.ValidWeightedVoteOption(option) {
+			return errorsmod.Wrapf(ErrInvalidVote, "invalid vote option %s", option.String())
+		}
+		if usedOptions[option.Option] {
+			return errorsmod.Wrapf(ErrInvalidVote, "duplicated vote option %s", option.Option)
+		}
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return errorsmod.Wrapf(ErrInvalidVote, "vote option weights add up to %s", totalWeight)
+	}
+
+	return nil
+}
@@ -8,9 +8,11 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	v1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -30,6 +32,103 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type HostChain_DelegationStrategy int32
+
+const (
+	// validators receive delegations proportional to their weight
+	HostChain_DELEGATION_STRATEGY_WEIGHT_PROPORTIONAL HostChain_DelegationStrategy = 0
+	// validators with weight receive the same share of the delegations
+	HostChain_DELEGATION_STRATEGY_EQUAL_SPLIT HostChain_DelegationStrategy = 1
+	// validators with weight receive delegations inversely proportional to their voting power
+	HostChain_DELEGATION_STRATEGY_STAKE_INVERSE HostChain_DelegationStrategy = 2
+	// validator weights are discounted by the commission they charge
+	HostChain_DELEGATION_STRATEGY_COMMISSION_AWARE HostChain_DelegationStrategy = 3
+)
+
+var HostChain_DelegationStrategy_name = map[int32]string{
+	0: "DELEGATION_STRATEGY_WEIGHT_PROPORTIONAL",
+	1: "DELEGATION_STRATEGY_EQUAL_SPLIT",
+	2: "DELEGATION_STRATEGY_STAKE_INVERSE",
+	3: "DELEGATION_STRATEGY_COMMISSION_AWARE",
+}
+
+var HostChain_DelegationStrategy_value = map[string]int32{
+	"DELEGATION_STRATEGY_WEIGHT_PROPORTIONAL": 0,
+	"DELEGATION_STRATEGY_EQUAL_SPLIT":         1,
+	"DELEGATION_STRATEGY_STAKE_INVERSE":       2,
+	"DELEGATION_STRATEGY_COMMISSION_AWARE":    3,
+}
+
+func (x HostChain_DelegationStrategy) String() string {
+	return proto.EnumName(HostChain_DelegationStrategy_name, int32(x))
+}
+
+func (HostChain_DelegationStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{0, 0}
+}
+
+type CValueCooldown_CooldownReason int32
+
+const (
+	// the c value left the host chain c value limits
+	CValueCooldown_COOLDOWN_REASON_OUT_OF_LIMITS CValueCooldown_CooldownReason = 0
+	// the c value changed more than the maximum c value delta
+	CValueCooldown_COOLDOWN_REASON_DELTA_EXCEEDED CValueCooldown_CooldownReason = 1
+)
+
+var CValueCooldown_CooldownReason_name = map[int32]string{
+	0: "COOLDOWN_REASON_OUT_OF_LIMITS",
+	1: "COOLDOWN_REASON_DELTA_EXCEEDED",
+}
+
+var CValueCooldown_CooldownReason_value = map[string]int32{
+	"COOLDOWN_REASON_OUT_OF_LIMITS":  0,
+	"COOLDOWN_REASON_DELTA_EXCEEDED": 1,
+}
+
+func (x CValueCooldown_CooldownReason) String() string {
+	return proto.EnumName(CValueCooldown_CooldownReason_name, int32(x))
+}
+
+func (CValueCooldown_CooldownReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{4, 0}
+}
+
+type Deregistration_DeregistrationState int32
+
+const (
+	// all the delegations are being undelegated from the host chain validators
+	Deregistration_DEREGISTRATION_UNBONDING Deregistration_DeregistrationState = 0
+	// the residual ICA balances are being returned to Persistence
+	Deregistration_DEREGISTRATION_RETURNING Deregistration_DeregistrationState = 1
+	// the settled unbondings are being claimed, for a bounded grace period
+	Deregistration_DEREGISTRATION_REDEEMING Deregistration_DeregistrationState = 2
+	// the host chain records are being deleted
+	Deregistration_DEREGISTRATION_DELETING Deregistration_DeregistrationState = 3
+)
+
+var Deregistration_DeregistrationState_name = map[int32]string{
+	0: "DEREGISTRATION_UNBONDING",
+	1: "DEREGISTRATION_RETURNING",
+	2: "DEREGISTRATION_REDEEMING",
+	3: "DEREGISTRATION_DELETING",
+}
+
+var Deregistration_DeregistrationState_value = map[string]int32{
+	"DEREGISTRATION_UNBONDING": 0,
+	"DEREGISTRATION_RETURNING": 1,
+	"DEREGISTRATION_REDEEMING": 2,
+	"DEREGISTRATION_DELETING":  3,
+}
+
+func (x Deregistration_DeregistrationState) String() string {
+	return proto.EnumName(Deregistration_DeregistrationState_name, int32(x))
+}
+
+func (Deregistration_DeregistrationState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{5, 0}
+}
+
 type ICAAccount_ChannelState int32
 
 const (
@@ -54,7 +153,7 @@ func (x ICAAccount_ChannelState) String() string {
 }
 
 func (ICAAccount_ChannelState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{3, 0}
+	return fileDescriptor_71a9a61e676043b6, []int{7, 0}
 }
 
 type Deposit_DepositState int32
@@ -89,7 +188,7 @@ func (x Deposit_DepositState) String() string {
 }
 
 func (Deposit_DepositState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{5, 0}
+	return fileDescriptor_71a9a61e676043b6, []int{9, 0}
 }
 
 type LSMDeposit_LSMDepositState int32
@@ -124,7 +223,7 @@ func (x LSMDeposit_LSMDepositState) String() string {
 }
 
 func (LSMDeposit_LSMDepositState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{6, 0}
+	return fileDescriptor_71a9a61e676043b6, []int{10, 0}
 }
 
 type Unbonding_UnbondingState int32
@@ -167,7 +266,65 @@ func (x Unbonding_UnbondingState) String() string {
 }
 
 func (Unbonding_UnbondingState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{7, 0}
+	return fileDescriptor_71a9a61e676043b6, []int{11, 0}
+}
+
+type Redelegation_RedelegationState int32
+
+const (
+	// redelegation has been sent to the host chain
+	Redelegation_REDELEGATION_INITIATED Redelegation_RedelegationState = 0
+	// redelegation is waiting for its completion time on the host chain
+	Redelegation_REDELEGATION_MATURING Redelegation_RedelegationState = 1
+)
+
+var Redelegation_RedelegationState_name = map[int32]string{
+	0: "REDELEGATION_INITIATED",
+	1: "REDELEGATION_MATURING",
+}
+
+var Redelegation_RedelegationState_value = map[string]int32{
+	"REDELEGATION_INITIATED": 0,
+	"REDELEGATION_MATURING":  1,
+}
+
+func (x Redelegation_RedelegationState) String() string {
+	return proto.EnumName(Redelegation_RedelegationState_name, int32(x))
+}
+
+func (Redelegation_RedelegationState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{14, 0}
+}
+
+type HostChainVote_VoteState int32
+
+const (
+	// vote has been sent to the host chain
+	HostChainVote_VOTE_INITIATED HostChainVote_VoteState = 0
+	// vote has been cast on the host chain
+	HostChainVote_VOTE_SUCCEEDED HostChainVote_VoteState = 1
+	// vote transaction failed or timed out on the host chain
+	HostChainVote_VOTE_FAILED HostChainVote_VoteState = 2
+)
+
+var HostChainVote_VoteState_name = map[int32]string{
+	0: "VOTE_INITIATED",
+	1: "VOTE_SUCCEEDED",
+	2: "VOTE_FAILED",
+}
+
+var HostChainVote_VoteState_value = map[string]int32{
+	"VOTE_INITIATED": 0,
+	"VOTE_SUCCEEDED": 1,
+	"VOTE_FAILED":    2,
+}
+
+func (x HostChainVote_VoteState) String() string {
+	return proto.EnumName(HostChainVote_VoteState_name, int32(x))
+}
+
+func (HostChainVote_VoteState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{23, 0}
 }
 
 type HostChain struct {
@@ -203,6 +360,22 @@ type HostChain struct {
 	AutoCompoundFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=auto_compound_factor,json=autoCompoundFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"auto_compound_factor"`
 	// host chain flags
 	Flags *HostChainFlags `protobuf:"bytes,16,opt,name=flags,proto3" json:"flags,omitempty"`
+	// strategy used to distribute delegations and undelegations among validators
+	DelegationStrategy HostChain_DelegationStrategy `protobuf:"varint,17,opt,name=delegation_strategy,json=delegationStrategy,proto3,enum=pstake.liquidstakeibc.v1beta1.HostChain_DelegationStrategy" json:"delegation_strategy,omitempty"`
+	// automated validator set selection, disabled if unset
+	ValidatorSetConfig *ValidatorSetConfig `protobuf:"bytes,18,opt,name=validator_set_config,json=validatorSetConfig,proto3" json:"validator_set_config,omitempty"`
+	// deregistration progress, unset if the host chain is not being deregistered
+	Deregistration *Deregistration `protobuf:"bytes,19,opt,name=deregistration,proto3" json:"deregistration,omitempty"`
+	// operations paused on the host chain, nothing is paused if unset
+	Pauses *HostChainPauses `protobuf:"bytes,20,opt,name=pauses,proto3" json:"pauses,omitempty"`
+	// c value circuit breaker cooldown, unset if the breaker is not tripped
+	CValueCooldown *CValueCooldown `protobuf:"bytes,21,opt,name=c_value_cooldown,json=cValueCooldown,proto3" json:"c_value_cooldown,omitempty"`
+	// host chain staking unbonding period, queried through ICQ, unset until the
+	// first query response
+	UnbondingPeriod time.Duration `protobuf:"bytes,22,opt,name=unbonding_period,json=unbondingPeriod,proto3,stdduration" json:"unbonding_period"`
+	// delegation epoch at which the host chain was registered, 0 for the host
+	// chains registered before it was recorded
+	RegistrationEpoch int64 `protobuf:"varint,23,opt,name=registration_epoch,json=registrationEpoch,proto3" json:"registration_epoch,omitempty"`
 }
 
 func (m *HostChain) Reset()         { *m = HostChain{} }
@@ -322,8 +495,59 @@ func (m *HostChain) GetFlags() *HostChainFlags {
 	return nil
 }
 
+func (m *HostChain) GetDelegationStrategy() HostChain_DelegationStrategy {
+	if m != nil {
+		return m.DelegationStrategy
+	}
+	return HostChain_DELEGATION_STRATEGY_WEIGHT_PROPORTIONAL
+}
+
+func (m *HostChain) GetValidatorSetConfig() *ValidatorSetConfig {
+	if m != nil {
+		return m.ValidatorSetConfig
+	}
+	return nil
+}
+
+func (m *HostChain) GetDeregistration() *Deregistration {
+	if m != nil {
+		return m.Deregistration
+	}
+	return nil
+}
+
+func (m *HostChain) GetPauses() *HostChainPauses {
+	if m != nil {
+		return m.Pauses
+	}
+	return nil
+}
+
+func (m *HostChain) GetCValueCooldown() *CValueCooldown {
+	if m != nil {
+		return m.CValueCooldown
+	}
+	return nil
+}
+
+func (m *HostChain) GetUnbondingPeriod() time.Duration {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
+}
+
+func (m *HostChain) GetRegistrationEpoch() int64 {
+	if m != nil {
+		return m.RegistrationEpoch
+	}
+	return 0
+}
+
 type HostChainFlags struct {
 	Lsm bool `protobuf:"varint,1,opt,name=lsm,proto3" json:"lsm,omitempty"`
+	// whether delegations are rebalanced every delegation epoch or not
+	Rebalance bool `protobuf:"varint,2,opt,name=rebalance,proto3" json:"rebalance,omitempty"`
 }
 
 func (m *HostChainFlags) Reset()         { *m = HostChainFlags{} }
@@ -366,26 +590,154 @@ func (m *HostChainFlags) GetLsm() bool {
 	return false
 }
 
+func (m *HostChainFlags) GetRebalance() bool {
+	if m != nil {
+		return m.Rebalance
+	}
+	return false
+}
+
+type HostChainPauses struct {
+	// whether liquid staking is paused or not
+	LiquidStake bool `protobuf:"varint,1,opt,name=liquid_stake,json=liquidStake,proto3" json:"liquid_stake,omitempty"`
+	// whether liquid staking LSM shares is paused or not
+	LsmStake bool `protobuf:"varint,2,opt,name=lsm_stake,json=lsmStake,proto3" json:"lsm_stake,omitempty"`
+	// whether liquid unstaking is paused or not
+	Unstake bool `protobuf:"varint,3,opt,name=unstake,proto3" json:"unstake,omitempty"`
+	// whether instant redemptions are paused or not
+	Redeem bool `protobuf:"varint,4,opt,name=redeem,proto3" json:"redeem,omitempty"`
+	// whether rewards auto-compounding is paused or not
+	Autocompound bool `protobuf:"varint,5,opt,name=autocompound,proto3" json:"autocompound,omitempty"`
+	// whether ICA transactions to the host chain are paused or not
+	IcaSends bool `protobuf:"varint,6,opt,name=ica_sends,json=icaSends,proto3" json:"ica_sends,omitempty"`
+}
+
+func (m *HostChainPauses) Reset()         { *m = HostChainPauses{} }
+func (m *HostChainPauses) String() string { return proto.CompactTextString(m) }
+func (*HostChainPauses) ProtoMessage()    {}
+func (*HostChainPauses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{2}
+}
+func (m *HostChainPauses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostChainPauses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostChainPauses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostChainPauses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostChainPauses.Merge(m, src)
+}
+func (m *HostChainPauses) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostChainPauses) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostChainPauses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostChainPauses proto.InternalMessageInfo
+
+func (m *HostChainPauses) GetLiquidStake() bool {
+	if m != nil {
+		return m.LiquidStake
+	}
+	return false
+}
+
+func (m *HostChainPauses) GetLsmStake() bool {
+	if m != nil {
+		return m.LsmStake
+	}
+	return false
+}
+
+func (m *HostChainPauses) GetUnstake() bool {
+	if m != nil {
+		return m.Unstake
+	}
+	return false
+}
+
+func (m *HostChainPauses) GetRedeem() bool {
+	if m != nil {
+		return m.Redeem
+	}
+	return false
+}
+
+func (m *HostChainPauses) GetAutocompound() bool {
+	if m != nil {
+		return m.Autocompound
+	}
+	return false
+}
+
+func (m *HostChainPauses) GetIcaSends() bool {
+	if m != nil {
+		return m.IcaSends
+	}
+	return false
+}
+
 type HostChainLSParams struct {
 	DepositFee    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=deposit_fee,json=depositFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"deposit_fee"`
 	RestakeFee    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=restake_fee,json=restakeFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"restake_fee"`
 	UnstakeFee    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=unstake_fee,json=unstakeFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unstake_fee"`
 	RedemptionFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=redemption_fee,json=redemptionFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redemption_fee"`
 	// LSM validator cap
-	//
-	//	Should be used only when HostChainFlag.Lsm == true, orelse default
+	//  Should be used only when HostChainFlag.Lsm == true, orelse default
 	LsmValidatorCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=lsm_validator_cap,json=lsmValidatorCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lsm_validator_cap"`
 	// LSM bond factor
-	//
-	//	Should be used only when HostChainFlag.Lsm == true, orelse default
+	//  Should be used only when HostChainFlag.Lsm == true, orelse default
 	LsmBondFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=lsm_bond_factor,json=lsmBondFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lsm_bond_factor"`
+	// maximum number of in-flight redelegations between the same pair of validators,
+	// should match the host chain staking max entries, orelse default
+	MaxRedelegationEntries uint32 `protobuf:"varint,8,opt,name=max_redelegation_entries,json=maxRedelegationEntries,proto3" json:"max_redelegation_entries,omitempty"`
+	// maximum amount of host chain tokens that can be liquid staked in total, zero disables the cap
+	MaxTvl github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=max_tvl,json=maxTvl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_tvl"`
+	// maximum amount of host chain tokens that can be liquid staked during a
+	// delegation epoch, zero disables the cap
+	MaxEpochInflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=max_epoch_inflow,json=maxEpochInflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_epoch_inflow"`
+	// maximum amount of host chain tokens that a single address can liquid stake
+	// during a delegation epoch, zero disables the cap
+	MaxAddressEpochInflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=max_address_epoch_inflow,json=maxAddressEpochInflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_address_epoch_inflow"`
+	// maximum amount of host chain tokens that can be unbonded in a single unbonding
+	// epoch through liquid unstakes, zero disables the cap
+	MaxEpochUnstake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=max_epoch_unstake,json=maxEpochUnstake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_epoch_unstake"`
+	// maximum ratio of the deposit account balance that can be instantly redeemed
+	// during a delegation epoch, zero disables the cap
+	MaxEpochRedeemRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=max_epoch_redeem_ratio,json=maxEpochRedeemRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_epoch_redeem_ratio"`
+	// ratio of a validator delegation that, once slashed in a single event, zeroes
+	// the validator weight, zero disables it
+	SlashThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_threshold,json=slashThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_threshold"`
+	// validator commission rate above which a validator is not delegable anymore,
+	// zero disables it
+	MaxValidatorCommission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=max_validator_commission,json=maxValidatorCommission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_commission"`
+	// upper limit of the host chain c value, the module limit is used if zero
+	UpperCValueLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=upper_c_value_limit,json=upperCValueLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"upper_c_value_limit"`
+	// lower limit of the host chain c value, the module limit is used if zero
+	LowerCValueLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=lower_c_value_limit,json=lowerCValueLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lower_c_value_limit"`
+	// maximum relative change of the c value between two computations,
+	// zero disables it
+	MaxCValueDelta github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=max_c_value_delta,json=maxCValueDelta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_c_value_delta"`
+	// consecutive in-range c value computations needed to leave the cooldown,
+	// zero disables the automatic recovery
+	CValueRecoveryCount uint32 `protobuf:"varint,19,opt,name=c_value_recovery_count,json=cValueRecoveryCount,proto3" json:"c_value_recovery_count,omitempty"`
 }
 
 func (m *HostChainLSParams) Reset()         { *m = HostChainLSParams{} }
 func (m *HostChainLSParams) String() string { return proto.CompactTextString(m) }
 func (*HostChainLSParams) ProtoMessage()    {}
 func (*HostChainLSParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{2}
+	return fileDescriptor_71a9a61e676043b6, []int{3}
 }
 func (m *HostChainLSParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,28 +766,43 @@ func (m *HostChainLSParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HostChainLSParams proto.InternalMessageInfo
 
-type ICAAccount struct {
-	// address of the ica on the controller chain
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// token balance of the ica
-	Balance types.Coin `protobuf:"bytes,2,opt,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"balance"`
-	// owner string
-	Owner        string                  `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
-	ChannelState ICAAccount_ChannelState `protobuf:"varint,4,opt,name=channel_state,json=channelState,proto3,enum=pstake.liquidstakeibc.v1beta1.ICAAccount_ChannelState" json:"channel_state,omitempty"`
+func (m *HostChainLSParams) GetMaxRedelegationEntries() uint32 {
+	if m != nil {
+		return m.MaxRedelegationEntries
+	}
+	return 0
 }
 
-func (m *ICAAccount) Reset()         { *m = ICAAccount{} }
-func (m *ICAAccount) String() string { return proto.CompactTextString(m) }
-func (*ICAAccount) ProtoMessage()    {}
-func (*ICAAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{3}
+func (m *HostChainLSParams) GetCValueRecoveryCount() uint32 {
+	if m != nil {
+		return m.CValueRecoveryCount
+	}
+	return 0
 }
-func (m *ICAAccount) XXX_Unmarshal(b []byte) error {
+
+type CValueCooldown struct {
+	// reason why the circuit breaker was tripped
+	Reason CValueCooldown_CooldownReason `protobuf:"varint,1,opt,name=reason,proto3,enum=pstake.liquidstakeibc.v1beta1.CValueCooldown_CooldownReason" json:"reason,omitempty"`
+	// c value that tripped the circuit breaker
+	CValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=c_value,json=cValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"c_value"`
+	// block height at which the circuit breaker was tripped
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// consecutive in-range c value computations since the circuit breaker was tripped
+	InRangeCount uint32 `protobuf:"varint,4,opt,name=in_range_count,json=inRangeCount,proto3" json:"in_range_count,omitempty"`
+}
+
+func (m *CValueCooldown) Reset()         { *m = CValueCooldown{} }
+func (m *CValueCooldown) String() string { return proto.CompactTextString(m) }
+func (*CValueCooldown) ProtoMessage()    {}
+func (*CValueCooldown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{4}
+}
+func (m *CValueCooldown) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ICAAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CValueCooldown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ICAAccount.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CValueCooldown.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -445,75 +812,62 @@ func (m *ICAAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ICAAccount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ICAAccount.Merge(m, src)
+func (m *CValueCooldown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CValueCooldown.Merge(m, src)
 }
-func (m *ICAAccount) XXX_Size() int {
+func (m *CValueCooldown) XXX_Size() int {
 	return m.Size()
 }
-func (m *ICAAccount) XXX_DiscardUnknown() {
-	xxx_messageInfo_ICAAccount.DiscardUnknown(m)
+func (m *CValueCooldown) XXX_DiscardUnknown() {
+	xxx_messageInfo_CValueCooldown.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ICAAccount proto.InternalMessageInfo
-
-func (m *ICAAccount) GetAddress() string {
-	if m != nil {
-		return m.Address
-	}
-	return ""
-}
+var xxx_messageInfo_CValueCooldown proto.InternalMessageInfo
 
-func (m *ICAAccount) GetBalance() types.Coin {
+func (m *CValueCooldown) GetReason() CValueCooldown_CooldownReason {
 	if m != nil {
-		return m.Balance
+		return m.Reason
 	}
-	return types.Coin{}
+	return CValueCooldown_COOLDOWN_REASON_OUT_OF_LIMITS
 }
 
-func (m *ICAAccount) GetOwner() string {
+func (m *CValueCooldown) GetHeight() int64 {
 	if m != nil {
-		return m.Owner
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-func (m *ICAAccount) GetChannelState() ICAAccount_ChannelState {
+func (m *CValueCooldown) GetInRangeCount() uint32 {
 	if m != nil {
-		return m.ChannelState
+		return m.InRangeCount
 	}
-	return ICAAccount_ICA_CHANNEL_CREATING
+	return 0
 }
 
-type Validator struct {
-	// valoper address
-	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
-	// validator status
-	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	// validator weight in the set
-	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
-	// amount delegated by the module to the validator
-	DelegatedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=delegated_amount,json=delegatedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegated_amount"`
-	// the validator token exchange rate, total bonded tokens divided by total shares issued
-	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
-	// the unbonding epoch number when the validator transitioned into the state
-	UnbondingEpoch int64 `protobuf:"varint,6,opt,name=unbonding_epoch,json=unbondingEpoch,proto3" json:"unbonding_epoch,omitempty"`
-	// whether the validator can accept delegations or not, default true for non-lsm chains
-	Delegable bool `protobuf:"varint,7,opt,name=delegable,proto3" json:"delegable,omitempty"`
+type Deregistration struct {
+	// state of the deregistration
+	State Deregistration_DeregistrationState `protobuf:"varint,1,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.Deregistration_DeregistrationState" json:"state,omitempty"`
+	// last block height at which the residual ICA balances were queried or transferred back
+	LastSweepHeight int64 `protobuf:"varint,2,opt,name=last_sweep_height,json=lastSweepHeight,proto3" json:"last_sweep_height,omitempty"`
+	// number of residual ICA balance queries that haven't been answered yet
+	PendingBalanceQueries uint32 `protobuf:"varint,3,opt,name=pending_balance_queries,json=pendingBalanceQueries,proto3" json:"pending_balance_queries,omitempty"`
+	// delegation epoch in which the settled unbondings started being claimed
+	RedeemingEpoch int64 `protobuf:"varint,4,opt,name=redeeming_epoch,json=redeemingEpoch,proto3" json:"redeeming_epoch,omitempty"`
 }
 
-func (m *Validator) Reset()         { *m = Validator{} }
-func (m *Validator) String() string { return proto.CompactTextString(m) }
-func (*Validator) ProtoMessage()    {}
-func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{4}
+func (m *Deregistration) Reset()         { *m = Deregistration{} }
+func (m *Deregistration) String() string { return proto.CompactTextString(m) }
+func (*Deregistration) ProtoMessage()    {}
+func (*Deregistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{5}
 }
-func (m *Validator) XXX_Unmarshal(b []byte) error {
+func (m *Deregistration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Deregistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Validator.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Deregistration.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -523,52 +877,301 @@ func (m *Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Validator) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Validator.Merge(m, src)
+func (m *Deregistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Deregistration.Merge(m, src)
 }
-func (m *Validator) XXX_Size() int {
+func (m *Deregistration) XXX_Size() int {
 	return m.Size()
 }
-func (m *Validator) XXX_DiscardUnknown() {
-	xxx_messageInfo_Validator.DiscardUnknown(m)
+func (m *Deregistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_Deregistration.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Validator proto.InternalMessageInfo
+var xxx_messageInfo_Deregistration proto.InternalMessageInfo
 
-func (m *Validator) GetOperatorAddress() string {
+func (m *Deregistration) GetState() Deregistration_DeregistrationState {
 	if m != nil {
-		return m.OperatorAddress
+		return m.State
 	}
-	return ""
+	return Deregistration_DEREGISTRATION_UNBONDING
 }
 
-func (m *Validator) GetStatus() string {
+func (m *Deregistration) GetLastSweepHeight() int64 {
 	if m != nil {
-		return m.Status
+		return m.LastSweepHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *Validator) GetUnbondingEpoch() int64 {
+func (m *Deregistration) GetPendingBalanceQueries() uint32 {
 	if m != nil {
-		return m.UnbondingEpoch
+		return m.PendingBalanceQueries
 	}
 	return 0
 }
 
-func (m *Validator) GetDelegable() bool {
+func (m *Deregistration) GetRedeemingEpoch() int64 {
 	if m != nil {
-		return m.Delegable
+		return m.RedeemingEpoch
 	}
-	return false
+	return 0
 }
 
-type Deposit struct {
-	// deposit target chain
-	ChainId string     `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Amount  types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
-	// epoch number of the deposit
-	Epoch int64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+type ValidatorSetConfig struct {
+	// whether the validator set is periodically selected from the host chain bonded set
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// whether the selected weights are applied right away or only proposed
+	AutoApply bool `protobuf:"varint,2,opt,name=auto_apply,json=autoApply,proto3" json:"auto_apply,omitempty"`
+	// maximum number of validators selected
+	MaxValidators uint32 `protobuf:"varint,3,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
+	// minimum weight of a selected validator
+	MinWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_weight,json=minWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_weight"`
+	// maximum weight of a selected validator, zero disables the bound
+	MaxWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_weight,json=maxWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_weight"`
+	// maximum commission of a selected validator, zero disables the bound
+	MaxCommission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_commission,json=maxCommission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_commission"`
+}
+
+func (m *ValidatorSetConfig) Reset()         { *m = ValidatorSetConfig{} }
+func (m *ValidatorSetConfig) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetConfig) ProtoMessage()    {}
+func (*ValidatorSetConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{6}
+}
+func (m *ValidatorSetConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSetConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetConfig.Merge(m, src)
+}
+func (m *ValidatorSetConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetConfig proto.InternalMessageInfo
+
+func (m *ValidatorSetConfig) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *ValidatorSetConfig) GetAutoApply() bool {
+	if m != nil {
+		return m.AutoApply
+	}
+	return false
+}
+
+func (m *ValidatorSetConfig) GetMaxValidators() uint32 {
+	if m != nil {
+		return m.MaxValidators
+	}
+	return 0
+}
+
+type ICAAccount struct {
+	// address of the ica on the controller chain
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// token balance of the ica
+	Balance types.Coin `protobuf:"bytes,2,opt,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"balance"`
+	// owner string
+	Owner        string                  `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	ChannelState ICAAccount_ChannelState `protobuf:"varint,4,opt,name=channel_state,json=channelState,proto3,enum=pstake.liquidstakeibc.v1beta1.ICAAccount_ChannelState" json:"channel_state,omitempty"`
+}
+
+func (m *ICAAccount) Reset()         { *m = ICAAccount{} }
+func (m *ICAAccount) String() string { return proto.CompactTextString(m) }
+func (*ICAAccount) ProtoMessage()    {}
+func (*ICAAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{7}
+}
+func (m *ICAAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ICAAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ICAAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ICAAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICAAccount.Merge(m, src)
+}
+func (m *ICAAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ICAAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICAAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICAAccount proto.InternalMessageInfo
+
+func (m *ICAAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ICAAccount) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
+func (m *ICAAccount) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ICAAccount) GetChannelState() ICAAccount_ChannelState {
+	if m != nil {
+		return m.ChannelState
+	}
+	return ICAAccount_ICA_CHANNEL_CREATING
+}
+
+type Validator struct {
+	// valoper address
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// validator status
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// validator weight in the set
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+	// amount delegated by the module to the validator
+	DelegatedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=delegated_amount,json=delegatedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegated_amount"`
+	// the validator token exchange rate, total bonded tokens divided by total shares issued
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+	// the unbonding epoch number when the validator transitioned into the state
+	UnbondingEpoch int64 `protobuf:"varint,6,opt,name=unbonding_epoch,json=unbondingEpoch,proto3" json:"unbonding_epoch,omitempty"`
+	// whether the validator can accept delegations or not, default true for non-lsm chains
+	Delegable bool `protobuf:"varint,7,opt,name=delegable,proto3" json:"delegable,omitempty"`
+	// validator commission rate on the host chain
+	Commission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=commission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission"`
+	// total tokens bonded to the validator on the host chain
+	Tokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens"`
+	// whether the validator is jailed on the host chain
+	Jailed bool `protobuf:"varint,10,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// whether the validator is tombstoned on the host chain
+	Tombstoned bool `protobuf:"varint,11,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	// validator consensus address, used to query its signing info
+	ConsensusAddress string `protobuf:"bytes,12,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+}
+
+func (m *Validator) Reset()         { *m = Validator{} }
+func (m *Validator) String() string { return proto.CompactTextString(m) }
+func (*Validator) ProtoMessage()    {}
+func (*Validator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{8}
+}
+func (m *Validator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Validator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Validator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Validator.Merge(m, src)
+}
+func (m *Validator) XXX_Size() int {
+	return m.Size()
+}
+func (m *Validator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Validator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Validator proto.InternalMessageInfo
+
+func (m *Validator) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *Validator) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Validator) GetUnbondingEpoch() int64 {
+	if m != nil {
+		return m.UnbondingEpoch
+	}
+	return 0
+}
+
+func (m *Validator) GetDelegable() bool {
+	if m != nil {
+		return m.Delegable
+	}
+	return false
+}
+
+func (m *Validator) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *Validator) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
+}
+
+func (m *Validator) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
+type Deposit struct {
+	// deposit target chain
+	ChainId string     `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// epoch number of the deposit
+	Epoch int64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	// state
 	State Deposit_DepositState `protobuf:"varint,4,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.Deposit_DepositState" json:"state,omitempty"`
 	// sequence id of the ibc transaction
@@ -579,7 +1182,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{5}
+	return fileDescriptor_71a9a61e676043b6, []int{9}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,7 +1270,7 @@ func (m *LSMDeposit) Reset()         { *m = LSMDeposit{} }
 func (m *LSMDeposit) String() string { return proto.CompactTextString(m) }
 func (*LSMDeposit) ProtoMessage()    {}
 func (*LSMDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{6}
+	return fileDescriptor_71a9a61e676043b6, []int{10}
 }
 func (m *LSMDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -759,7 +1362,7 @@ func (m *Unbonding) Reset()         { *m = Unbonding{} }
 func (m *Unbonding) String() string { return proto.CompactTextString(m) }
 func (*Unbonding) ProtoMessage()    {}
 func (*Unbonding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{7}
+	return fileDescriptor_71a9a61e676043b6, []int{11}
 }
 func (m *Unbonding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -854,7 +1457,7 @@ func (m *UserUnbonding) Reset()         { *m = UserUnbonding{} }
 func (m *UserUnbonding) String() string { return proto.CompactTextString(m) }
 func (*UserUnbonding) ProtoMessage()    {}
 func (*UserUnbonding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{8}
+	return fileDescriptor_71a9a61e676043b6, []int{12}
 }
 func (m *UserUnbonding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -937,7 +1540,7 @@ func (m *ValidatorUnbonding) Reset()         { *m = ValidatorUnbonding{} }
 func (m *ValidatorUnbonding) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUnbonding) ProtoMessage()    {}
 func (*ValidatorUnbonding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{9}
+	return fileDescriptor_71a9a61e676043b6, []int{13}
 }
 func (m *ValidatorUnbonding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1008,23 +1611,35 @@ func (m *ValidatorUnbonding) GetIbcSequenceId() string {
 	return ""
 }
 
-type KVUpdate struct {
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+type Redelegation struct {
+	// redelegation target chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// address of the validator the tokens are moved from
+	SrcValidatorAddress string `protobuf:"bytes,2,opt,name=src_validator_address,json=srcValidatorAddress,proto3" json:"src_validator_address,omitempty"`
+	// address of the validator the tokens are moved to
+	DstValidatorAddress string `protobuf:"bytes,3,opt,name=dst_validator_address,json=dstValidatorAddress,proto3" json:"dst_validator_address,omitempty"`
+	// amount of tokens being redelegated
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// time when the redelegation completes on the host chain
+	CompletionTime time.Time `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// sequence id of the ibc transaction
+	IbcSequenceId string `protobuf:"bytes,6,opt,name=ibc_sequence_id,json=ibcSequenceId,proto3" json:"ibc_sequence_id,omitempty"`
+	// state of the redelegation
+	State Redelegation_RedelegationState `protobuf:"varint,7,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.Redelegation_RedelegationState" json:"state,omitempty"`
 }
 
-func (m *KVUpdate) Reset()         { *m = KVUpdate{} }
-func (m *KVUpdate) String() string { return proto.CompactTextString(m) }
-func (*KVUpdate) ProtoMessage()    {}
-func (*KVUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{10}
+func (m *Redelegation) Reset()         { *m = Redelegation{} }
+func (m *Redelegation) String() string { return proto.CompactTextString(m) }
+func (*Redelegation) ProtoMessage()    {}
+func (*Redelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{14}
 }
-func (m *KVUpdate) XXX_Unmarshal(b []byte) error {
+func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *KVUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Redelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_KVUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Redelegation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1034,1290 +1649,10521 @@ func (m *KVUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *KVUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_KVUpdate.Merge(m, src)
+func (m *Redelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Redelegation.Merge(m, src)
 }
-func (m *KVUpdate) XXX_Size() int {
+func (m *Redelegation) XXX_Size() int {
 	return m.Size()
 }
-func (m *KVUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_KVUpdate.DiscardUnknown(m)
+func (m *Redelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Redelegation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_KVUpdate proto.InternalMessageInfo
+var xxx_messageInfo_Redelegation proto.InternalMessageInfo
 
-func (m *KVUpdate) GetKey() string {
+func (m *Redelegation) GetChainId() string {
 	if m != nil {
-		return m.Key
+		return m.ChainId
 	}
 	return ""
 }
 
-func (m *KVUpdate) GetValue() string {
+func (m *Redelegation) GetSrcValidatorAddress() string {
 	if m != nil {
-		return m.Value
+		return m.SrcValidatorAddress
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.ICAAccount_ChannelState", ICAAccount_ChannelState_name, ICAAccount_ChannelState_value)
-	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.Deposit_DepositState", Deposit_DepositState_name, Deposit_DepositState_value)
-	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.LSMDeposit_LSMDepositState", LSMDeposit_LSMDepositState_name, LSMDeposit_LSMDepositState_value)
-	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.Unbonding_UnbondingState", Unbonding_UnbondingState_name, Unbonding_UnbondingState_value)
-	proto.RegisterType((*HostChain)(nil), "pstake.liquidstakeibc.v1beta1.HostChain")
-	proto.RegisterType((*HostChainFlags)(nil), "pstake.liquidstakeibc.v1beta1.HostChainFlags")
-	proto.RegisterType((*HostChainLSParams)(nil), "pstake.liquidstakeibc.v1beta1.HostChainLSParams")
-	proto.RegisterType((*ICAAccount)(nil), "pstake.liquidstakeibc.v1beta1.ICAAccount")
-	proto.RegisterType((*Validator)(nil), "pstake.liquidstakeibc.v1beta1.Validator")
-	proto.RegisterType((*Deposit)(nil), "pstake.liquidstakeibc.v1beta1.Deposit")
-	proto.RegisterType((*LSMDeposit)(nil), "pstake.liquidstakeibc.v1beta1.LSMDeposit")
-	proto.RegisterType((*Unbonding)(nil), "pstake.liquidstakeibc.v1beta1.Unbonding")
-	proto.RegisterType((*UserUnbonding)(nil), "pstake.liquidstakeibc.v1beta1.UserUnbonding")
-	proto.RegisterType((*ValidatorUnbonding)(nil), "pstake.liquidstakeibc.v1beta1.ValidatorUnbonding")
-	proto.RegisterType((*KVUpdate)(nil), "pstake.liquidstakeibc.v1beta1.KVUpdate")
+func (m *Redelegation) GetDstValidatorAddress() string {
+	if m != nil {
+		return m.DstValidatorAddress
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("pstake/liquidstakeibc/v1beta1/liquidstakeibc.proto", fileDescriptor_71a9a61e676043b6)
+func (m *Redelegation) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-var fileDescriptor_71a9a61e676043b6 = []byte{
-	// 1560 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0x8f, 0xbf, 0xed, 0x67, 0x3b, 0xde, 0x4c, 0x43, 0xeb, 0x16, 0x9a, 0x04, 0x23, 0xb5, 0xa9,
-	0x50, 0x6c, 0xea, 0x4a, 0x54, 0x20, 0x84, 0x70, 0xec, 0x4d, 0xb3, 0x6a, 0xe2, 0x54, 0x1b, 0x27,
-	0x42, 0xad, 0x60, 0x35, 0xde, 0x9d, 0xd8, 0xab, 0x78, 0x77, 0xdd, 0x9d, 0x75, 0xda, 0xde, 0xb8,
-	0x71, 0xed, 0x09, 0x71, 0x42, 0x9c, 0x39, 0x71, 0xe8, 0x3f, 0xc0, 0xad, 0x12, 0x97, 0xd2, 0x13,
-	0x42, 0xa8, 0x45, 0xad, 0xf8, 0x3f, 0xd0, 0x7c, 0xac, 0xed, 0xa4, 0x55, 0xed, 0x28, 0x3e, 0x70,
-	0xf2, 0xcc, 0x7b, 0xfb, 0x7e, 0x33, 0xf3, 0xe6, 0xf7, 0x3e, 0xc6, 0x50, 0xed, 0xd3, 0x00, 0x1f,
-	0x92, 0x4a, 0xcf, 0xbe, 0x3f, 0xb0, 0x2d, 0x3e, 0xb6, 0xdb, 0x66, 0xe5, 0xe8, 0x7a, 0x9b, 0x04,
-	0xf8, 0xfa, 0x09, 0x71, 0xb9, 0xef, 0x7b, 0x81, 0x87, 0x2e, 0x0b, 0x9b, 0xf2, 0x09, 0xa5, 0xb4,
-	0xb9, 0xb4, 0xd8, 0xf1, 0x3a, 0x1e, 0xff, 0xb2, 0xc2, 0x46, 0xc2, 0xe8, 0xd2, 0x45, 0xd3, 0xa3,
-	0x8e, 0x47, 0x0d, 0xa1, 0x10, 0x13, 0xa9, 0x5a, 0x12, 0xb3, 0x4a, 0x1b, 0x53, 0x32, 0x5c, 0xd9,
-	0xf4, 0x6c, 0x57, 0xea, 0x97, 0x3b, 0x9e, 0xd7, 0xe9, 0x91, 0x0a, 0x9f, 0xb5, 0x07, 0x07, 0x95,
-	0xc0, 0x76, 0x08, 0x0d, 0xb0, 0xd3, 0x17, 0x1f, 0x94, 0xbe, 0x4b, 0x43, 0x66, 0xd3, 0xa3, 0x41,
-	0xbd, 0x8b, 0x6d, 0x17, 0x5d, 0x84, 0xb4, 0xc9, 0x06, 0x86, 0x6d, 0x15, 0x23, 0x2b, 0x91, 0xd5,
-	0x8c, 0x9e, 0xe2, 0x73, 0xcd, 0x42, 0x1f, 0x41, 0xde, 0xf4, 0x5c, 0x97, 0x98, 0x81, 0xed, 0x71,
-	0x7d, 0x94, 0xeb, 0x73, 0x23, 0xa1, 0x66, 0xa1, 0x4d, 0x48, 0xf6, 0xb1, 0x8f, 0x1d, 0x5a, 0x8c,
-	0xad, 0x44, 0x56, 0xb3, 0xd5, 0x4f, 0xca, 0xef, 0x3c, 0x6f, 0x79, 0xb8, 0xf2, 0xd6, 0xee, 0x1d,
-	0x6e, 0xa7, 0x4b, 0x7b, 0x74, 0x19, 0xa0, 0xeb, 0xd1, 0xc0, 0xb0, 0x88, 0xeb, 0x39, 0xc5, 0x38,
-	0x5f, 0x2b, 0xc3, 0x24, 0x0d, 0x26, 0x60, 0x6a, 0xb3, 0x8b, 0x5d, 0x97, 0xf4, 0xd8, 0x56, 0x12,
-	0x42, 0x2d, 0x25, 0x9a, 0x85, 0x2e, 0x40, 0xaa, 0xef, 0xf9, 0x01, 0xd3, 0x25, 0xb9, 0x2e, 0xc9,
-	0xa6, 0x9a, 0x85, 0xbe, 0x06, 0x64, 0x91, 0x1e, 0xe9, 0x60, 0x7e, 0x0a, 0x6c, 0x9a, 0xde, 0xc0,
-	0x0d, 0x8a, 0x29, 0xbe, 0xd9, 0x6b, 0x13, 0x36, 0xab, 0xd5, 0x6b, 0x35, 0x61, 0xa0, 0x2f, 0x8c,
-	0x40, 0xa4, 0x08, 0xe9, 0x50, 0xf0, 0xc9, 0x03, 0xec, 0x5b, 0x74, 0x08, 0x9b, 0x3e, 0x2d, 0xec,
-	0xbc, 0x44, 0x08, 0x31, 0x37, 0x01, 0x8e, 0x70, 0xcf, 0xb6, 0x70, 0xe0, 0xf9, 0xb4, 0x98, 0x59,
-	0x89, 0xad, 0x66, 0xab, 0xab, 0x13, 0xe0, 0xf6, 0x43, 0x03, 0x7d, 0xcc, 0x16, 0x11, 0x28, 0x38,
-	0xb6, 0x6b, 0x3b, 0x03, 0xc7, 0xb0, 0x48, 0xdf, 0xa3, 0x76, 0x50, 0x04, 0xe6, 0x98, 0xf5, 0x2f,
-	0x9e, 0xbe, 0x58, 0x9e, 0xfb, 0xeb, 0xc5, 0xf2, 0x95, 0x8e, 0x1d, 0x74, 0x07, 0xed, 0xb2, 0xe9,
-	0x39, 0x92, 0x61, 0xf2, 0x67, 0x8d, 0x5a, 0x87, 0x95, 0xe0, 0x51, 0x9f, 0xd0, 0xb2, 0xe6, 0x06,
-	0xcf, 0x9f, 0xac, 0x81, 0x24, 0xa0, 0xc6, 0x36, 0x2c, 0x41, 0x1b, 0x02, 0x13, 0xed, 0x41, 0xca,
-	0x34, 0x8e, 0x70, 0x6f, 0x40, 0x8a, 0xd9, 0x53, 0xc3, 0x37, 0x88, 0x39, 0x06, 0xdf, 0x20, 0xa6,
-	0x9e, 0x34, 0xf7, 0x19, 0x16, 0xfa, 0x16, 0x72, 0x3d, 0x4c, 0x03, 0x23, 0xc4, 0xce, 0xcd, 0x00,
-	0x1b, 0x18, 0x62, 0x5d, 0xe0, 0x5f, 0x03, 0x65, 0xe0, 0xb6, 0x3d, 0xd7, 0xb2, 0xdd, 0x8e, 0x71,
-	0x80, 0xcd, 0xc0, 0xf3, 0x8b, 0xf9, 0x95, 0xc8, 0x6a, 0x4c, 0x2f, 0x0c, 0xe5, 0x1b, 0x5c, 0x8c,
-	0xce, 0x43, 0x12, 0x9b, 0x81, 0x7d, 0x44, 0x8a, 0xf3, 0x2b, 0x91, 0xd5, 0xb4, 0x2e, 0x67, 0xc8,
-	0x85, 0x45, 0x3c, 0x08, 0x3c, 0xc3, 0xf4, 0x9c, 0xbe, 0x37, 0x70, 0xad, 0x10, 0xa6, 0x30, 0x83,
-	0xad, 0x22, 0x86, 0x5c, 0x97, 0xc0, 0x72, 0x1f, 0x75, 0x48, 0x1c, 0xf4, 0x70, 0x87, 0x16, 0x15,
-	0x4e, 0xb2, 0xb5, 0x69, 0x03, 0x6d, 0x83, 0x19, 0xe9, 0xc2, 0xf6, 0xf3, 0xf8, 0x8f, 0x3f, 0x2f,
-	0x47, 0x4a, 0x25, 0x98, 0x3f, 0xae, 0x46, 0x0a, 0xc4, 0x7a, 0xd4, 0xe1, 0x19, 0x20, 0xad, 0xb3,
-	0x61, 0xe9, 0xdf, 0x38, 0x2c, 0xbc, 0x11, 0xac, 0xe8, 0x1b, 0xc8, 0x4a, 0x36, 0x19, 0x07, 0x84,
-	0x88, 0x8c, 0x71, 0xd6, 0x6b, 0x91, 0x80, 0x1b, 0x84, 0x30, 0x78, 0x9f, 0xf0, 0xa3, 0x70, 0xf8,
-	0xe8, 0x2c, 0xe0, 0x25, 0xa0, 0x84, 0x1f, 0xb8, 0x23, 0xf8, 0xd8, 0x2c, 0xe0, 0x25, 0x20, 0x83,
-	0x37, 0x61, 0xde, 0x27, 0x16, 0x71, 0xfa, 0x3c, 0xd5, 0xb0, 0x15, 0xe2, 0x33, 0x58, 0x21, 0x3f,
-	0xc2, 0x64, 0x8b, 0x74, 0x61, 0xa1, 0x47, 0x1d, 0x63, 0x18, 0xe9, 0x86, 0x89, 0xfb, 0x22, 0xe5,
-	0x9d, 0x71, 0x9d, 0x42, 0x8f, 0x3a, 0xc3, 0x54, 0x52, 0xc7, 0x7d, 0x64, 0x01, 0x13, 0x19, 0x2c,
-	0x1a, 0x42, 0x6e, 0xa7, 0x66, 0x71, 0x9e, 0x1e, 0x75, 0xd6, 0xbd, 0x90, 0xd6, 0xa5, 0xbf, 0xa3,
-	0x00, 0xa3, 0x84, 0x88, 0xaa, 0x90, 0xc2, 0x96, 0xe5, 0x13, 0x4a, 0x25, 0xb9, 0x8a, 0xcf, 0x9f,
-	0xac, 0x2d, 0x4a, 0xf3, 0x9a, 0xd0, 0xec, 0x06, 0xbe, 0xed, 0x76, 0xf4, 0xf0, 0x43, 0x64, 0x41,
-	0xaa, 0x8d, 0x7b, 0xd8, 0x35, 0x05, 0x63, 0xb2, 0xd5, 0x8b, 0x65, 0x69, 0xc0, 0x8a, 0xe4, 0x30,
-	0x22, 0xea, 0x9e, 0xed, 0xae, 0x57, 0xd8, 0xde, 0x7f, 0x79, 0xb9, 0x7c, 0x75, 0x8a, 0xbd, 0x33,
-	0x03, 0x3d, 0x84, 0x46, 0x8b, 0x90, 0xf0, 0x1e, 0xb8, 0xc4, 0x17, 0xb4, 0xd1, 0xc5, 0x04, 0xdd,
-	0x83, 0x7c, 0x58, 0x96, 0x68, 0x80, 0x03, 0x71, 0xe5, 0xf3, 0xd5, 0x4f, 0xa7, 0x2e, 0x01, 0xe5,
-	0xba, 0x30, 0xdf, 0x65, 0xd6, 0x7a, 0xce, 0x1c, 0x9b, 0x95, 0x6a, 0x90, 0x1b, 0xd7, 0xa2, 0x22,
-	0x2c, 0x6a, 0xf5, 0x9a, 0x51, 0xdf, 0xac, 0x35, 0x9b, 0xea, 0x96, 0x51, 0xd7, 0xd5, 0x5a, 0x4b,
-	0x6b, 0xde, 0x52, 0xe6, 0xd0, 0x05, 0x38, 0xf7, 0x86, 0x46, 0x6d, 0x28, 0x91, 0xd2, 0x1f, 0x31,
-	0xc8, 0x0c, 0x6f, 0x15, 0xd5, 0x41, 0xf1, 0xfa, 0xc4, 0xe7, 0xbc, 0x99, 0xd6, 0xcd, 0x85, 0xd0,
-	0x42, 0x8a, 0x59, 0x42, 0x64, 0x47, 0x1d, 0x50, 0xd9, 0x10, 0xc8, 0x19, 0x6a, 0x41, 0xf2, 0x01,
-	0xb1, 0x3b, 0xdd, 0x60, 0x26, 0x81, 0x25, 0xb1, 0x50, 0x07, 0x14, 0x59, 0x7a, 0x89, 0x65, 0x60,
-	0x87, 0x97, 0xd9, 0xf8, 0x0c, 0x0a, 0x59, 0x61, 0x88, 0x5a, 0xe3, 0xa0, 0x08, 0x43, 0x9e, 0x3c,
-	0x64, 0xee, 0xef, 0x10, 0xc3, 0x67, 0x37, 0x99, 0x98, 0xc1, 0x29, 0x72, 0x21, 0xa4, 0xce, 0xee,
-	0xef, 0x2a, 0x8c, 0xaa, 0x8b, 0x41, 0xfa, 0x9e, 0xd9, 0xe5, 0x91, 0x1b, 0xd3, 0xe7, 0x87, 0x62,
-	0x95, 0x49, 0xd1, 0x07, 0x90, 0x11, 0xdb, 0x6b, 0xf7, 0x08, 0x0f, 0xba, 0xb4, 0x3e, 0x12, 0x94,
-	0x7e, 0x8f, 0x42, 0x2a, 0xac, 0xbf, 0xef, 0xe8, 0xdf, 0x6e, 0x42, 0x52, 0xfa, 0x6b, 0x62, 0x54,
-	0xc4, 0xd9, 0x21, 0x75, 0xf9, 0x39, 0x63, 0xba, 0xd8, 0x5c, 0x8c, 0x6f, 0x4e, 0x4c, 0x90, 0x06,
-	0x89, 0x71, 0x86, 0xdf, 0x98, 0xc0, 0x70, 0xb9, 0xc1, 0xf0, 0x57, 0xd0, 0x5b, 0x20, 0xa0, 0x2b,
-	0x50, 0xb0, 0xdb, 0xa6, 0x41, 0xc9, 0xfd, 0x01, 0x71, 0x4d, 0x32, 0x6a, 0xe8, 0xf2, 0x76, 0xdb,
-	0xdc, 0x95, 0x52, 0xcd, 0x2a, 0x99, 0x90, 0x1b, 0x37, 0x47, 0xe7, 0xa0, 0xd0, 0x50, 0xef, 0xec,
-	0xec, 0x6a, 0x2d, 0xe3, 0x8e, 0xda, 0x6c, 0x08, 0xea, 0x2b, 0x90, 0x0b, 0x85, 0xbb, 0x6a, 0xb3,
-	0xa5, 0x44, 0xd0, 0x22, 0x28, 0xa1, 0x44, 0x57, 0xeb, 0xaa, 0xb6, 0xaf, 0x36, 0x94, 0x28, 0x3a,
-	0x0f, 0x28, 0x94, 0x36, 0xd4, 0x2d, 0xf5, 0x96, 0x08, 0x9d, 0x58, 0xe9, 0x87, 0x38, 0xc0, 0xd6,
-	0xee, 0xf6, 0x14, 0x0e, 0x6d, 0x1d, 0x73, 0xe8, 0x59, 0x09, 0x18, 0x7a, 0xbb, 0x05, 0x49, 0xda,
-	0xc5, 0x3e, 0xa1, 0xb3, 0x09, 0x1b, 0x81, 0xc5, 0xee, 0x70, 0xbc, 0x91, 0x16, 0x13, 0xf4, 0x3e,
-	0x64, 0x98, 0xe3, 0x85, 0x46, 0xb8, 0x3c, 0x6d, 0xb7, 0x4d, 0xd1, 0x61, 0x7f, 0x0c, 0x61, 0x93,
-	0x3b, 0x96, 0x1d, 0x44, 0x33, 0xad, 0x0c, 0x15, 0x61, 0x12, 0xd8, 0x09, 0xd9, 0x90, 0xe2, 0x6c,
-	0xf8, 0x6c, 0x02, 0x1b, 0x46, 0x0e, 0x1e, 0x1b, 0x4e, 0xe2, 0x44, 0xfa, 0x6d, 0x9c, 0xe8, 0x42,
-	0xe1, 0x04, 0xc2, 0xd9, 0x68, 0x51, 0x84, 0xc5, 0x50, 0xba, 0xd7, 0x6c, 0xed, 0xdc, 0x56, 0x9b,
-	0xda, 0x5d, 0x41, 0x8c, 0x5f, 0xe3, 0x90, 0xd9, 0x0b, 0xe3, 0xf2, 0x5d, 0xbc, 0xf8, 0x10, 0x72,
-	0x3c, 0x44, 0x0c, 0x77, 0xe0, 0xb4, 0x89, 0xcf, 0xd9, 0x11, 0xd3, 0xb3, 0x5c, 0xd6, 0xe4, 0x22,
-	0xa4, 0x42, 0xd6, 0xc1, 0xc1, 0xc0, 0x27, 0x06, 0x7b, 0x8e, 0xc9, 0xb7, 0xd2, 0xa5, 0xb2, 0x78,
-	0xab, 0x95, 0xc3, 0xb7, 0x5a, 0xb9, 0x15, 0xbe, 0xd5, 0xd6, 0xd3, 0x8c, 0x05, 0x8f, 0x5f, 0x2e,
-	0x47, 0x74, 0x10, 0x86, 0x4c, 0x85, 0xbe, 0x82, 0x6c, 0x7b, 0xe0, 0xbb, 0xe3, 0x79, 0x70, 0x8a,
-	0xb8, 0x06, 0x66, 0x23, 0xb3, 0x5c, 0x03, 0xf2, 0x22, 0xd7, 0x84, 0x18, 0x89, 0xe9, 0x30, 0x72,
-	0xc2, 0x4a, 0xa2, 0xbc, 0xe5, 0xb2, 0x92, 0x6f, 0xb9, 0x2c, 0xb4, 0x7d, 0x9c, 0x25, 0x37, 0x27,
-	0xb0, 0x64, 0xe8, 0xed, 0xd1, 0x68, 0x9c, 0x23, 0xa5, 0x9f, 0x22, 0x30, 0x7f, 0x5c, 0x83, 0xde,
-	0x83, 0x85, 0xbd, 0xe6, 0xfa, 0x0e, 0xbf, 0xf5, 0xb1, 0xdb, 0xbf, 0x00, 0xe7, 0x46, 0x62, 0xad,
-	0xa9, 0xb5, 0x34, 0x51, 0x0f, 0x59, 0x16, 0x18, 0x29, 0xb6, 0x6b, 0xad, 0x3d, 0x9d, 0x19, 0x44,
-	0x8f, 0xe3, 0x70, 0xb9, 0xda, 0x50, 0x62, 0xc7, 0x71, 0xea, 0x5b, 0x35, 0x6d, 0xbb, 0xb6, 0xbe,
-	0xa5, 0x2a, 0x71, 0x46, 0xa6, 0x91, 0x62, 0xa3, 0xa6, 0x6d, 0xa9, 0x0d, 0x25, 0x51, 0xfa, 0x3e,
-	0x0a, 0xf9, 0x3d, 0x4a, 0xfc, 0x59, 0xd1, 0x66, 0xac, 0x1b, 0x8a, 0x4d, 0xdb, 0x0d, 0x7d, 0x09,
-	0x40, 0x83, 0xc3, 0x53, 0x52, 0x24, 0x43, 0x83, 0xc3, 0x59, 0x32, 0xa4, 0xf4, 0x5b, 0x14, 0xd0,
-	0xb0, 0xef, 0xf8, 0x9f, 0x45, 0x91, 0x0a, 0x0b, 0xa3, 0xf6, 0x39, 0xf4, 0x6f, 0x7c, 0x82, 0x7f,
-	0x95, 0xa1, 0x49, 0x98, 0x02, 0x47, 0xf5, 0x35, 0x71, 0xba, 0xfa, 0x3a, 0x65, 0xf4, 0x94, 0xaa,
-	0x90, 0xbe, 0xbd, 0xbf, 0xd7, 0xb7, 0x18, 0xcf, 0x15, 0x88, 0x1d, 0x92, 0x47, 0xd2, 0x67, 0x6c,
-	0xc8, 0x32, 0xbc, 0x78, 0x1b, 0x8b, 0x2e, 0x4c, 0x4c, 0xd6, 0xef, 0x3d, 0x7d, 0xb5, 0x14, 0x79,
-	0xf6, 0x6a, 0x29, 0xf2, 0xcf, 0xab, 0xa5, 0xc8, 0xe3, 0xd7, 0x4b, 0x73, 0xcf, 0x5e, 0x2f, 0xcd,
-	0xfd, 0xf9, 0x7a, 0x69, 0xee, 0x6e, 0x6d, 0xac, 0x9e, 0xf4, 0x89, 0x4f, 0x6d, 0x1a, 0xb0, 0x75,
-	0x76, 0x5c, 0x52, 0x11, 0x51, 0xb9, 0xe6, 0x62, 0xf6, 0xb0, 0xad, 0x1c, 0x55, 0x2b, 0x0f, 0x4f,
-	0xfe, 0xc5, 0xc5, 0xcb, 0x4d, 0x3b, 0xc9, 0x5d, 0x7c, 0xe3, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x9a, 0x78, 0x62, 0x70, 0x08, 0x13, 0x00, 0x00,
+func (m *Redelegation) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
 }
 
-func (m *HostChain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Redelegation) GetIbcSequenceId() string {
+	if m != nil {
+		return m.IbcSequenceId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *HostChain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Redelegation) GetState() Redelegation_RedelegationState {
+	if m != nil {
+		return m.State
+	}
+	return Redelegation_REDELEGATION_INITIATED
 }
 
-func (m *HostChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Flags != nil {
-		{
-			size, err := m.Flags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	{
-		size := m.AutoCompoundFactor.Size()
-		i -= size
-		if _, err := m.AutoCompoundFactor.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x7a
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.UnbondingFactor != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.UnbondingFactor))
-		i--
-		dAtA[i] = 0x68
-	}
-	{
-		size := m.LastCValue.Size()
-		i -= size
-		if _, err := m.LastCValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size := m.CValue.Size()
-		i -= size
-		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.MinimumDeposit.Size()
-		i -= size
-		if _, err := m.MinimumDeposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if m.RewardsAccount != nil {
-		{
-			size, err := m.RewardsAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.DelegationAccount != nil {
-		{
-			size, err := m.DelegationAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.HostDenom) > 0 {
-		i -= len(m.HostDenom)
-		copy(dAtA[i:], m.HostDenom)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.HostDenom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+type SlashRecord struct {
+	// chain of the slashed validator
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// address of the slashed validator
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// block height at which the slash was detected
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// block time at which the slash was detected
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// amount of the delegation to the validator that was slashed
+	SlashedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=slashed_amount,json=slashedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"slashed_amount"`
+	// exchange rate the delegation was accounted at before the slash
+	ExchangeRateBefore github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=exchange_rate_before,json=exchangeRateBefore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate_before"`
+	// validator exchange rate after the slash
+	ExchangeRateAfter github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=exchange_rate_after,json=exchangeRateAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate_after"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{15}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
 }
 
-func (m *HostChainFlags) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *HostChainFlags) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SlashRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
 }
 
-func (m *HostChainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Lsm {
-		i--
-		if m.Lsm {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (m *SlashRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *HostChainLSParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SlashRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *HostChainLSParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidatorSetProposal struct {
+	// host chain the validator set was selected for
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// block height at which the validator set was selected
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// selected validators, ordered by score
+	Validators []*ValidatorScore `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
+	// delegation epoch at which the validator set was selected
+	Epoch int64 `protobuf:"varint,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
 }
 
-func (m *HostChainLSParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.LsmBondFactor.Size()
-		i -= size
-		if _, err := m.LsmBondFactor.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.LsmValidatorCap.Size()
-		i -= size
-		if _, err := m.LsmValidatorCap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.RedemptionFee.Size()
-		i -= size
-		if _, err := m.RedemptionFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ValidatorSetProposal) Reset()         { *m = ValidatorSetProposal{} }
+func (m *ValidatorSetProposal) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetProposal) ProtoMessage()    {}
+func (*ValidatorSetProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{16}
+}
+func (m *ValidatorSetProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.UnstakeFee.Size()
-		i -= size
-		if _, err := m.UnstakeFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+}
+func (m *ValidatorSetProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetProposal.Merge(m, src)
+}
+func (m *ValidatorSetProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetProposal proto.InternalMessageInfo
+
+func (m *ValidatorSetProposal) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.RestakeFee.Size()
-		i -= size
-		if _, err := m.RestakeFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *ValidatorSetProposal) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.DepositFee.Size()
-		i -= size
-		if _, err := m.DepositFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *ValidatorSetProposal) GetValidators() []*ValidatorScore {
+	if m != nil {
+		return m.Validators
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ICAAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorSetProposal) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ICAAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidatorScore struct {
+	// valoper address
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// score of the validator, higher is better
+	Score github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=score,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"score"`
+	// weight assigned to the validator
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
 }
 
-func (m *ICAAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChannelState != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.ChannelState))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+func (m *ValidatorScore) Reset()         { *m = ValidatorScore{} }
+func (m *ValidatorScore) String() string { return proto.CompactTextString(m) }
+func (*ValidatorScore) ProtoMessage()    {}
+func (*ValidatorScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{17}
+}
+func (m *ValidatorScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorScore.Merge(m, src)
+}
+func (m *ValidatorScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorScore.DiscardUnknown(m)
 }
 
-func (m *Validator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorScore proto.InternalMessageInfo
+
+func (m *ValidatorScore) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidatorSetRound struct {
+	// host chain the validator set is being selected for
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// delegation epoch at which the round started
+	Epoch int64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// whether every page of the host chain bonded set has been received
+	DiscoveryDone bool `protobuf:"varint,3,opt,name=discovery_done,json=discoveryDone,proto3" json:"discovery_done,omitempty"`
+	// host chain slashing signed blocks window, zero until its query is answered
+	SignedBlocksWindow int64 `protobuf:"varint,4,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// number of candidates discovered in the host chain bonded set
+	Candidates uint64 `protobuf:"varint,5,opt,name=candidates,proto3" json:"candidates,omitempty"`
+	// number of candidates with both their validator and signing info verified
+	Verified uint64 `protobuf:"varint,6,opt,name=verified,proto3" json:"verified,omitempty"`
+}
+
+func (m *ValidatorSetRound) Reset()         { *m = ValidatorSetRound{} }
+func (m *ValidatorSetRound) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetRound) ProtoMessage()    {}
+func (*ValidatorSetRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{18}
+}
+func (m *ValidatorSetRound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Delegable {
-		i--
-		if m.Delegable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.UnbondingEpoch != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.UnbondingEpoch))
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size := m.ExchangeRate.Size()
-		i -= size
-		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.DelegatedAmount.Size()
-		i -= size
-		if _, err := m.DelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Weight.Size()
-		i -= size
-		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ValidatorSetRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetRound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ValidatorSetRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetRound.Merge(m, src)
+}
+func (m *ValidatorSetRound) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetRound proto.InternalMessageInfo
+
+func (m *ValidatorSetRound) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ValidatorSetRound) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorSetRound) GetDiscoveryDone() bool {
+	if m != nil {
+		return m.DiscoveryDone
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorSetRound) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IbcSequenceId) > 0 {
-		i -= len(m.IbcSequenceId)
-		copy(dAtA[i:], m.IbcSequenceId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.State != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x20
+func (m *ValidatorSetRound) GetCandidates() uint64 {
+	if m != nil {
+		return m.Candidates
 	}
-	if m.Epoch != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *ValidatorSetRound) GetVerified() uint64 {
+	if m != nil {
+		return m.Verified
 	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+type ValidatorSetCandidate struct {
+	// host chain the validator is bonded on
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// valoper address
+	OperatorAddress string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// valcons address, set once the validator is verified
+	ConsensusAddress string `protobuf:"bytes,3,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// validator status
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// whether the validator is jailed
+	Jailed bool `protobuf:"varint,5,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// validator tokens
+	Tokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens"`
+	// validator commission rate
+	Commission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=commission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission"`
+	// total shares issued by the validator
+	DelegatorShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=delegator_shares,json=delegatorShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegator_shares"`
+	// shares of the validator held by liquid staking providers
+	LiquidShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=liquid_shares,json=liquidShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquid_shares"`
+	// validator bond shares
+	ValidatorBondShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=validator_bond_shares,json=validatorBondShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_shares"`
+	// blocks missed within the host chain signed blocks window
+	MissedBlocksCounter int64 `protobuf:"varint,11,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// whether the validator record has been proof verified
+	ValidatorVerified bool `protobuf:"varint,12,opt,name=validator_verified,json=validatorVerified,proto3" json:"validator_verified,omitempty"`
+	// whether the validator signing info has been proof verified
+	SigningInfoVerified bool `protobuf:"varint,13,opt,name=signing_info_verified,json=signingInfoVerified,proto3" json:"signing_info_verified,omitempty"`
+}
+
+func (m *ValidatorSetCandidate) Reset()         { *m = ValidatorSetCandidate{} }
+func (m *ValidatorSetCandidate) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetCandidate) ProtoMessage()    {}
+func (*ValidatorSetCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{19}
+}
+func (m *ValidatorSetCandidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetCandidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorSetCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetCandidate.Merge(m, src)
+}
+func (m *ValidatorSetCandidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetCandidate.DiscardUnknown(m)
 }
 
-func (m *LSMDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorSetCandidate proto.InternalMessageInfo
+
+func (m *ValidatorSetCandidate) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *LSMDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorSetCandidate) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
 }
 
-func (m *LSMDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IbcSequenceId) > 0 {
-		i -= len(m.IbcSequenceId)
-		copy(dAtA[i:], m.IbcSequenceId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.State != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.IbcDenom) > 0 {
-		i -= len(m.IbcDenom)
-		copy(dAtA[i:], m.IbcDenom)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcDenom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.Shares.Size()
-		i -= size
-		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *ValidatorSetCandidate) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Unbonding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorSetCandidate) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Unbonding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorSetCandidate) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
 }
 
-func (m *Unbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.State != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.IbcSequenceId) > 0 {
-		i -= len(m.IbcSequenceId)
-		copy(dAtA[i:], m.IbcSequenceId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	{
-		size, err := m.UnbondAmount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.BurnAmount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.MatureTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime):])
-	if err9 != nil {
-		return 0, err9
-	}
-	i -= n9
-	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n9))
-	i--
-	dAtA[i] = 0x1a
-	if m.EpochNumber != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x10
+func (m *ValidatorSetCandidate) GetMissedBlocksCounter() int64 {
+	if m != nil {
+		return m.MissedBlocksCounter
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ValidatorSetCandidate) GetValidatorVerified() bool {
+	if m != nil {
+		return m.ValidatorVerified
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *UserUnbonding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorSetCandidate) GetSigningInfoVerified() bool {
+	if m != nil {
+		return m.SigningInfoVerified
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *UserUnbonding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type KVUpdate struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *UserUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.UnbondAmount.MarshalToSizedBuffer(dAtA[:i])
+func (m *KVUpdate) Reset()         { *m = KVUpdate{} }
+func (m *KVUpdate) String() string { return proto.CompactTextString(m) }
+func (*KVUpdate) ProtoMessage()    {}
+func (*KVUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{20}
+}
+func (m *KVUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KVUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KVUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.StkAmount.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *KVUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KVUpdate.Merge(m, src)
+}
+func (m *KVUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *KVUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_KVUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KVUpdate proto.InternalMessageInfo
+
+func (m *KVUpdate) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *KVUpdate) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type TimelockedUpdate struct {
+	// unique identifier of the queued updates
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// host chain the updates are applied to
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// account that queued the updates
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+	// updates to apply
+	Updates []*KVUpdate `protobuf:"bytes,4,rep,name=updates,proto3" json:"updates,omitempty"`
+	// delegation epoch at the end of which the updates are applied
+	ExecutionEpoch int64 `protobuf:"varint,5,opt,name=execution_epoch,json=executionEpoch,proto3" json:"execution_epoch,omitempty"`
+}
+
+func (m *TimelockedUpdate) Reset()         { *m = TimelockedUpdate{} }
+func (m *TimelockedUpdate) String() string { return proto.CompactTextString(m) }
+func (*TimelockedUpdate) ProtoMessage()    {}
+func (*TimelockedUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{21}
+}
+func (m *TimelockedUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimelockedUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelockedUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *TimelockedUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockedUpdate.Merge(m, src)
+}
+func (m *TimelockedUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimelockedUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockedUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimelockedUpdate proto.InternalMessageInfo
+
+func (m *TimelockedUpdate) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *TimelockedUpdate) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ValidatorUnbonding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TimelockedUpdate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ValidatorUnbonding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TimelockedUpdate) GetUpdates() []*KVUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
 }
 
-func (m *ValidatorUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IbcSequenceId) > 0 {
-		i -= len(m.IbcSequenceId)
-		copy(dAtA[i:], m.IbcSequenceId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
-		i--
-		dAtA[i] = 0x32
+func (m *TimelockedUpdate) GetExecutionEpoch() int64 {
+	if m != nil {
+		return m.ExecutionEpoch
 	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+type TimelockEpochsUpdate struct {
+	// update timelock epochs to set once the current timelock has passed
+	UpdateTimelockEpochs int64 `protobuf:"varint,1,opt,name=update_timelock_epochs,json=updateTimelockEpochs,proto3" json:"update_timelock_epochs,omitempty"`
+	// account that lowered the update timelock
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// delegation epoch at the end of which the update timelock is lowered
+	ExecutionEpoch int64 `protobuf:"varint,3,opt,name=execution_epoch,json=executionEpoch,proto3" json:"execution_epoch,omitempty"`
+}
+
+func (m *TimelockEpochsUpdate) Reset()         { *m = TimelockEpochsUpdate{} }
+func (m *TimelockEpochsUpdate) String() string { return proto.CompactTextString(m) }
+func (*TimelockEpochsUpdate) ProtoMessage()    {}
+func (*TimelockEpochsUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{22}
+}
+func (m *TimelockEpochsUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimelockEpochsUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelockEpochsUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.MatureTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime):])
-	if err13 != nil {
-		return 0, err13
+		return b[:n], nil
 	}
-	i -= n13
-	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n13))
-	i--
-	dAtA[i] = 0x1a
-	if m.EpochNumber != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *TimelockEpochsUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockEpochsUpdate.Merge(m, src)
+}
+func (m *TimelockEpochsUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimelockEpochsUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockEpochsUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimelockEpochsUpdate proto.InternalMessageInfo
+
+func (m *TimelockEpochsUpdate) GetUpdateTimelockEpochs() int64 {
+	if m != nil {
+		return m.UpdateTimelockEpochs
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *TimelockEpochsUpdate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *KVUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TimelockEpochsUpdate) GetExecutionEpoch() int64 {
+	if m != nil {
+		return m.ExecutionEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *KVUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type HostChainVote struct {
+	// host chain the proposal belongs to
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// id of the host chain governance proposal
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// weighted options voted by the delegation account
+	Options []v1beta1.WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options"`
+	// block height at which the vote was sent
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// block time at which the vote was sent
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// sequence id of the ibc transaction
+	IbcSequenceId string `protobuf:"bytes,6,opt,name=ibc_sequence_id,json=ibcSequenceId,proto3" json:"ibc_sequence_id,omitempty"`
+	// state of the vote
+	State HostChainVote_VoteState `protobuf:"varint,7,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.HostChainVote_VoteState" json:"state,omitempty"`
 }
 
-func (m *KVUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+func (m *HostChainVote) Reset()         { *m = HostChainVote{} }
+func (m *HostChainVote) String() string { return proto.CompactTextString(m) }
+func (*HostChainVote) ProtoMessage()    {}
+func (*HostChainVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{23}
+}
+func (m *HostChainVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostChainVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostChainVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *HostChainVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostChainVote.Merge(m, src)
+}
+func (m *HostChainVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostChainVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostChainVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostChainVote proto.InternalMessageInfo
+
+func (m *HostChainVote) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintLiquidstakeibc(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLiquidstakeibc(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *HostChainVote) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *HostChain) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *HostChainVote) GetOptions() []v1beta1.WeightedVoteOption {
+	if m != nil {
+		return m.Options
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return nil
+}
+
+func (m *HostChainVote) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return 0
+}
+
+func (m *HostChainVote) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return time.Time{}
+}
+
+func (m *HostChainVote) GetIbcSequenceId() string {
+	if m != nil {
+		return m.IbcSequenceId
 	}
-	l = len(m.HostDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
-	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
-	}
-	if m.DelegationAccount != nil {
-		l = m.DelegationAccount.Size()
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
-	}
-	if m.RewardsAccount != nil {
-		l = m.RewardsAccount.Size()
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return ""
+}
+
+func (m *HostChainVote) GetState() HostChainVote_VoteState {
+	if m != nil {
+		return m.State
 	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return HostChainVote_VOTE_INITIATED
+}
+
+type VoteSignaling struct {
+	// host chain the proposal belongs to
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// id of the host chain governance proposal
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// time at which the signaling closes and its tally is voted on the host
+	// chain, it should be before the end of the host chain voting period
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// whether the signaling has been tallied
+	Tallied bool `protobuf:"varint,4,opt,name=tallied,proto3" json:"tallied,omitempty"`
+	// weighted options voted on the host chain out of the signals
+	Tally []v1beta1.WeightedVoteOption `protobuf:"bytes,5,rep,name=tally,proto3" json:"tally"`
+	// ibc sequence id of the ICA transaction voting the tally
+	IbcSequenceId string `protobuf:"bytes,6,opt,name=ibc_sequence_id,json=ibcSequenceId,proto3" json:"ibc_sequence_id,omitempty"`
+	// whether the tally vote has been acknowledged or could not be sent, closed
+	// signalings are pruned with their signals
+	Closed bool `protobuf:"varint,7,opt,name=closed,proto3" json:"closed,omitempty"`
+	// stk power accumulated by each option out of the signals
+	OptionPowers []VoteOptionPower `protobuf:"bytes,8,rep,name=option_powers,json=optionPowers,proto3" json:"option_powers"`
+	// stk power of all the signals
+	TotalPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=total_power,json=totalPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_power"`
+}
+
+func (m *VoteSignaling) Reset()         { *m = VoteSignaling{} }
+func (m *VoteSignaling) String() string { return proto.CompactTextString(m) }
+func (*VoteSignaling) ProtoMessage()    {}
+func (*VoteSignaling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{24}
+}
+func (m *VoteSignaling) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteSignaling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteSignaling.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = m.MinimumDeposit.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.CValue.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.LastCValue.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	if m.UnbondingFactor != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.UnbondingFactor))
-	}
-	if m.Active {
-		n += 2
-	}
-	l = m.AutoCompoundFactor.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	if m.Flags != nil {
-		l = m.Flags.Size()
-		n += 2 + l + sovLiquidstakeibc(uint64(l))
+}
+func (m *VoteSignaling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteSignaling.Merge(m, src)
+}
+func (m *VoteSignaling) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteSignaling) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteSignaling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteSignaling proto.InternalMessageInfo
+
+func (m *VoteSignaling) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return n
+	return ""
 }
 
-func (m *HostChainFlags) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VoteSignaling) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	var l int
-	_ = l
-	if m.Lsm {
-		n += 2
+	return 0
+}
+
+func (m *VoteSignaling) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	return n
+	return time.Time{}
 }
 
-func (m *HostChainLSParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VoteSignaling) GetTallied() bool {
+	if m != nil {
+		return m.Tallied
 	}
-	var l int
-	_ = l
-	l = m.DepositFee.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.RestakeFee.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.UnstakeFee.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.RedemptionFee.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.LsmValidatorCap.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.LsmBondFactor.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	return n
+	return false
 }
 
-func (m *ICAAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VoteSignaling) GetTally() []v1beta1.WeightedVoteOption {
+	if m != nil {
+		return m.Tally
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return nil
+}
+
+func (m *VoteSignaling) GetIbcSequenceId() string {
+	if m != nil {
+		return m.IbcSequenceId
 	}
-	l = m.Balance.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return ""
+}
+
+func (m *VoteSignaling) GetClosed() bool {
+	if m != nil {
+		return m.Closed
 	}
-	if m.ChannelState != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.ChannelState))
+	return false
+}
+
+func (m *VoteSignaling) GetOptionPowers() []VoteOptionPower {
+	if m != nil {
+		return m.OptionPowers
 	}
-	return n
+	return nil
 }
 
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
+type VoteOptionPower struct {
+	Option v1beta1.VoteOption `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	// stk power signaled for the option
+	Power github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=power,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"power"`
+}
+
+func (m *VoteOptionPower) Reset()         { *m = VoteOptionPower{} }
+func (m *VoteOptionPower) String() string { return proto.CompactTextString(m) }
+func (*VoteOptionPower) ProtoMessage()    {}
+func (*VoteOptionPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{25}
+}
+func (m *VoteOptionPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteOptionPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteOptionPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+}
+func (m *VoteOptionPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteOptionPower.Merge(m, src)
+}
+func (m *VoteOptionPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteOptionPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteOptionPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteOptionPower proto.InternalMessageInfo
+
+func (m *VoteOptionPower) GetOption() v1beta1.VoteOption {
+	if m != nil {
+		return m.Option
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return v1beta1.OptionEmpty
+}
+
+type VoteSignal struct {
+	// host chain the proposal belongs to
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// id of the host chain governance proposal
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// address of the liquid staker signaling
+	Signaler string `protobuf:"bytes,3,opt,name=signaler,proto3" json:"signaler,omitempty"`
+	// weighted options signaled
+	Options []v1beta1.WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options"`
+	// stk escrowed by the signaler, returned once the signal is pruned
+	Power github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=power,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"power"`
+}
+
+func (m *VoteSignal) Reset()         { *m = VoteSignal{} }
+func (m *VoteSignal) String() string { return proto.CompactTextString(m) }
+func (*VoteSignal) ProtoMessage()    {}
+func (*VoteSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{26}
+}
+func (m *VoteSignal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteSignal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Weight.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.DelegatedAmount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	if m.UnbondingEpoch != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.UnbondingEpoch))
+}
+func (m *VoteSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteSignal.Merge(m, src)
+}
+func (m *VoteSignal) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteSignal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteSignal proto.InternalMessageInfo
+
+func (m *VoteSignal) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if m.Delegable {
-		n += 2
+	return ""
+}
+
+func (m *VoteSignal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return n
+	return 0
 }
 
-func (m *Deposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VoteSignal) GetSignaler() string {
+	if m != nil {
+		return m.Signaler
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return ""
+}
+
+func (m *VoteSignal) GetOptions() []v1beta1.WeightedVoteOption {
+	if m != nil {
+		return m.Options
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	if m.Epoch != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.Epoch))
+	return nil
+}
+
+type CValueRecord struct {
+	// chain the c value was computed for
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// c value epoch in which the c value was computed
+	Epoch int64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// block height at which the c value was computed
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// block time at which the c value was computed
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// computed c value
+	CValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=c_value,json=cValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"c_value"`
+	// total stk tokens minted
+	MintedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=minted_amount,json=mintedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minted_amount"`
+	// amount staked by the module in the host chain validators
+	StakedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=staked_amount,json=stakedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"staked_amount"`
+	// amount in the staking flow that hasn't left Persistence yet
+	AmountOnPersistence github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=amount_on_persistence,json=amountOnPersistence,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount_on_persistence"`
+	// amount in the staking flow that has arrived to the host chain but hasn't been staked yet
+	AmountOnHostChain github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=amount_on_host_chain,json=amountOnHostChain,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount_on_host_chain"`
+	// amount of LSM deposits that haven't been untokenized yet
+	TokenizedStakedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=tokenized_staked_amount,json=tokenizedStakedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokenized_staked_amount"`
+	// amount unbonded from validators that have been unbonding for more than 4 unbonding epochs
+	ValidatorUnbondingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=validator_unbonding_amount,json=validatorUnbondingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"validator_unbonding_amount"`
+}
+
+func (m *CValueRecord) Reset()         { *m = CValueRecord{} }
+func (m *CValueRecord) String() string { return proto.CompactTextString(m) }
+func (*CValueRecord) ProtoMessage()    {}
+func (*CValueRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{27}
+}
+func (m *CValueRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CValueRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CValueRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.State != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.State))
+}
+func (m *CValueRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CValueRecord.Merge(m, src)
+}
+func (m *CValueRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CValueRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CValueRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CValueRecord proto.InternalMessageInfo
+
+func (m *CValueRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	l = len(m.IbcSequenceId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return ""
+}
+
+func (m *CValueRecord) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return n
+	return 0
 }
 
-func (m *LSMDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CValueRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return 0
+}
+
+func (m *CValueRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.Shares.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return time.Time{}
+}
+
+type RewardRecord struct {
+	// chain the rewards were autocompounded for
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// delegation epoch in which the rewards were received
+	Epoch int64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// rewards received from the rewards account, restake fee included
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// restake fee charged on the received rewards
+	Fee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee"`
+}
+
+func (m *RewardRecord) Reset()         { *m = RewardRecord{} }
+func (m *RewardRecord) String() string { return proto.CompactTextString(m) }
+func (*RewardRecord) ProtoMessage()    {}
+func (*RewardRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{28}
+}
+func (m *RewardRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.IbcDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+}
+func (m *RewardRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardRecord.Merge(m, src)
+}
+func (m *RewardRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardRecord proto.InternalMessageInfo
+
+func (m *RewardRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return ""
+}
+
+func (m *RewardRecord) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	if m.State != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	return 0
+}
+
+type Inflow struct {
+	// chain the tokens were liquid staked on
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// delegation epoch in which the tokens were liquid staked
+	Epoch int64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// address that liquid staked the tokens, the host chain total if empty
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// amount liquid staked
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *Inflow) Reset()         { *m = Inflow{} }
+func (m *Inflow) String() string { return proto.CompactTextString(m) }
+func (*Inflow) ProtoMessage()    {}
+func (*Inflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{29}
+}
+func (m *Inflow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Inflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Inflow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.IbcSequenceId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+}
+func (m *Inflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Inflow.Merge(m, src)
+}
+func (m *Inflow) XXX_Size() int {
+	return m.Size()
+}
+func (m *Inflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_Inflow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Inflow proto.InternalMessageInfo
+
+func (m *Inflow) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return n
+	return ""
 }
 
-func (m *Unbonding) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Inflow) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return 0
+}
+
+func (m *Inflow) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.EpochNumber != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	return ""
+}
+
+type RedeemOutflow struct {
+	// chain the tokens were redeemed from
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// delegation epoch in which the tokens were redeemed
+	Epoch int64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// amount instantly redeemed
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *RedeemOutflow) Reset()         { *m = RedeemOutflow{} }
+func (m *RedeemOutflow) String() string { return proto.CompactTextString(m) }
+func (*RedeemOutflow) ProtoMessage()    {}
+func (*RedeemOutflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{30}
+}
+func (m *RedeemOutflow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedeemOutflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedeemOutflow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime)
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.BurnAmount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.UnbondAmount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = len(m.IbcSequenceId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+}
+func (m *RedeemOutflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedeemOutflow.Merge(m, src)
+}
+func (m *RedeemOutflow) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedeemOutflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedeemOutflow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedeemOutflow proto.InternalMessageInfo
+
+func (m *RedeemOutflow) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if m.State != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	return ""
+}
+
+func (m *RedeemOutflow) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return n
+	return 0
 }
 
-func (m *UserUnbonding) Size() (n int) {
-	if m == nil {
-		return 0
+type WorkflowCursor struct {
+	// chain the begin block workflow runs for
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// name of the begin block workflow
+	Workflow string `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	// store key where the workflow resumes
+	Cursor []byte `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *WorkflowCursor) Reset()         { *m = WorkflowCursor{} }
+func (m *WorkflowCursor) String() string { return proto.CompactTextString(m) }
+func (*WorkflowCursor) ProtoMessage()    {}
+func (*WorkflowCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{31}
+}
+func (m *WorkflowCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+}
+func (m *WorkflowCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowCursor.Merge(m, src)
+}
+func (m *WorkflowCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowCursor proto.InternalMessageInfo
+
+func (m *WorkflowCursor) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if m.EpochNumber != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	return ""
+}
+
+func (m *WorkflowCursor) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return ""
+}
+
+func (m *WorkflowCursor) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
 	}
-	l = m.StkAmount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.UnbondAmount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	return n
+	return nil
 }
 
-func (m *ValidatorUnbonding) Size() (n int) {
-	if m == nil {
-		return 0
+type RedemptionRecord struct {
+	// chain the stk tokens were minted for
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// denom of the stk tokens redeemed against the record
+	MintDenom string `protobuf:"bytes,2,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
+	// ibc denom of the host chain tokens paid out
+	IbcDenom string `protobuf:"bytes,3,opt,name=ibc_denom,json=ibcDenom,proto3" json:"ibc_denom,omitempty"`
+	// final c value of the host chain
+	CValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=c_value,json=cValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"c_value"`
+	// host chain tokens left to be paid out, held by the deposit module account
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// stk tokens left to be redeemed
+	StkAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=stk_amount,json=stkAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"stk_amount"`
+}
+
+func (m *RedemptionRecord) Reset()         { *m = RedemptionRecord{} }
+func (m *RedemptionRecord) String() string { return proto.CompactTextString(m) }
+func (*RedemptionRecord) ProtoMessage()    {}
+func (*RedemptionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{32}
+}
+func (m *RedemptionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedemptionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedemptionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+}
+func (m *RedemptionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionRecord.Merge(m, src)
+}
+func (m *RedemptionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedemptionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionRecord proto.InternalMessageInfo
+
+func (m *RedemptionRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if m.EpochNumber != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	return ""
+}
+
+func (m *RedemptionRecord) GetMintDenom() string {
+	if m != nil {
+		return m.MintDenom
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime)
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return ""
+}
+
+func (m *RedemptionRecord) GetIbcDenom() string {
+	if m != nil {
+		return m.IbcDenom
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = len(m.IbcSequenceId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.HostChain_DelegationStrategy", HostChain_DelegationStrategy_name, HostChain_DelegationStrategy_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.CValueCooldown_CooldownReason", CValueCooldown_CooldownReason_name, CValueCooldown_CooldownReason_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.Deregistration_DeregistrationState", Deregistration_DeregistrationState_name, Deregistration_DeregistrationState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.ICAAccount_ChannelState", ICAAccount_ChannelState_name, ICAAccount_ChannelState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.Deposit_DepositState", Deposit_DepositState_name, Deposit_DepositState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.LSMDeposit_LSMDepositState", LSMDeposit_LSMDepositState_name, LSMDeposit_LSMDepositState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.Unbonding_UnbondingState", Unbonding_UnbondingState_name, Unbonding_UnbondingState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.Redelegation_RedelegationState", Redelegation_RedelegationState_name, Redelegation_RedelegationState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.HostChainVote_VoteState", HostChainVote_VoteState_name, HostChainVote_VoteState_value)
+	proto.RegisterType((*HostChain)(nil), "pstake.liquidstakeibc.v1beta1.HostChain")
+	proto.RegisterType((*HostChainFlags)(nil), "pstake.liquidstakeibc.v1beta1.HostChainFlags")
+	proto.RegisterType((*HostChainPauses)(nil), "pstake.liquidstakeibc.v1beta1.HostChainPauses")
+	proto.RegisterType((*HostChainLSParams)(nil), "pstake.liquidstakeibc.v1beta1.HostChainLSParams")
+	proto.RegisterType((*CValueCooldown)(nil), "pstake.liquidstakeibc.v1beta1.CValueCooldown")
+	proto.RegisterType((*Deregistration)(nil), "pstake.liquidstakeibc.v1beta1.Deregistration")
+	proto.RegisterType((*ValidatorSetConfig)(nil), "pstake.liquidstakeibc.v1beta1.ValidatorSetConfig")
+	proto.RegisterType((*ICAAccount)(nil), "pstake.liquidstakeibc.v1beta1.ICAAccount")
+	proto.RegisterType((*Validator)(nil), "pstake.liquidstakeibc.v1beta1.Validator")
+	proto.RegisterType((*Deposit)(nil), "pstake.liquidstakeibc.v1beta1.Deposit")
+	proto.RegisterType((*LSMDeposit)(nil), "pstake.liquidstakeibc.v1beta1.LSMDeposit")
+	proto.RegisterType((*Unbonding)(nil), "pstake.liquidstakeibc.v1beta1.Unbonding")
+	proto.RegisterType((*UserUnbonding)(nil), "pstake.liquidstakeibc.v1beta1.UserUnbonding")
+	proto.RegisterType((*ValidatorUnbonding)(nil), "pstake.liquidstakeibc.v1beta1.ValidatorUnbonding")
+	proto.RegisterType((*Redelegation)(nil), "pstake.liquidstakeibc.v1beta1.Redelegation")
+	proto.RegisterType((*SlashRecord)(nil), "pstake.liquidstakeibc.v1beta1.SlashRecord")
+	proto.RegisterType((*ValidatorSetProposal)(nil), "pstake.liquidstakeibc.v1beta1.ValidatorSetProposal")
+	proto.RegisterType((*ValidatorScore)(nil), "pstake.liquidstakeibc.v1beta1.ValidatorScore")
+	proto.RegisterType((*ValidatorSetRound)(nil), "pstake.liquidstakeibc.v1beta1.ValidatorSetRound")
+	proto.RegisterType((*ValidatorSetCandidate)(nil), "pstake.liquidstakeibc.v1beta1.ValidatorSetCandidate")
+	proto.RegisterType((*KVUpdate)(nil), "pstake.liquidstakeibc.v1beta1.KVUpdate")
+	proto.RegisterType((*TimelockedUpdate)(nil), "pstake.liquidstakeibc.v1beta1.TimelockedUpdate")
+	proto.RegisterType((*TimelockEpochsUpdate)(nil), "pstake.liquidstakeibc.v1beta1.TimelockEpochsUpdate")
+	proto.RegisterType((*HostChainVote)(nil), "pstake.liquidstakeibc.v1beta1.HostChainVote")
+	proto.RegisterType((*VoteSignaling)(nil), "pstake.liquidstakeibc.v1beta1.VoteSignaling")
+	proto.RegisterType((*VoteOptionPower)(nil), "pstake.liquidstakeibc.v1beta1.VoteOptionPower")
+	proto.RegisterType((*VoteSignal)(nil), "pstake.liquidstakeibc.v1beta1.VoteSignal")
+	proto.RegisterType((*CValueRecord)(nil), "pstake.liquidstakeibc.v1beta1.CValueRecord")
+	proto.RegisterType((*RewardRecord)(nil), "pstake.liquidstakeibc.v1beta1.RewardRecord")
+	proto.RegisterType((*Inflow)(nil), "pstake.liquidstakeibc.v1beta1.Inflow")
+	proto.RegisterType((*RedeemOutflow)(nil), "pstake.liquidstakeibc.v1beta1.RedeemOutflow")
+	proto.RegisterType((*WorkflowCursor)(nil), "pstake.liquidstakeibc.v1beta1.WorkflowCursor")
+	proto.RegisterType((*RedemptionRecord)(nil), "pstake.liquidstakeibc.v1beta1.RedemptionRecord")
+}
+
+func init() {
+	proto.RegisterFile("pstake/liquidstakeibc/v1beta1/liquidstakeibc.proto", fileDescriptor_71a9a61e676043b6)
+}
+
+var fileDescriptor_71a9a61e676043b6 = []byte{
+	// 3876 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xcd, 0x6f, 0x23, 0xc9,
+	0x75, 0x1f, 0x7e, 0x8a, 0x7a, 0x22, 0x29, 0xaa, 0xf4, 0x31, 0xbd, 0xe3, 0x5d, 0xcd, 0x2c, 0xed,
+	0xdd, 0x19, 0x67, 0x31, 0x92, 0x57, 0x6b, 0x8c, 0x9d, 0xc4, 0x49, 0x4c, 0x91, 0x3d, 0x33, 0xf4,
+	0x52, 0xa4, 0xdc, 0xa4, 0x24, 0xdb, 0x9b, 0xa4, 0xd1, 0xec, 0x2e, 0x51, 0x1d, 0x35, 0xbb, 0xb9,
+	0xdd, 0x4d, 0x49, 0xe3, 0x5b, 0x00, 0x07, 0x41, 0x80, 0x1c, 0x0c, 0x04, 0x30, 0x8c, 0x00, 0x09,
+	0x92, 0x63, 0x02, 0x04, 0xc8, 0xc1, 0x40, 0x4e, 0x39, 0x04, 0xc8, 0xc1, 0x40, 0x2e, 0x86, 0x91,
+	0x43, 0x12, 0x24, 0x76, 0xb2, 0x9b, 0x5c, 0x72, 0xcd, 0x1f, 0x90, 0xe0, 0x55, 0x55, 0x7f, 0x49,
+	0xb4, 0x44, 0x8d, 0x7a, 0x81, 0x5c, 0x66, 0x58, 0xef, 0xf5, 0xfb, 0xbd, 0xfa, 0x78, 0xf5, 0xde,
+	0xab, 0x57, 0x25, 0xd8, 0x99, 0x78, 0xbe, 0x76, 0x4a, 0xb7, 0x2d, 0xf3, 0xe3, 0xa9, 0x69, 0xb0,
+	0xdf, 0xe6, 0x50, 0xdf, 0x3e, 0x7b, 0x7f, 0x48, 0x7d, 0xed, 0xfd, 0x4b, 0xe4, 0xad, 0x89, 0xeb,
+	0xf8, 0x0e, 0x79, 0x8b, 0xcb, 0x6c, 0x5d, 0x62, 0x0a, 0x99, 0x07, 0x6b, 0x23, 0x67, 0xe4, 0xb0,
+	0x2f, 0xb7, 0xf1, 0x17, 0x17, 0x7a, 0xf0, 0x86, 0xee, 0x78, 0x63, 0xc7, 0x53, 0x39, 0x83, 0x37,
+	0x04, 0x6b, 0x93, 0xb7, 0xb6, 0x87, 0x9a, 0x47, 0x43, 0xcd, 0xba, 0x63, 0xda, 0x82, 0xff, 0xa6,
+	0xe0, 0x8f, 0x9c, 0xb3, 0x90, 0x3d, 0x72, 0xce, 0x04, 0xf7, 0xe1, 0xc8, 0x71, 0x46, 0x16, 0xdd,
+	0x66, 0xad, 0xe1, 0xf4, 0x78, 0xdb, 0x37, 0xc7, 0xd4, 0xf3, 0xb5, 0xf1, 0x24, 0x80, 0xbf, 0xfc,
+	0x81, 0x31, 0x75, 0x35, 0xdf, 0x74, 0x04, 0x7c, 0xfd, 0x7b, 0x55, 0x58, 0x7c, 0xe9, 0x78, 0x7e,
+	0xf3, 0x44, 0x33, 0x6d, 0xf2, 0x06, 0x94, 0x74, 0xfc, 0xa1, 0x9a, 0x86, 0x94, 0x79, 0x94, 0x79,
+	0xb2, 0xa8, 0x2c, 0xb0, 0x76, 0xdb, 0x20, 0x9f, 0x87, 0x8a, 0xee, 0xd8, 0x36, 0xd5, 0x51, 0x18,
+	0xf9, 0x59, 0xc6, 0x2f, 0x47, 0xc4, 0xb6, 0x41, 0x5e, 0x42, 0x71, 0xa2, 0xb9, 0xda, 0xd8, 0x93,
+	0x72, 0x8f, 0x32, 0x4f, 0x96, 0x76, 0xbe, 0xb4, 0x75, 0xed, 0x6c, 0x6d, 0x85, 0x9a, 0x3b, 0xfd,
+	0x7d, 0x26, 0xa7, 0x08, 0x79, 0xf2, 0x16, 0xc0, 0x89, 0xe3, 0xf9, 0xaa, 0x41, 0x6d, 0x67, 0x2c,
+	0xe5, 0x99, 0xae, 0x45, 0xa4, 0xb4, 0x90, 0x80, 0x6c, 0xfd, 0x44, 0xb3, 0x6d, 0x6a, 0x61, 0x57,
+	0x0a, 0x9c, 0x2d, 0x28, 0x6d, 0x83, 0xdc, 0x87, 0x85, 0x89, 0xe3, 0xfa, 0xc8, 0x2b, 0x32, 0x5e,
+	0x11, 0x9b, 0x6d, 0x83, 0x7c, 0x0b, 0x88, 0x41, 0x2d, 0x3a, 0x62, 0x53, 0xa0, 0x6a, 0xba, 0xee,
+	0x4c, 0x6d, 0x5f, 0x5a, 0x60, 0x9d, 0xfd, 0xe2, 0x0d, 0x9d, 0x6d, 0x37, 0x1b, 0x0d, 0x2e, 0xa0,
+	0xac, 0x44, 0x20, 0x82, 0x44, 0x14, 0x58, 0x76, 0xe9, 0xb9, 0xe6, 0x1a, 0x5e, 0x08, 0x5b, 0xba,
+	0x2d, 0x6c, 0x55, 0x20, 0x04, 0x98, 0x2f, 0x01, 0xce, 0x34, 0xcb, 0x34, 0x34, 0xdf, 0x71, 0x3d,
+	0x69, 0xf1, 0x51, 0xee, 0xc9, 0xd2, 0xce, 0x93, 0x1b, 0xe0, 0x0e, 0x03, 0x01, 0x25, 0x26, 0x4b,
+	0x28, 0x2c, 0x8f, 0x4d, 0xdb, 0x1c, 0x4f, 0xc7, 0xaa, 0x41, 0x27, 0x8e, 0x67, 0xfa, 0x12, 0xe0,
+	0xc4, 0xec, 0x7e, 0xed, 0xc7, 0x3f, 0x7b, 0x78, 0xef, 0x5f, 0x7e, 0xf6, 0xf0, 0xdd, 0x91, 0xe9,
+	0x9f, 0x4c, 0x87, 0x5b, 0xba, 0x33, 0x16, 0xf6, 0x29, 0xfe, 0x7b, 0xea, 0x19, 0xa7, 0xdb, 0xfe,
+	0xab, 0x09, 0xf5, 0xb6, 0xda, 0xb6, 0xff, 0xd3, 0x1f, 0x3d, 0x05, 0x4e, 0xc7, 0x96, 0x52, 0x15,
+	0xa0, 0x2d, 0x8e, 0x49, 0x0e, 0x60, 0x41, 0x57, 0xcf, 0x34, 0x6b, 0x4a, 0xa5, 0xa5, 0x5b, 0xc3,
+	0xb7, 0xa8, 0x1e, 0x83, 0x6f, 0x51, 0x5d, 0x29, 0xea, 0x87, 0x88, 0x45, 0x7e, 0x1b, 0xca, 0x96,
+	0xe6, 0xf9, 0x6a, 0x80, 0x5d, 0x4e, 0x01, 0x1b, 0x10, 0xb1, 0xc9, 0xf1, 0xbf, 0x08, 0xb5, 0xa9,
+	0x3d, 0x74, 0x6c, 0xc3, 0xb4, 0x47, 0xea, 0xb1, 0xa6, 0xfb, 0x8e, 0x2b, 0x55, 0x1e, 0x65, 0x9e,
+	0xe4, 0x94, 0xe5, 0x90, 0xfe, 0x9c, 0x91, 0xc9, 0x06, 0x14, 0x35, 0xdd, 0x37, 0xcf, 0xa8, 0x54,
+	0x7d, 0x94, 0x79, 0x52, 0x52, 0x44, 0x8b, 0xd8, 0xb0, 0xa6, 0x4d, 0x7d, 0x47, 0xd5, 0x9d, 0xf1,
+	0xc4, 0x99, 0xda, 0x46, 0x00, 0xb3, 0x9c, 0x42, 0x57, 0x09, 0x22, 0x37, 0x05, 0xb0, 0xe8, 0x47,
+	0x13, 0x0a, 0xc7, 0x96, 0x36, 0xf2, 0xa4, 0x1a, 0x33, 0xb2, 0xa7, 0xf3, 0x6e, 0xb4, 0xe7, 0x28,
+	0xa4, 0x70, 0x59, 0x62, 0xc1, 0x6a, 0x6c, 0x37, 0x78, 0xbe, 0xab, 0xf9, 0x74, 0xf4, 0x4a, 0x5a,
+	0x79, 0x94, 0x79, 0x52, 0xdd, 0xf9, 0xd5, 0x79, 0x21, 0xb7, 0x5a, 0x21, 0x46, 0x5f, 0x40, 0x28,
+	0xc4, 0xb8, 0x42, 0x23, 0x3a, 0xac, 0x85, 0x16, 0xa9, 0x7a, 0xd4, 0x57, 0x75, 0xc7, 0x3e, 0x36,
+	0x47, 0x12, 0x61, 0x23, 0x78, 0x7f, 0x5e, 0xbb, 0xee, 0x53, 0xbf, 0xc9, 0x04, 0x15, 0x72, 0x76,
+	0x85, 0x46, 0x0e, 0xa0, 0x6a, 0x50, 0x97, 0x8e, 0x4c, 0x36, 0x1a, 0xd3, 0xb1, 0xa5, 0xd5, 0xb9,
+	0x26, 0xa8, 0x95, 0x10, 0x52, 0x2e, 0x81, 0x90, 0xe7, 0xe8, 0xd8, 0xa6, 0x1e, 0xf5, 0xa4, 0x35,
+	0x06, 0xb7, 0x35, 0xef, 0xe4, 0xec, 0x33, 0x29, 0x45, 0x48, 0x93, 0x23, 0xa8, 0x09, 0x23, 0x56,
+	0x75, 0xc7, 0xb1, 0x0c, 0xe7, 0xdc, 0x96, 0xd6, 0xe7, 0xea, 0x20, 0x37, 0xd5, 0xa6, 0x10, 0x52,
+	0xaa, 0x7a, 0xa2, 0x4d, 0xba, 0x71, 0x13, 0x9e, 0x50, 0xd7, 0x74, 0x0c, 0x69, 0x83, 0x01, 0xbf,
+	0xb1, 0xc5, 0x43, 0xc0, 0x56, 0x10, 0x02, 0xb6, 0x5a, 0x22, 0x04, 0xec, 0x96, 0xd0, 0x2c, 0x7f,
+	0xf8, 0xf3, 0x87, 0x99, 0x98, 0x9d, 0xef, 0x33, 0x59, 0xf2, 0x14, 0x48, 0x7c, 0x02, 0x54, 0x3a,
+	0x71, 0xf4, 0x13, 0xe9, 0x3e, 0xdb, 0x14, 0x2b, 0x71, 0x8e, 0x8c, 0x8c, 0xfa, 0xdf, 0x64, 0x80,
+	0x5c, 0x35, 0x03, 0xf2, 0x1e, 0x3c, 0x6e, 0xc9, 0x1d, 0xf9, 0x45, 0x63, 0xd0, 0xee, 0x75, 0xd5,
+	0xfe, 0x40, 0x69, 0x0c, 0xe4, 0x17, 0xdf, 0x56, 0x8f, 0xe4, 0xf6, 0x8b, 0x97, 0x03, 0x75, 0x5f,
+	0xe9, 0xed, 0xf7, 0x14, 0x64, 0x35, 0x3a, 0xb5, 0x7b, 0xe4, 0xf3, 0xf0, 0x70, 0xd6, 0xc7, 0xf2,
+	0x37, 0x0f, 0x1a, 0x1d, 0xb5, 0xbf, 0xdf, 0x69, 0x0f, 0x6a, 0x19, 0xf2, 0x0e, 0xbc, 0x3d, 0xeb,
+	0xa3, 0xfe, 0xa0, 0xf1, 0xa1, 0xac, 0xb6, 0xbb, 0x87, 0xb2, 0xd2, 0x97, 0x6b, 0x59, 0xf2, 0x04,
+	0xbe, 0x30, 0xeb, 0xb3, 0x66, 0x6f, 0x6f, 0xaf, 0xdd, 0xef, 0x23, 0xad, 0x71, 0xd4, 0x50, 0xe4,
+	0x5a, 0xee, 0x57, 0xf2, 0x3f, 0xfc, 0xb3, 0x87, 0x99, 0xfa, 0xd7, 0xa1, 0x9a, 0xdc, 0x22, 0xa4,
+	0x06, 0x39, 0xcb, 0x1b, 0xb3, 0x28, 0x58, 0x52, 0xf0, 0x27, 0x79, 0x13, 0x16, 0x5d, 0x3a, 0xd4,
+	0x2c, 0xcd, 0xd6, 0x29, 0x8b, 0x7e, 0x25, 0x25, 0x22, 0xd4, 0xff, 0x3e, 0x03, 0xcb, 0x97, 0x56,
+	0x9d, 0xbc, 0x0d, 0x65, 0xbe, 0x9a, 0x2a, 0x5b, 0x4e, 0x01, 0xb6, 0xc4, 0x69, 0x7d, 0x24, 0x91,
+	0xcf, 0xc1, 0xa2, 0xe5, 0x8d, 0x05, 0x9f, 0x83, 0x96, 0x2c, 0x6f, 0xcc, 0x99, 0x12, 0x2c, 0x4c,
+	0x6d, 0xce, 0xca, 0x31, 0x56, 0xd0, 0x44, 0x37, 0xe4, 0x52, 0x83, 0x52, 0x1e, 0x1a, 0x4b, 0x8a,
+	0x68, 0x91, 0x3a, 0x94, 0xd1, 0x59, 0x04, 0x5e, 0x88, 0x45, 0xc6, 0x92, 0x92, 0xa0, 0xa1, 0x4a,
+	0x53, 0xd7, 0x54, 0x8f, 0xda, 0x86, 0xc7, 0xc2, 0x63, 0x49, 0x29, 0x99, 0xba, 0xd6, 0xc7, 0x76,
+	0xfd, 0x0f, 0xaa, 0xb0, 0x72, 0x25, 0x2a, 0x93, 0xdf, 0x82, 0x25, 0x11, 0x36, 0xd4, 0x63, 0xca,
+	0xc7, 0x71, 0x67, 0xff, 0x2b, 0x00, 0x9f, 0x53, 0x8a, 0xf0, 0x2e, 0x65, 0x03, 0x63, 0xf0, 0xd9,
+	0x34, 0xe0, 0x05, 0xa0, 0x80, 0x9f, 0xda, 0x11, 0x7c, 0x2e, 0x0d, 0xf8, 0xa9, 0x1d, 0xc2, 0xeb,
+	0x50, 0xc5, 0xd9, 0x1f, 0x4f, 0xd8, 0x46, 0x41, 0x0d, 0xf9, 0x14, 0x34, 0x54, 0x22, 0x4c, 0x54,
+	0x72, 0x02, 0x2b, 0x68, 0x27, 0x91, 0x03, 0xd5, 0xb5, 0x89, 0x54, 0x4c, 0x41, 0xcf, 0xb2, 0xe5,
+	0x8d, 0x43, 0xdf, 0xda, 0xd4, 0x26, 0xc4, 0x00, 0x24, 0xa9, 0x43, 0x27, 0x0a, 0x62, 0x0b, 0x69,
+	0x8c, 0xc7, 0xf2, 0xc6, 0xbb, 0x4e, 0x18, 0xbf, 0xbe, 0x0a, 0xd2, 0x58, 0xbb, 0x50, 0x71, 0x90,
+	0x61, 0x00, 0xa2, 0xb6, 0xef, 0x9a, 0xd4, 0x63, 0x79, 0x53, 0x45, 0xd9, 0x18, 0x6b, 0x17, 0x4a,
+	0x8c, 0x2d, 0x73, 0x2e, 0xe6, 0x18, 0x28, 0xe9, 0x9f, 0x59, 0xd2, 0x62, 0x0a, 0x29, 0x4c, 0x71,
+	0xac, 0x5d, 0x0c, 0xce, 0x2c, 0x72, 0x0c, 0x35, 0x84, 0x65, 0x7e, 0x4e, 0x35, 0xed, 0x63, 0xcb,
+	0x39, 0x4f, 0x29, 0x45, 0xd2, 0x2e, 0x98, 0x8f, 0x6c, 0x33, 0x4c, 0x32, 0xe5, 0x03, 0xd7, 0x0c,
+	0xc3, 0xa5, 0x9e, 0x97, 0xd4, 0xb7, 0x94, 0x82, 0xbe, 0xf5, 0xb1, 0x76, 0xd1, 0xe0, 0xe0, 0x71,
+	0xb5, 0x27, 0xb0, 0x12, 0x0d, 0x2f, 0x70, 0x2a, 0xe5, 0x14, 0xf4, 0x2d, 0x07, 0xe3, 0x3b, 0x10,
+	0xae, 0xe9, 0x63, 0xd8, 0x88, 0x34, 0x71, 0xb7, 0xa4, 0xb2, 0x50, 0x21, 0x55, 0x6e, 0xad, 0xee,
+	0xaa, 0x19, 0xad, 0x06, 0xea, 0x14, 0x86, 0xac, 0x20, 0x30, 0x66, 0xb7, 0x9e, 0xa5, 0x79, 0x27,
+	0xaa, 0x7f, 0xe2, 0x52, 0xef, 0xc4, 0xb1, 0x0c, 0xa9, 0x9a, 0x82, 0xae, 0x2a, 0x03, 0x1d, 0x04,
+	0x98, 0xe4, 0x8c, 0x2f, 0x5d, 0x6c, 0x0f, 0x3a, 0xe3, 0xb1, 0xe9, 0x79, 0x98, 0x65, 0xa4, 0x91,
+	0xe7, 0xe1, 0xbc, 0x45, 0x5b, 0x31, 0xc4, 0x26, 0xa7, 0xb0, 0x3a, 0x9d, 0x4c, 0xa8, 0x1b, 0xe4,
+	0xbf, 0xaa, 0x65, 0x8e, 0x4d, 0x5f, 0xaa, 0xa5, 0xa0, 0xb2, 0xc6, 0x80, 0x79, 0x6e, 0xd1, 0x41,
+	0x54, 0x54, 0x66, 0x39, 0xe7, 0x57, 0x94, 0xad, 0xa4, 0xa1, 0x8c, 0x01, 0xc7, 0x95, 0x8d, 0xb8,
+	0x55, 0x06, 0xaa, 0x0c, 0x6a, 0xf9, 0x9a, 0x44, 0x52, 0x50, 0x85, 0xbb, 0x8e, 0x2b, 0x6a, 0x21,
+	0x26, 0xf9, 0x00, 0x36, 0x02, 0x25, 0x2e, 0xd5, 0x9d, 0x33, 0xea, 0xbe, 0x52, 0xf9, 0x21, 0x6d,
+	0x95, 0x39, 0x9b, 0x55, 0x9e, 0x4e, 0x29, 0x82, 0xd7, 0x44, 0x56, 0xfd, 0x1f, 0xb3, 0x50, 0x4d,
+	0xa6, 0x5d, 0x64, 0x80, 0x71, 0x57, 0xf3, 0x1c, 0x9b, 0xc5, 0xc0, 0xea, 0xce, 0xd7, 0x6e, 0x95,
+	0xb5, 0x6d, 0x05, 0x3f, 0x14, 0x86, 0xa1, 0x08, 0xac, 0xf8, 0xb1, 0x29, 0x9b, 0xe2, 0xb1, 0x69,
+	0x03, 0x8a, 0x27, 0xd4, 0x1c, 0x9d, 0xf8, 0x2c, 0xe4, 0xe5, 0x14, 0xd1, 0x22, 0x5f, 0x80, 0xaa,
+	0x69, 0xab, 0xae, 0x66, 0x8f, 0xa8, 0x98, 0x84, 0x3c, 0x9b, 0x84, 0xb2, 0x69, 0x2b, 0x48, 0xe4,
+	0xa3, 0x3f, 0x82, 0x6a, 0xb2, 0xbb, 0xe4, 0x6d, 0x78, 0xab, 0xd9, 0xeb, 0x75, 0x5a, 0xbd, 0xa3,
+	0xae, 0xaa, 0xc8, 0x8d, 0x7e, 0xaf, 0xab, 0xf6, 0x0e, 0x06, 0x6a, 0xef, 0xb9, 0xda, 0x69, 0xef,
+	0xb5, 0x07, 0xfd, 0xda, 0x3d, 0x52, 0x87, 0xcd, 0xcb, 0x9f, 0xb4, 0xe4, 0xce, 0xa0, 0xa1, 0xca,
+	0xdf, 0x6a, 0xca, 0x72, 0x4b, 0x6e, 0xd5, 0x32, 0xf5, 0xdf, 0xcb, 0x41, 0x35, 0x99, 0x6e, 0x93,
+	0x23, 0x28, 0x78, 0xbe, 0xe6, 0x53, 0x31, 0xab, 0x8d, 0x5b, 0x25, 0xeb, 0x97, 0x9a, 0x7d, 0x04,
+	0x52, 0x38, 0x1e, 0xf9, 0x25, 0x58, 0x61, 0x27, 0x47, 0xef, 0x9c, 0xd2, 0x89, 0x2a, 0x66, 0x23,
+	0xcb, 0x8f, 0x76, 0xc8, 0xe8, 0x23, 0xfd, 0x25, 0x9f, 0x96, 0x67, 0x70, 0x7f, 0x42, 0x79, 0x02,
+	0x2d, 0x92, 0x3a, 0xf5, 0xe3, 0x29, 0x65, 0x11, 0x29, 0xc7, 0xe6, 0x67, 0x5d, 0xb0, 0x77, 0x39,
+	0xf7, 0x9b, 0x9c, 0x49, 0x1e, 0xe3, 0xc9, 0x1f, 0x9d, 0x11, 0x4a, 0xf2, 0x3c, 0x39, 0xcf, 0x34,
+	0x54, 0x43, 0x32, 0x4f, 0x92, 0xff, 0x30, 0x03, 0xab, 0x33, 0xfa, 0x4a, 0xde, 0x04, 0xa9, 0x25,
+	0x2b, 0xf2, 0x8b, 0x36, 0xcb, 0x53, 0x31, 0x39, 0x3d, 0xe8, 0xee, 0xf6, 0xba, 0xad, 0x76, 0xf7,
+	0x45, 0xed, 0xde, 0x0c, 0xae, 0x22, 0x0f, 0x0e, 0x94, 0x2e, 0x72, 0x33, 0x33, 0xb9, 0x2d, 0x59,
+	0xde, 0x43, 0x6e, 0x96, 0x7c, 0x0e, 0xee, 0x5f, 0xe2, 0x62, 0x56, 0x3c, 0x40, 0x66, 0xae, 0xfe,
+	0x47, 0x39, 0x20, 0x57, 0x4f, 0x55, 0x98, 0x74, 0x52, 0x5b, 0x1b, 0x5a, 0xd4, 0x10, 0xf9, 0x6a,
+	0xd0, 0xc4, 0xa2, 0x0b, 0x3b, 0xe3, 0x6a, 0x93, 0x89, 0xf5, 0x2a, 0xc8, 0x80, 0x91, 0xd2, 0x40,
+	0x02, 0x79, 0x07, 0xaa, 0x09, 0xf7, 0x18, 0x4c, 0x5b, 0x25, 0xee, 0xd6, 0x3c, 0xf2, 0x11, 0xc0,
+	0xd8, 0xb4, 0xd5, 0x73, 0xbe, 0x16, 0x69, 0xa4, 0x4a, 0x8b, 0x63, 0xd3, 0x3e, 0xe2, 0x6b, 0x88,
+	0xe0, 0xda, 0x45, 0x00, 0x5e, 0x48, 0x05, 0x5c, 0xbb, 0x10, 0xe0, 0x3a, 0x1f, 0x60, 0xcc, 0xeb,
+	0xa7, 0x91, 0x80, 0xe1, 0xf4, 0x44, 0xce, 0xbe, 0xfe, 0xaf, 0x59, 0x80, 0xa8, 0x24, 0x44, 0x76,
+	0x60, 0x41, 0xa4, 0x0a, 0x22, 0xeb, 0x96, 0x7e, 0xfa, 0xa3, 0xa7, 0x6b, 0x42, 0x5c, 0xc4, 0xf9,
+	0xbe, 0xef, 0x9a, 0xf6, 0x48, 0x09, 0x3e, 0x24, 0x06, 0x2c, 0xc4, 0x8f, 0x29, 0x78, 0x04, 0x14,
+	0x02, 0x58, 0x64, 0x8c, 0x7c, 0x93, 0x63, 0xda, 0xbb, 0xdb, 0xd8, 0xf7, 0xbf, 0xfc, 0xf9, 0xc3,
+	0xc7, 0x73, 0xf4, 0x1d, 0x05, 0x94, 0x00, 0x9a, 0xac, 0x41, 0xc1, 0x39, 0xb7, 0xa9, 0xcb, 0xf3,
+	0x69, 0x85, 0x37, 0xc8, 0x47, 0x50, 0x09, 0x0a, 0x73, 0x7c, 0x47, 0xe7, 0xd9, 0x8e, 0x7e, 0x36,
+	0x77, 0x11, 0x6c, 0xab, 0xc9, 0xc5, 0xf9, 0x36, 0x2e, 0xeb, 0xb1, 0x56, 0xbd, 0x01, 0xe5, 0x38,
+	0x97, 0x48, 0xb0, 0xd6, 0x6e, 0x36, 0xd4, 0xe6, 0xcb, 0x46, 0xb7, 0x2b, 0x77, 0xd4, 0xa6, 0x22,
+	0x37, 0x06, 0x7c, 0xd3, 0xdc, 0x87, 0xd5, 0x2b, 0x1c, 0xe6, 0x7c, 0xfe, 0xbb, 0x00, 0x8b, 0xa1,
+	0x31, 0x92, 0x26, 0xd4, 0x9c, 0x09, 0x75, 0xf1, 0xb7, 0x3a, 0xef, 0x34, 0x2f, 0x07, 0x12, 0x82,
+	0x8c, 0x6e, 0x16, 0x87, 0x3a, 0xf5, 0x44, 0x49, 0x54, 0xb4, 0x30, 0x56, 0x9c, 0x47, 0xee, 0xf7,
+	0xce, 0x4e, 0x9d, 0x63, 0x91, 0x11, 0xd4, 0x44, 0x4e, 0x4c, 0x0d, 0x55, 0x1b, 0x87, 0xee, 0xfb,
+	0xce, 0x79, 0x5c, 0x88, 0xda, 0x60, 0xa0, 0x44, 0x83, 0x0a, 0xbd, 0xc0, 0xe9, 0x1f, 0x51, 0xcc,
+	0xdf, 0x68, 0x2a, 0xbb, 0xa9, 0x1c, 0x40, 0x2a, 0xb8, 0x7e, 0x8f, 0x21, 0xaa, 0x3b, 0x08, 0xcf,
+	0x59, 0xe4, 0x9e, 0x33, 0x24, 0x33, 0xcf, 0x89, 0x47, 0x6f, 0xde, 0xbd, 0xa1, 0x45, 0xd9, 0x69,
+	0xa4, 0xa4, 0x44, 0x04, 0xf2, 0x9b, 0x00, 0xb1, 0x3d, 0x59, 0x4a, 0xe3, 0x78, 0x17, 0xe1, 0xe1,
+	0x32, 0xfa, 0xce, 0x29, 0xb5, 0xbd, 0x74, 0x8e, 0x1b, 0x1c, 0x0b, 0x8d, 0xe6, 0x77, 0x34, 0x13,
+	0x9d, 0x2c, 0xf0, 0x03, 0x3c, 0x6f, 0x91, 0x4d, 0x00, 0xdf, 0x19, 0x0f, 0x3d, 0xdf, 0xb1, 0xa9,
+	0xc1, 0x0e, 0x04, 0x25, 0x25, 0x46, 0x21, 0xef, 0xc1, 0x8a, 0xee, 0xd8, 0x1e, 0xb5, 0xbd, 0xa9,
+	0x17, 0x9a, 0x2c, 0xcb, 0xe3, 0x95, 0x5a, 0xc8, 0x10, 0x96, 0x59, 0xff, 0x87, 0x2c, 0x2c, 0x04,
+	0xa5, 0xd9, 0x6b, 0x4a, 0xfb, 0x5f, 0x81, 0xa2, 0x30, 0xa4, 0x1b, 0xdd, 0x45, 0x1e, 0x07, 0xaf,
+	0x88, 0xcf, 0xd1, 0x05, 0xf0, 0x55, 0xe3, 0xf9, 0x05, 0x6f, 0x90, 0x76, 0x10, 0xcc, 0xf9, 0xd6,
+	0xff, 0xe0, 0xc6, 0x60, 0xce, 0x3a, 0x18, 0xfc, 0x9f, 0x08, 0xdf, 0xef, 0xc2, 0xb2, 0x39, 0xd4,
+	0x55, 0x8f, 0x7e, 0x3c, 0xa5, 0x18, 0x8f, 0xc3, 0x5a, 0x7f, 0xc5, 0x1c, 0xea, 0x7d, 0x41, 0x6d,
+	0x1b, 0x75, 0x1d, 0xca, 0x71, 0x71, 0xb2, 0x0a, 0xcb, 0x2d, 0x79, 0xbf, 0xd7, 0x6f, 0x0f, 0xd4,
+	0x7d, 0x39, 0x08, 0xa4, 0x35, 0x28, 0x07, 0xc4, 0xbe, 0xdc, 0xc5, 0x62, 0xd2, 0x1a, 0xd4, 0x02,
+	0x8a, 0x22, 0x37, 0xe5, 0xf6, 0xa1, 0xdc, 0xaa, 0x65, 0xc9, 0x06, 0x90, 0x80, 0x1a, 0xd4, 0x90,
+	0x58, 0xbc, 0xfc, 0x41, 0x1e, 0xa0, 0xd3, 0xdf, 0x9b, 0x63, 0x42, 0x07, 0x89, 0x09, 0xbd, 0xb3,
+	0xc9, 0x88, 0xd9, 0x1e, 0x40, 0xd1, 0x3b, 0xd1, 0x5c, 0x91, 0x8e, 0xdc, 0xd9, 0x9f, 0x70, 0x2c,
+	0x5c, 0xc3, 0xf8, 0x1d, 0x0b, 0x6f, 0xb0, 0x1a, 0xd1, 0x50, 0x17, 0xb7, 0x2f, 0x7c, 0xca, 0x4b,
+	0xe6, 0x50, 0xe7, 0x97, 0x2f, 0xef, 0x41, 0x70, 0xff, 0x11, 0x73, 0x9b, 0xfc, 0x9e, 0xa5, 0x16,
+	0x32, 0x02, 0xef, 0xd8, 0x0b, 0xac, 0x61, 0x81, 0x59, 0xc3, 0x2f, 0xdf, 0x60, 0x0d, 0xd1, 0x04,
+	0xc7, 0x7e, 0xde, 0x64, 0x13, 0xa5, 0x59, 0x36, 0x71, 0x02, 0xcb, 0x97, 0x10, 0xee, 0x66, 0x16,
+	0x12, 0xac, 0x05, 0xd4, 0x83, 0xee, 0xa0, 0xf7, 0xa1, 0xdc, 0x6d, 0x7f, 0x87, 0x1b, 0xc6, 0x5f,
+	0xe7, 0x61, 0xf1, 0x20, 0x70, 0x58, 0xd7, 0xd9, 0xc5, 0xdb, 0x50, 0xe6, 0xc7, 0x62, 0x7b, 0x3a,
+	0x1e, 0x52, 0x57, 0x24, 0xa2, 0x4b, 0x8c, 0xd6, 0x65, 0x24, 0x22, 0xc3, 0xd2, 0x58, 0xf3, 0xa7,
+	0x2e, 0x55, 0x7d, 0x73, 0x4c, 0xc5, 0x35, 0xda, 0x83, 0x2b, 0x25, 0xdc, 0x41, 0x70, 0xcd, 0xc7,
+	0x6b, 0xb8, 0xdf, 0xc7, 0x1a, 0x2e, 0x70, 0x41, 0x64, 0x91, 0xaf, 0xc3, 0xd2, 0x70, 0xea, 0xda,
+	0xf1, 0x00, 0x31, 0xc7, 0xbe, 0x06, 0x94, 0x11, 0xee, 0xbf, 0x05, 0x15, 0xee, 0x84, 0x03, 0x8c,
+	0xc2, 0x7c, 0x18, 0x65, 0x2e, 0x25, 0x50, 0x66, 0x2c, 0x56, 0x71, 0xc6, 0x62, 0x91, 0xbd, 0xa4,
+	0x95, 0x7c, 0xe5, 0x06, 0x2b, 0x09, 0x67, 0x3b, 0xfa, 0x15, 0xb7, 0x91, 0xfa, 0x9f, 0x66, 0xa0,
+	0x9a, 0xe4, 0x90, 0x75, 0x58, 0x09, 0xb3, 0xea, 0xd8, 0xea, 0xdf, 0x87, 0xd5, 0x88, 0xdc, 0xee,
+	0xb6, 0x07, 0x6d, 0x9e, 0x28, 0xa0, 0x17, 0x88, 0x18, 0x7b, 0x8d, 0xc1, 0x81, 0xc2, 0x53, 0xea,
+	0x04, 0x0e, 0xa3, 0xcb, 0xad, 0x5a, 0x2e, 0x89, 0xd3, 0xec, 0x34, 0xda, 0x7b, 0x8d, 0xdd, 0x8e,
+	0x5c, 0xcb, 0xa3, 0x31, 0x45, 0x8c, 0xe7, 0x8d, 0x76, 0x47, 0x6e, 0xd5, 0x0a, 0xf5, 0xdf, 0xcf,
+	0x42, 0xe5, 0xc0, 0xa3, 0x6e, 0x5a, 0x66, 0x13, 0x4b, 0x13, 0x73, 0xf3, 0xa6, 0x89, 0xbf, 0x0e,
+	0xe0, 0xf9, 0xa7, 0xb7, 0x34, 0x91, 0x45, 0xcf, 0x3f, 0x4d, 0xd3, 0x42, 0xea, 0x7f, 0x97, 0x8d,
+	0x9d, 0x42, 0xfe, 0x9f, 0xed, 0x22, 0x19, 0x56, 0xa2, 0x62, 0x4f, 0x30, 0xbf, 0xf9, 0x1b, 0xe6,
+	0xb7, 0x16, 0x8a, 0x08, 0x7a, 0x2c, 0xbe, 0x16, 0x6e, 0x17, 0x5f, 0xe7, 0xdc, 0x3d, 0x18, 0x99,
+	0xca, 0xf1, 0x52, 0xe9, 0x75, 0xb3, 0xd7, 0x81, 0x75, 0xcf, 0xd5, 0xd5, 0xab, 0xe3, 0xca, 0xde,
+	0x30, 0xae, 0x55, 0xcf, 0xd5, 0x0f, 0x2f, 0x0f, 0xad, 0x03, 0xeb, 0x86, 0xe7, 0xcf, 0x40, 0xbb,
+	0xc9, 0x0a, 0x57, 0x0d, 0xcf, 0x3f, 0xfc, 0xc5, 0x13, 0x95, 0xbf, 0xdd, 0x44, 0xed, 0xc1, 0x32,
+	0x5e, 0x6f, 0x58, 0x94, 0xd5, 0x91, 0xd9, 0x9a, 0x17, 0x6e, 0xb1, 0xe6, 0xd5, 0x48, 0x98, 0xad,
+	0xfb, 0xbc, 0x5e, 0xab, 0x9f, 0xf4, 0x5a, 0xbf, 0x76, 0x83, 0xd7, 0x8a, 0x2f, 0x51, 0xa2, 0x91,
+	0xf0, 0x5d, 0xdf, 0x80, 0x95, 0x2b, 0x3c, 0xf2, 0x00, 0x36, 0x14, 0x39, 0xc8, 0x46, 0x7a, 0xdd,
+	0x98, 0xa7, 0xba, 0x47, 0xde, 0x80, 0xf5, 0x04, 0x2f, 0x74, 0x56, 0x99, 0xfa, 0xf7, 0xf2, 0xb0,
+	0xd4, 0xc7, 0x22, 0x26, 0x16, 0xb6, 0x5c, 0xe3, 0x3a, 0xbb, 0x98, 0x69, 0xeb, 0xd9, 0x5b, 0xdb,
+	0xfa, 0x2f, 0xaa, 0x39, 0x7d, 0x15, 0xf2, 0x6c, 0x59, 0xf2, 0xb7, 0x58, 0x16, 0x26, 0x81, 0xa7,
+	0x6e, 0x56, 0x87, 0xa5, 0x09, 0x3f, 0x73, 0xd7, 0xa4, 0xaa, 0x22, 0x30, 0x85, 0x2f, 0xb3, 0x61,
+	0x2d, 0x71, 0xd8, 0x51, 0x87, 0xf4, 0xd8, 0x71, 0x69, 0x2a, 0x07, 0x7c, 0x12, 0x3f, 0xf3, 0xec,
+	0x32, 0x5c, 0xbc, 0x79, 0x4f, 0xea, 0xd3, 0x8e, 0x7d, 0x9a, 0xce, 0x45, 0xcb, 0x4a, 0x5c, 0x5d,
+	0x03, 0x61, 0xeb, 0x7f, 0x9b, 0x81, 0xb5, 0x78, 0xa5, 0x67, 0xdf, 0x75, 0x26, 0x8e, 0xa7, 0x59,
+	0xd7, 0xd9, 0x43, 0xb4, 0x90, 0xd9, 0xc4, 0x42, 0xee, 0x25, 0xde, 0xa4, 0xe4, 0x1e, 0xe5, 0xe6,
+	0xb8, 0xbb, 0x8e, 0x74, 0xeb, 0x8e, 0x4b, 0x13, 0x0f, 0x53, 0xc2, 0x23, 0x44, 0x21, 0x76, 0x84,
+	0xf8, 0x46, 0xbe, 0x94, 0xaf, 0x15, 0x94, 0x05, 0x2c, 0x34, 0x99, 0xd4, 0xa8, 0xff, 0x6f, 0x06,
+	0xaa, 0x49, 0x8c, 0x74, 0x4e, 0xee, 0x0a, 0x14, 0x3c, 0x44, 0x4b, 0xa5, 0xea, 0xca, 0xa1, 0x3e,
+	0x9b, 0x53, 0x7f, 0xfd, 0x9f, 0x33, 0xb0, 0x12, 0x5f, 0x41, 0x85, 0xdd, 0xe4, 0x5e, 0xb3, 0x7c,
+	0xe1, 0xbc, 0x66, 0xe3, 0x47, 0xb3, 0x77, 0xa0, 0x6a, 0x98, 0x9e, 0xa8, 0x7f, 0x1b, 0x8e, 0x1d,
+	0xdc, 0x2b, 0x57, 0x42, 0x6a, 0xcb, 0xb1, 0x29, 0xf9, 0x12, 0xac, 0x79, 0xe6, 0xc8, 0xa6, 0x86,
+	0x3a, 0xb4, 0x1c, 0xfd, 0xd4, 0x53, 0xcf, 0x4d, 0xdb, 0x70, 0xce, 0x45, 0x59, 0x93, 0x70, 0xde,
+	0x2e, 0x63, 0x1d, 0x31, 0x0e, 0x1e, 0x5b, 0x75, 0xcd, 0x36, 0xb0, 0x7f, 0xd4, 0x63, 0x6b, 0x99,
+	0x57, 0x62, 0x14, 0xf2, 0x00, 0x4a, 0x67, 0xd4, 0x35, 0x8f, 0x4d, 0xca, 0x5d, 0x69, 0x5e, 0x09,
+	0xdb, 0xf5, 0x7f, 0x2b, 0xc2, 0x7a, 0xa2, 0x0e, 0x19, 0x88, 0x5d, 0x37, 0xbe, 0x59, 0xeb, 0x9f,
+	0xbd, 0xed, 0xfa, 0xcf, 0x3c, 0x4c, 0xe7, 0x66, 0x1f, 0xa6, 0x63, 0x65, 0x9e, 0x7c, 0xa2, 0xcc,
+	0x13, 0x9d, 0xe4, 0x0b, 0x89, 0x93, 0x7c, 0x54, 0x37, 0x28, 0xa6, 0x58, 0x37, 0x48, 0xd6, 0x3a,
+	0x16, 0x52, 0xae, 0x75, 0x44, 0xc5, 0x25, 0x7c, 0xa2, 0xc3, 0x0f, 0x9b, 0x69, 0xd4, 0x53, 0x96,
+	0x43, 0xd4, 0x3e, 0x3f, 0x75, 0x6a, 0x50, 0x09, 0x5e, 0x46, 0x70, 0x2d, 0x8b, 0x69, 0x14, 0x97,
+	0x38, 0xa4, 0x50, 0x31, 0x81, 0xf5, 0x28, 0xa0, 0xb1, 0x34, 0x55, 0xa8, 0x82, 0x34, 0xae, 0x21,
+	0x43, 0x68, 0xbc, 0xd3, 0x16, 0x1a, 0x77, 0x60, 0x1d, 0x27, 0x32, 0xda, 0x36, 0xac, 0xa0, 0x49,
+	0x5d, 0x56, 0xc6, 0xc9, 0x29, 0xab, 0x9c, 0xc9, 0xf7, 0x4d, 0x93, 0xb3, 0xf0, 0x9d, 0x4d, 0xd4,
+	0xcb, 0x70, 0x8b, 0x94, 0x99, 0x25, 0x45, 0x01, 0xf9, 0x50, 0x30, 0x50, 0x05, 0xee, 0x3e, 0xac,
+	0x97, 0x99, 0xf6, 0xb1, 0x13, 0x49, 0x54, 0x98, 0xc4, 0xaa, 0x60, 0xb6, 0xed, 0x63, 0x27, 0x90,
+	0xa9, 0xef, 0x40, 0xe9, 0xc3, 0xc3, 0x83, 0x09, 0xdb, 0x51, 0x35, 0xc8, 0x9d, 0xd2, 0x57, 0x62,
+	0x33, 0xe1, 0x4f, 0x74, 0x14, 0xb1, 0x9b, 0x27, 0x85, 0x37, 0xea, 0xff, 0x91, 0x81, 0x1a, 0x86,
+	0x64, 0xec, 0x2b, 0x35, 0x84, 0x70, 0x15, 0xb2, 0x62, 0x23, 0xe6, 0x95, 0xac, 0x99, 0x74, 0x3f,
+	0xd9, 0xe4, 0xf6, 0x7c, 0x06, 0x78, 0x31, 0x70, 0xe2, 0xb8, 0xa6, 0xff, 0xea, 0xc6, 0x5c, 0x30,
+	0xfa, 0x94, 0x34, 0x60, 0x61, 0x3a, 0xe1, 0x4e, 0x24, 0xcf, 0x42, 0xcb, 0xe3, 0x1b, 0x42, 0x4b,
+	0x30, 0x32, 0x25, 0x90, 0xc3, 0xa2, 0x22, 0xbd, 0xa0, 0xfa, 0x34, 0xf6, 0x6c, 0x89, 0xc7, 0x96,
+	0x6a, 0x48, 0xe6, 0xd7, 0x31, 0x7f, 0x95, 0x81, 0xb5, 0x60, 0x8c, 0x8c, 0xe2, 0x89, 0x71, 0x7e,
+	0x19, 0x36, 0x38, 0x98, 0xea, 0x0b, 0x36, 0xc7, 0xe1, 0x11, 0x26, 0xa7, 0xac, 0x71, 0x6e, 0x52,
+	0x36, 0x39, 0xe4, 0xec, 0xfc, 0x43, 0x9e, 0xd1, 0xdf, 0xdc, 0xcc, 0xfe, 0xfe, 0x79, 0x0e, 0x2a,
+	0xe1, 0xd3, 0x9c, 0x43, 0xe7, 0x7a, 0xff, 0xf8, 0x10, 0x96, 0x26, 0x22, 0xca, 0x07, 0xcb, 0x93,
+	0x57, 0x20, 0x20, 0xb5, 0x0d, 0xf2, 0x1c, 0x16, 0x1c, 0xf6, 0xba, 0x24, 0x08, 0xe2, 0xef, 0x06,
+	0xc9, 0x36, 0xbe, 0x2e, 0x0e, 0xa6, 0x97, 0xdf, 0x7c, 0x50, 0x03, 0xd5, 0xf5, 0xd8, 0xe7, 0x22,
+	0xf3, 0x0e, 0x84, 0x63, 0x79, 0x42, 0x7e, 0x66, 0xc2, 0x57, 0xb8, 0x75, 0xc2, 0x37, 0x6f, 0xf6,
+	0xdd, 0x49, 0x66, 0xdf, 0xcf, 0xe6, 0x7d, 0x92, 0x87, 0x63, 0xd9, 0xc2, 0x7f, 0x12, 0x69, 0x77,
+	0x0b, 0x16, 0x43, 0x1a, 0x21, 0x50, 0x3d, 0xec, 0x0d, 0xe4, 0x44, 0x9a, 0x1d, 0xd0, 0xfa, 0x07,
+	0xcd, 0xe0, 0x2a, 0x93, 0x2c, 0xc3, 0x12, 0xa3, 0x89, 0x73, 0x7d, 0xb6, 0xfe, 0x9f, 0x39, 0xa8,
+	0x30, 0x18, 0x73, 0x64, 0x6b, 0xd6, 0x0d, 0x07, 0xd9, 0x1b, 0xd7, 0xe8, 0x37, 0xa0, 0x44, 0x6d,
+	0xe3, 0xf6, 0x67, 0xd8, 0x05, 0x6a, 0x1b, 0x48, 0xc7, 0xbb, 0x3c, 0x5f, 0xb3, 0x30, 0x87, 0x12,
+	0xef, 0xc4, 0x82, 0x26, 0xd9, 0x85, 0x02, 0xfe, 0x7c, 0x25, 0x15, 0x5e, 0x63, 0xf1, 0xb9, 0xe8,
+	0xdc, 0x0b, 0xb5, 0x01, 0x45, 0xdd, 0x72, 0x3c, 0x6a, 0x88, 0xd2, 0xbd, 0x68, 0x91, 0x6f, 0x43,
+	0x85, 0x5b, 0x91, 0x3a, 0xc1, 0x87, 0x01, 0x18, 0x6a, 0x72, 0x73, 0xbc, 0xad, 0x8c, 0xba, 0xb3,
+	0x8f, 0x62, 0x41, 0x55, 0xc1, 0x89, 0x48, 0xec, 0xc1, 0x9a, 0xef, 0xf8, 0x9a, 0xc5, 0x91, 0x53,
+	0xa9, 0xdc, 0x03, 0x03, 0x64, 0xf8, 0xf5, 0x3f, 0xc9, 0xc0, 0xf2, 0xa5, 0x6e, 0x90, 0x67, 0x50,
+	0xe4, 0x5d, 0x10, 0x97, 0xd8, 0x9b, 0xb3, 0xa6, 0x34, 0x12, 0x52, 0xc4, 0xd7, 0x98, 0x84, 0xf2,
+	0x4e, 0xa6, 0x92, 0x84, 0x32, 0xa8, 0xfa, 0x1f, 0x67, 0x01, 0x22, 0x33, 0xbc, 0x93, 0x0d, 0x7e,
+	0x19, 0x4a, 0x1e, 0x43, 0x09, 0x6e, 0xfa, 0xae, 0xf1, 0x6a, 0xe1, 0x97, 0x71, 0xef, 0x92, 0xbf,
+	0x8b, 0x77, 0x09, 0x27, 0x27, 0x8d, 0x33, 0x9f, 0x98, 0x9c, 0xdf, 0x5d, 0x80, 0x72, 0x33, 0x7c,
+	0xee, 0xe1, 0xbe, 0x46, 0x1a, 0x9d, 0xfe, 0x21, 0x37, 0xf6, 0x02, 0xa4, 0x90, 0xe2, 0x0b, 0x10,
+	0x0d, 0x2a, 0x63, 0xd3, 0x8e, 0xdd, 0x14, 0xa6, 0x91, 0x8a, 0x96, 0x39, 0x64, 0x74, 0x4d, 0xc8,
+	0x76, 0x68, 0xa8, 0x62, 0x21, 0x0d, 0x15, 0x1c, 0x52, 0xa8, 0x98, 0xc0, 0x3a, 0xc7, 0x56, 0xd1,
+	0x55, 0x50, 0xd7, 0x33, 0x3d, 0x1f, 0x5d, 0x8b, 0x54, 0x4a, 0x41, 0xd5, 0x2a, 0x87, 0xee, 0xd9,
+	0xfb, 0x11, 0x30, 0x19, 0xc3, 0x5a, 0xa4, 0x91, 0xfd, 0x1d, 0x0a, 0x33, 0x88, 0x54, 0xfc, 0xc8,
+	0x4a, 0xa0, 0x30, 0xfa, 0xb3, 0x1b, 0x1f, 0xee, 0xb3, 0xf4, 0xde, 0xfc, 0x2e, 0x35, 0xd4, 0xe4,
+	0x6c, 0xa6, 0xf1, 0x04, 0x71, 0x3d, 0x04, 0xef, 0xc7, 0xa7, 0xf5, 0xbb, 0xf0, 0x20, 0x4a, 0x3d,
+	0xa3, 0x7b, 0x58, 0xa1, 0x38, 0x8d, 0xb7, 0x88, 0xd2, 0xd9, 0x95, 0xc2, 0xae, 0xa8, 0xfa, 0xfe,
+	0x57, 0x06, 0x2b, 0x96, 0xf8, 0xc7, 0x2e, 0xaf, 0xbb, 0x07, 0xa3, 0x3b, 0xb6, 0x5c, 0x8a, 0x77,
+	0x6c, 0x5d, 0xc8, 0xbd, 0xde, 0x03, 0xde, 0xab, 0x90, 0x08, 0x54, 0xff, 0x8b, 0x0c, 0x14, 0xc5,
+	0x0b, 0xcc, 0x5b, 0x8f, 0x50, 0xba, 0x54, 0xd3, 0x8f, 0x2a, 0xf7, 0x83, 0x44, 0x9d, 0x34, 0xa5,
+	0xb1, 0xd7, 0x7f, 0x90, 0x81, 0x0a, 0x7f, 0x55, 0xd9, 0x9b, 0xfa, 0xaf, 0xd7, 0xe5, 0xcf, 0x64,
+	0x51, 0xea, 0x2a, 0x54, 0x8f, 0x1c, 0xf7, 0x14, 0xbb, 0xd4, 0x9c, 0xba, 0x9e, 0xe3, 0x5e, 0xd7,
+	0xb1, 0x07, 0x50, 0x3a, 0x17, 0x1f, 0x8b, 0x43, 0x49, 0xd8, 0x66, 0x89, 0x08, 0x03, 0x60, 0xdd,
+	0x2b, 0x2b, 0xa2, 0x55, 0xff, 0x9f, 0x2c, 0xd4, 0x94, 0xf0, 0xb9, 0xf5, 0xcd, 0x16, 0xf9, 0x16,
+	0x7b, 0xc2, 0x14, 0xfc, 0x71, 0x1a, 0xd7, 0x82, 0x8f, 0x90, 0xfc, 0xd6, 0xd5, 0xcb, 0xd3, 0xdc,
+	0xa5, 0xcb, 0xd3, 0x98, 0xa7, 0xcf, 0xa7, 0xe8, 0xe9, 0x07, 0x89, 0x3b, 0x86, 0xb4, 0xb6, 0xc3,
+	0x47, 0x89, 0x2b, 0xa2, 0x34, 0x82, 0x47, 0x74, 0x7f, 0xb4, 0xfb, 0xd1, 0x8f, 0x3f, 0xd9, 0xcc,
+	0xfc, 0xe4, 0x93, 0xcd, 0xcc, 0xbf, 0x7f, 0xb2, 0x99, 0xf9, 0xfe, 0xa7, 0x9b, 0xf7, 0x7e, 0xf2,
+	0xe9, 0xe6, 0xbd, 0x7f, 0xfa, 0x74, 0xf3, 0xde, 0x77, 0x1a, 0x31, 0xe8, 0x98, 0xbf, 0xef, 0xd9,
+	0x74, 0x9b, 0xa7, 0x86, 0x4f, 0x6d, 0x0d, 0xff, 0xea, 0x6a, 0xfb, 0x6c, 0x67, 0xfb, 0xe2, 0xf2,
+	0x5f, 0x6f, 0x32, 0xcd, 0xc3, 0x22, 0x0b, 0xba, 0x1f, 0xfc, 0xdf, 0x00, 0xfa, 0x61, 0x37, 0x18,
+	0xe3, 0x39, 0x00, 0x00,
+}
+
+func (m *HostChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RegistrationEpoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.RegistrationEpoch))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if m.CValueCooldown != nil {
+		{
+			size, err := m.CValueCooldown.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.Pauses != nil {
+		{
+			size, err := m.Pauses.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.Deregistration != nil {
+		{
+			size, err := m.Deregistration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.ValidatorSetConfig != nil {
+		{
+			size, err := m.ValidatorSetConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.DelegationStrategy != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.DelegationStrategy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Flags != nil {
+		{
+			size, err := m.Flags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	{
+		size := m.AutoCompoundFactor.Size()
+		i -= size
+		if _, err := m.AutoCompoundFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.UnbondingFactor != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.UnbondingFactor))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.LastCValue.Size()
+		i -= size
+		if _, err := m.LastCValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.CValue.Size()
+		i -= size
+		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MinimumDeposit.Size()
+		i -= size
+		if _, err := m.MinimumDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.RewardsAccount != nil {
+		{
+			size, err := m.RewardsAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DelegationAccount != nil {
+		{
+			size, err := m.DelegationAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostChainFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostChainFlags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostChainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rebalance {
+		i--
+		if m.Rebalance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Lsm {
+		i--
+		if m.Lsm {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostChainPauses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostChainPauses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostChainPauses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IcaSends {
+		i--
+		if m.IcaSends {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Autocompound {
+		i--
+		if m.Autocompound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Redeem {
+		i--
+		if m.Redeem {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Unstake {
+		i--
+		if m.Unstake {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LsmStake {
+		i--
+		if m.LsmStake {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LiquidStake {
+		i--
+		if m.LiquidStake {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostChainLSParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostChainLSParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostChainLSParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CValueRecoveryCount != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.CValueRecoveryCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		size := m.MaxCValueDelta.Size()
+		i -= size
+		if _, err := m.MaxCValueDelta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.LowerCValueLimit.Size()
+		i -= size
+		if _, err := m.LowerCValueLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.UpperCValueLimit.Size()
+		i -= size
+		if _, err := m.UpperCValueLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.MaxValidatorCommission.Size()
+		i -= size
+		if _, err := m.MaxValidatorCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.SlashThreshold.Size()
+		i -= size
+		if _, err := m.SlashThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.MaxEpochRedeemRatio.Size()
+		i -= size
+		if _, err := m.MaxEpochRedeemRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MaxEpochUnstake.Size()
+		i -= size
+		if _, err := m.MaxEpochUnstake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.MaxAddressEpochInflow.Size()
+		i -= size
+		if _, err := m.MaxAddressEpochInflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MaxEpochInflow.Size()
+		i -= size
+		if _, err := m.MaxEpochInflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MaxTvl.Size()
+		i -= size
+		if _, err := m.MaxTvl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.MaxRedelegationEntries != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.MaxRedelegationEntries))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.LsmBondFactor.Size()
+		i -= size
+		if _, err := m.LsmBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.LsmValidatorCap.Size()
+		i -= size
+		if _, err := m.LsmValidatorCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RedemptionFee.Size()
+		i -= size
+		if _, err := m.RedemptionFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.UnstakeFee.Size()
+		i -= size
+		if _, err := m.UnstakeFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RestakeFee.Size()
+		i -= size
+		if _, err := m.RestakeFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.DepositFee.Size()
+		i -= size
+		if _, err := m.DepositFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CValueCooldown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CValueCooldown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CValueCooldown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InRangeCount != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.InRangeCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.CValue.Size()
+		i -= size
+		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Reason != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Deregistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deregistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deregistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RedeemingEpoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.RedeemingEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PendingBalanceQueries != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.PendingBalanceQueries))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastSweepHeight != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.LastSweepHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.State != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSetConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxCommission.Size()
+		i -= size
+		if _, err := m.MaxCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxWeight.Size()
+		i -= size
+		if _, err := m.MaxWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinWeight.Size()
+		i -= size
+		if _, err := m.MinWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxValidators != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.MaxValidators))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AutoApply {
+		i--
+		if m.AutoApply {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ICAAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICAAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICAAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChannelState != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.ChannelState))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Validator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Delegable {
+		i--
+		if m.Delegable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UnbondingEpoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.UnbondingEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DelegatedAmount.Size()
+		i -= size
+		if _, err := m.DelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.State != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LSMDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LSMDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LSMDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.State != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.IbcDenom) > 0 {
+		i -= len(m.IbcDenom)
+		copy(dAtA[i:], m.IbcDenom)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Unbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Unbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Unbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.UnbondAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.BurnAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.MatureTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UnbondAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.StkAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.MatureTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Redelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Redelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DstValidatorAddress) > 0 {
+		i -= len(m.DstValidatorAddress)
+		copy(dAtA[i:], m.DstValidatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.DstValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SrcValidatorAddress) > 0 {
+		i -= len(m.SrcValidatorAddress)
+		copy(dAtA[i:], m.SrcValidatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.SrcValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExchangeRateAfter.Size()
+		i -= size
+		if _, err := m.ExchangeRateAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ExchangeRateBefore.Size()
+		i -= size
+		if _, err := m.ExchangeRateBefore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SlashedAmount.Size()
+		i -= size
+		if _, err := m.SlashedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSetProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSetRound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetRound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetRound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verified != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Verified))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Candidates != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Candidates))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DiscoveryDone {
+		i--
+		if m.DiscoveryDone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSetCandidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetCandidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetCandidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SigningInfoVerified {
+		i--
+		if m.SigningInfoVerified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ValidatorVerified {
+		i--
+		if m.ValidatorVerified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.ValidatorBondShares.Size()
+		i -= size
+		if _, err := m.ValidatorBondShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.LiquidShares.Size()
+		i -= size
+		if _, err := m.LiquidShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.DelegatorShares.Size()
+		i -= size
+		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KVUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KVUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KVUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimelockedUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelockedUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelockedUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutionEpoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.ExecutionEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimelockEpochsUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelockEpochsUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelockEpochsUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutionEpoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.ExecutionEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.UpdateTimelockEpochs != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.UpdateTimelockEpochs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostChainVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostChainVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostChainVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteSignaling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteSignaling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteSignaling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalPower.Size()
+		i -= size
+		if _, err := m.TotalPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.OptionPowers) > 0 {
+		for iNdEx := len(m.OptionPowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OptionPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Closed {
+		i--
+		if m.Closed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Tally) > 0 {
+		for iNdEx := len(m.Tally) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tally[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Tallied {
+		i--
+		if m.Tallied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x1a
+	if m.ProposalId != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteOptionPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteOptionPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteOptionPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Power.Size()
+		i -= size
+		if _, err := m.Power.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Option != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteSignal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteSignal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Power.Size()
+		i -= size
+		if _, err := m.Power.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Signaler) > 0 {
+		i -= len(m.Signaler)
+		copy(dAtA[i:], m.Signaler)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Signaler)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CValueRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CValueRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CValueRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ValidatorUnbondingAmount.Size()
+		i -= size
+		if _, err := m.ValidatorUnbondingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.TokenizedStakedAmount.Size()
+		i -= size
+		if _, err := m.TokenizedStakedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.AmountOnHostChain.Size()
+		i -= size
+		if _, err := m.AmountOnHostChain.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.AmountOnPersistence.Size()
+		i -= size
+		if _, err := m.AmountOnPersistence.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.StakedAmount.Size()
+		i -= size
+		if _, err := m.StakedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MintedAmount.Size()
+		i -= size
+		if _, err := m.MintedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CValue.Size()
+		i -= size
+		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Epoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Inflow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Inflow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Inflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedeemOutflow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedeemOutflow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedeemOutflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Epoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Workflow) > 0 {
+		i -= len(m.Workflow)
+		copy(dAtA[i:], m.Workflow)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Workflow)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedemptionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedemptionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StkAmount.Size()
+		i -= size
+		if _, err := m.StkAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CValue.Size()
+		i -= size
+		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.IbcDenom) > 0 {
+		i -= len(m.IbcDenom)
+		copy(dAtA[i:], m.IbcDenom)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquidstakeibc(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidstakeibc(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HostChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.DelegationAccount != nil {
+		l = m.DelegationAccount.Size()
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.RewardsAccount != nil {
+		l = m.RewardsAccount.Size()
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	l = m.MinimumDeposit.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.CValue.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.LastCValue.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.UnbondingFactor != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.UnbondingFactor))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = m.AutoCompoundFactor.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.Flags != nil {
+		l = m.Flags.Size()
+		n += 2 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.DelegationStrategy != 0 {
+		n += 2 + sovLiquidstakeibc(uint64(m.DelegationStrategy))
+	}
+	if m.ValidatorSetConfig != nil {
+		l = m.ValidatorSetConfig.Size()
+		n += 2 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Deregistration != nil {
+		l = m.Deregistration.Size()
+		n += 2 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Pauses != nil {
+		l = m.Pauses.Size()
+		n += 2 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.CValueCooldown != nil {
+		l = m.CValueCooldown.Size()
+		n += 2 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	if m.RegistrationEpoch != 0 {
+		n += 2 + sovLiquidstakeibc(uint64(m.RegistrationEpoch))
+	}
+	return n
+}
+
+func (m *HostChainFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lsm {
+		n += 2
+	}
+	if m.Rebalance {
+		n += 2
+	}
+	return n
+}
+
+func (m *HostChainPauses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LiquidStake {
+		n += 2
+	}
+	if m.LsmStake {
+		n += 2
+	}
+	if m.Unstake {
+		n += 2
+	}
+	if m.Redeem {
+		n += 2
+	}
+	if m.Autocompound {
+		n += 2
+	}
+	if m.IcaSends {
+		n += 2
+	}
+	return n
+}
+
+func (m *HostChainLSParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DepositFee.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.RestakeFee.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.UnstakeFee.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.RedemptionFee.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.LsmValidatorCap.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.LsmBondFactor.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.MaxRedelegationEntries != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.MaxRedelegationEntries))
+	}
+	l = m.MaxTvl.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MaxEpochInflow.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MaxAddressEpochInflow.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MaxEpochUnstake.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MaxEpochRedeemRatio.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.SlashThreshold.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MaxValidatorCommission.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.UpperCValueLimit.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	l = m.LowerCValueLimit.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MaxCValueDelta.Size()
+	n += 2 + l + sovLiquidstakeibc(uint64(l))
+	if m.CValueRecoveryCount != 0 {
+		n += 2 + sovLiquidstakeibc(uint64(m.CValueRecoveryCount))
+	}
+	return n
+}
+
+func (m *CValueCooldown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Reason))
+	}
+	l = m.CValue.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Height))
+	}
+	if m.InRangeCount != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.InRangeCount))
+	}
+	return n
+}
+
+func (m *Deregistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	}
+	if m.LastSweepHeight != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.LastSweepHeight))
+	}
+	if m.PendingBalanceQueries != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.PendingBalanceQueries))
+	}
+	if m.RedeemingEpoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.RedeemingEpoch))
+	}
+	return n
+}
+
+func (m *ValidatorSetConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.AutoApply {
+		n += 2
+	}
+	if m.MaxValidators != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.MaxValidators))
+	}
+	l = m.MinWeight.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MaxWeight.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MaxCommission.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *ICAAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.ChannelState != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.ChannelState))
+	}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.DelegatedAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.UnbondingEpoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.UnbondingEpoch))
+	}
+	if m.Delegable {
+		n += 2
+	}
+	l = m.Commission.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.Tokens.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.Jailed {
+		n += 2
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.Epoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Epoch))
+	}
+	if m.State != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	}
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *LSMDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.Shares.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	}
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *Unbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.BurnAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.UnbondAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	}
+	return n
+}
+
+func (m *UserUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.StkAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.UnbondAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *ValidatorUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *Redelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.SrcValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.DstValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	}
+	return n
+}
+
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.SlashedAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.ExchangeRateBefore.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.ExchangeRateAfter.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *ValidatorSetProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Height))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *ValidatorScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Score.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.Weight.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *ValidatorSetRound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Epoch))
+	}
+	if m.DiscoveryDone {
+		n += 2
+	}
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.SignedBlocksWindow))
+	}
+	if m.Candidates != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Candidates))
+	}
+	if m.Verified != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Verified))
+	}
+	return n
+}
+
+func (m *ValidatorSetCandidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.LiquidShares.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.ValidatorBondShares.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.MissedBlocksCounter))
+	}
+	if m.ValidatorVerified {
+		n += 2
+	}
+	if m.SigningInfoVerified {
+		n += 2
+	}
+	return n
+}
+
+func (m *KVUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *TimelockedUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Id))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	if m.ExecutionEpoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.ExecutionEpoch))
+	}
+	return n
+}
+
+func (m *TimelockEpochsUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateTimelockEpochs != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.UpdateTimelockEpochs))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.ExecutionEpoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.ExecutionEpoch))
+	}
+	return n
+}
+
+func (m *HostChainVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.ProposalId))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	}
+	return n
+}
+
+func (m *VoteSignaling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.ProposalId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.Tallied {
+		n += 2
+	}
+	if len(m.Tally) > 0 {
+		for _, e := range m.Tally {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Closed {
+		n += 2
+	}
+	if len(m.OptionPowers) > 0 {
+		for _, e := range m.OptionPowers {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	l = m.TotalPower.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *VoteOptionPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Option))
+	}
+	l = m.Power.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *VoteSignal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.ProposalId))
+	}
+	l = len(m.Signaler)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	l = m.Power.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *CValueRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.CValue.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MintedAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.StakedAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.AmountOnPersistence.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.AmountOnHostChain.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.TokenizedStakedAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.ValidatorUnbondingAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *RewardRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Epoch))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *Inflow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Epoch))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *RedeemOutflow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Epoch))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *WorkflowCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.Workflow)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *RedemptionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.CValue.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.StkAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func sovLiquidstakeibc(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiquidstakeibc(x uint64) (n int) {
+	return sovLiquidstakeibc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HostChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &HostChainLSParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelegationAccount == nil {
+				m.DelegationAccount = &ICAAccount{}
+			}
+			if err := m.DelegationAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RewardsAccount == nil {
+				m.RewardsAccount = &ICAAccount{}
+			}
+			if err := m.RewardsAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastCValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFactor", wireType)
+			}
+			m.UnbondingFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingFactor |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoCompoundFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flags == nil {
+				m.Flags = &HostChainFlags{}
+			}
+			if err := m.Flags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationStrategy", wireType)
+			}
+			m.DelegationStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationStrategy |= HostChain_DelegationStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorSetConfig == nil {
+				m.ValidatorSetConfig = &ValidatorSetConfig{}
+			}
+			if err := m.ValidatorSetConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deregistration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deregistration == nil {
+				m.Deregistration = &Deregistration{}
+			}
+			if err := m.Deregistration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pauses == nil {
+				m.Pauses = &HostChainPauses{}
+			}
+			if err := m.Pauses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CValueCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CValueCooldown == nil {
+				m.CValueCooldown = &CValueCooldown{}
+			}
+			if err := m.CValueCooldown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationEpoch", wireType)
+			}
+			m.RegistrationEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostChainFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostChainFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostChainFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lsm", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lsm = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebalance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rebalance = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostChainPauses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostChainPauses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostChainPauses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStake", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LiquidStake = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LsmStake", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LsmStake = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unstake", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unstake = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeem", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Redeem = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Autocompound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Autocompound = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaSends", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IcaSends = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostChainLSParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostChainLSParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RestakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnstakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LsmValidatorCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LsmValidatorCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LsmBondFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LsmBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedelegationEntries", wireType)
+			}
+			m.MaxRedelegationEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRedelegationEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTvl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEpochInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxEpochInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAddressEpochInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAddressEpochInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEpochUnstake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxEpochUnstake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEpochRedeemRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxEpochRedeemRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperCValueLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpperCValueLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerCValueLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LowerCValueLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCValueDelta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCValueDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CValueRecoveryCount", wireType)
+			}
+			m.CValueRecoveryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CValueRecoveryCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CValueCooldown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CValueCooldown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CValueCooldown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= CValueCooldown_CooldownReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InRangeCount", wireType)
+			}
+			m.InRangeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InRangeCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deregistration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deregistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deregistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Deregistration_DeregistrationState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSweepHeight", wireType)
+			}
+			m.LastSweepHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSweepHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBalanceQueries", wireType)
+			}
+			m.PendingBalanceQueries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingBalanceQueries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemingEpoch", wireType)
+			}
+			m.RedeemingEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedeemingEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSetConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoApply", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoApply = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICAAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICAAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICAAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelState", wireType)
+			}
+			m.ChannelState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelState |= ICAAccount_ChannelState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEpoch", wireType)
+			}
+			m.UnbondingEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delegable = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Deposit_DepositState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LSMDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LSMDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= LSMDeposit_LSMDepositState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Unbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Unbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Unbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatureTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.MatureTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Unbonding_UnbondingState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserUnbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StkAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StkAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorUnbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatureTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.MatureTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Redelegation_RedelegationState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateBefore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRateBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRateAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSetProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &ValidatorScore{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorScore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorScore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorScore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSetRound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetRound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetRound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryDone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DiscoveryDone = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			}
+			m.Candidates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Candidates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			m.Verified = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Verified |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSetCandidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetCandidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetCandidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatorVerified = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfoVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SigningInfoVerified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *KVUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovLiquidstakeibc(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLiquidstakeibc(x uint64) (n int) {
-	return sovLiquidstakeibc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *HostChain) Unmarshal(dAtA []byte) error {
+func (m *KVUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2340,15 +12186,15 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostChain: wiretype end group for non-group")
+			return fmt.Errorf("proto: KVUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostChain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KVUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2376,11 +12222,11 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2408,49 +12254,63 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstakeibc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &HostChainLSParams{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimelockedUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimelockedUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimelockedUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2460,27 +12320,14 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HostDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2508,11 +12355,11 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2540,11 +12387,11 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2571,18 +12418,16 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DelegationAccount == nil {
-				m.DelegationAccount = &ICAAccount{}
-			}
-			if err := m.DelegationAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Updates = append(m.Updates, &KVUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAccount", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionEpoch", wireType)
 			}
-			var msglen int
+			m.ExecutionEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2592,33 +12437,66 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExecutionEpoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RewardsAccount == nil {
-				m.RewardsAccount = &ICAAccount{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimelockEpochsUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
 			}
-			if err := m.RewardsAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimelockEpochsUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimelockEpochsUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimelockEpochs", wireType)
+			}
+			m.UpdateTimelockEpochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2628,29 +12506,14 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UpdateTimelockEpochs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2678,15 +12541,13 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinimumDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionEpoch", wireType)
 			}
-			var stringLen uint64
+			m.ExecutionEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2696,29 +12557,64 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExecutionEpoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostChainVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
 			}
-			iNdEx = postIndex
-		case 12:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostChainVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostChainVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2746,15 +12642,13 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastCValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			m.UnbondingFactor = 0
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2764,16 +12658,50 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingFactor |= int64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, v1beta1.WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2783,17 +12711,16 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
-		case 15:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2803,31 +12730,30 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AutoCompoundFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2837,83 +12763,29 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flags == nil {
-				m.Flags = &HostChainFlags{}
-			}
-			if err := m.Flags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HostChainFlags) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidstakeibc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HostChainFlags: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostChainFlags: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lsm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var v int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2923,12 +12795,11 @@ func (m *HostChainFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.State |= HostChainVote_VoteState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lsm = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
@@ -2950,7 +12821,7 @@ func (m *HostChainFlags) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
+func (m *VoteSignaling) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2973,15 +12844,15 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostChainLSParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteSignaling: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostChainLSParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteSignaling: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3009,15 +12880,32 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DepositFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestakeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3027,31 +12915,50 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RestakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tallied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tallied = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3061,29 +12968,29 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnstakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tally = append(m.Tally, v1beta1.WeightedVoteOption{})
+			if err := m.Tally[len(m.Tally)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3111,15 +13018,33 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedemptionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Closed = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LsmValidatorCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionPowers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3129,29 +13054,29 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LsmValidatorCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OptionPowers = append(m.OptionPowers, VoteOptionPower{})
+			if err := m.OptionPowers[len(m.OptionPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LsmBondFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3179,7 +13104,7 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LsmBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3204,7 +13129,7 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ICAAccount) Unmarshal(dAtA []byte) error {
+func (m *VoteOptionPower) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3227,17 +13152,17 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ICAAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteOptionPower: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ICAAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteOptionPower: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
 			}
-			var stringLen uint64
+			m.Option = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3247,60 +13172,14 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Option |= v1beta1.VoteOption(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstakeibc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3328,27 +13207,10 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelState", wireType)
-			}
-			m.ChannelState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstakeibc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChannelState |= ICAAccount_ChannelState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
@@ -3370,7 +13232,7 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *VoteSignal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3393,15 +13255,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteSignal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteSignal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3429,13 +13291,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3445,27 +13307,14 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signaler", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3493,15 +13342,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signaler = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3511,29 +13358,29 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Options = append(m.Options, v1beta1.WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3561,49 +13408,10 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEpoch", wireType)
-			}
-			m.UnbondingEpoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstakeibc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingEpoch |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegable", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstakeibc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Delegable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
@@ -3625,7 +13433,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *CValueRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3648,10 +13456,10 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: CValueRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CValueRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3687,10 +13495,10 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var msglen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3700,30 +13508,16 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Epoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Epoch = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3733,16 +13527,16 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			m.State = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3752,14 +13546,28 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= Deposit_DepositState(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3787,61 +13595,47 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLiquidstakeibc
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedAmount", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidstakeibc
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.MintedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LSMDeposit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LSMDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3869,11 +13663,13 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := m.StakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOnPersistence", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3901,13 +13697,13 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AmountOnPersistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOnHostChain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3935,13 +13731,13 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AmountOnHostChain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizedStakedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3969,11 +13765,13 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.TokenizedStakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUnbondingAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4001,11 +13799,63 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.ValidatorUnbondingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4033,13 +13883,13 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.State = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4049,14 +13899,14 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= LSMDeposit_LSMDepositState(b&0x7F) << shift
+				m.Epoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4084,7 +13934,43 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4107,7 +13993,7 @@ func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Unbonding) Unmarshal(dAtA []byte) error {
+func (m *Inflow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4130,10 +14016,10 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Unbonding: wiretype end group for non-group")
+			return fmt.Errorf("proto: Inflow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Unbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Inflow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4170,9 +14056,9 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.EpochNumber = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4182,16 +14068,16 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				m.Epoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatureTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4201,30 +14087,29 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.MatureTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4234,61 +14119,79 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondAmount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstakeibc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedeemOutflow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnbondAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedeemOutflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedeemOutflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4316,13 +14219,13 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.State = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4332,11 +14235,45 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= Unbonding_UnbondingState(b&0x7F) << shift
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
@@ -4358,7 +14295,7 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
+func (m *WorkflowCursor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4381,10 +14318,10 @@ func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserUnbonding: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowCursor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowCursor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4420,27 +14357,8 @@ func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
-			}
-			m.EpochNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstakeibc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4468,46 +14386,13 @@ func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StkAmount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstakeibc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StkAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Workflow = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4517,23 +14402,24 @@ func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnbondAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Cursor = append(m.Cursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cursor == nil {
+				m.Cursor = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4557,7 +14443,7 @@ func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
+func (m *RedemptionRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4580,10 +14466,10 @@ func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorUnbonding: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedemptionRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedemptionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4619,60 +14505,8 @@ func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
-			}
-			m.EpochNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstakeibc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatureTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstakeibc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.MatureTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4700,13 +14534,13 @@ func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -4716,28 +14550,27 @@ func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4765,61 +14598,13 @@ func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KVUpdate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidstakeibc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KVUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KVUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4847,11 +14632,13 @@ func (m *KVUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StkAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4879,7 +14666,9 @@ func (m *KVUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if err := m.StkAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8,7 +8,9 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
 
 	"github.com/persistenceOne/pstake-native/v2/app"
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
@@ -163,6 +165,17 @@ func TestDeposit_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid sent state",
+			fields: fields{
+				ChainId:       "chain-1",
+				Amount:        validCoin,
+				Epoch:         0,
+				State:         types.Deposit_DEPOSIT_SENT,
+				IbcSequenceId: "channel-0-sequence-1",
+			},
+			wantErr: false,
+		},
 		{
 			name: "invalid amount",
 			fields: fields{
@@ -549,12 +562,110 @@ func TestValidatorUnbonding_Validate(t *testing.T) {
 	}
 }
 
+func TestLSMDeposit_Validate(t *testing.T) {
+	validDeposit := func() *types.LSMDeposit {
+		return &types.LSMDeposit{
+			ChainId:          "chain-1",
+			Amount:           sdk.NewInt(1000),
+			Shares:           sdk.NewDec(1000),
+			Denom:            "cosmosvaloper1hcqg5wj9t42zawqkqucs7la85ffyv08le09ljt/1",
+			IbcDenom:         "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9",
+			DelegatorAddress: authtypes.NewModuleAddressOrBech32Address("test").String(),
+			State:            types.LSMDeposit_DEPOSIT_PENDING,
+			IbcSequenceId:    "",
+		}
+	}
+	tests := []struct {
+		name    string
+		deposit func() *types.LSMDeposit
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			deposit: validDeposit,
+			wantErr: false,
+		},
+		{
+			name: "invalid delegator address",
+			deposit: func() *types.LSMDeposit {
+				deposit := validDeposit()
+				deposit.DelegatorAddress = "test"
+				return deposit
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid denom",
+			deposit: func() *types.LSMDeposit {
+				deposit := validDeposit()
+				deposit.Denom = ""
+				return deposit
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid ibc denom",
+			deposit: func() *types.LSMDeposit {
+				deposit := validDeposit()
+				deposit.IbcDenom = "1ibc"
+				return deposit
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative amount",
+			deposit: func() *types.LSMDeposit {
+				deposit := validDeposit()
+				deposit.Amount = sdk.NewInt(-1)
+				return deposit
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative shares",
+			deposit: func() *types.LSMDeposit {
+				deposit := validDeposit()
+				deposit.Shares = sdk.NewDec(-1)
+				return deposit
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid state",
+			deposit: func() *types.LSMDeposit {
+				deposit := validDeposit()
+				deposit.State = 10
+				return deposit
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.deposit().Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestHostChainLSParams_Validate(t *testing.T) {
 	type fields struct {
 		DepositFee    sdk.Dec
 		RestakeFee    sdk.Dec
 		UnstakeFee    sdk.Dec
 		RedemptionFee sdk.Dec
+
+		MaxTvl                 sdk.Int
+		MaxEpochInflow         sdk.Int
+		MaxAddressEpochInflow  sdk.Int
+		MaxEpochUnstake        sdk.Int
+		MaxEpochRedeemRatio    sdk.Dec
+		SlashThreshold         sdk.Dec
+		MaxValidatorCommission sdk.Dec
+		UpperCValueLimit       sdk.Dec
+		LowerCValueLimit       sdk.Dec
+		MaxCValueDelta         sdk.Dec
 	}
 	tests := []struct {
 		name    string
@@ -608,6 +719,152 @@ func TestHostChainLSParams_Validate(t *testing.T) {
 				RedemptionFee: sdk.MustNewDecFromStr("1.2"),
 			},
 			wantErr: true,
+		}, {
+			name: "valid caps",
+			fields: fields{
+				DepositFee:            sdk.ZeroDec(),
+				RestakeFee:            sdk.ZeroDec(),
+				UnstakeFee:            sdk.ZeroDec(),
+				RedemptionFee:         sdk.ZeroDec(),
+				MaxTvl:                sdk.NewInt(1000000),
+				MaxEpochInflow:        sdk.ZeroInt(),
+				MaxAddressEpochInflow: sdk.NewInt(1000),
+			},
+			wantErr: false,
+		}, {
+			name: "invalid max tvl",
+			fields: fields{
+				DepositFee:    sdk.ZeroDec(),
+				RestakeFee:    sdk.ZeroDec(),
+				UnstakeFee:    sdk.ZeroDec(),
+				RedemptionFee: sdk.ZeroDec(),
+				MaxTvl:        sdk.NewInt(-1),
+			},
+			wantErr: true,
+		}, {
+			name: "invalid max epoch inflow",
+			fields: fields{
+				DepositFee:     sdk.ZeroDec(),
+				RestakeFee:     sdk.ZeroDec(),
+				UnstakeFee:     sdk.ZeroDec(),
+				RedemptionFee:  sdk.ZeroDec(),
+				MaxEpochInflow: sdk.NewInt(-1),
+			},
+			wantErr: true,
+		}, {
+			name: "invalid max address epoch inflow",
+			fields: fields{
+				DepositFee:            sdk.ZeroDec(),
+				RestakeFee:            sdk.ZeroDec(),
+				UnstakeFee:            sdk.ZeroDec(),
+				RedemptionFee:         sdk.ZeroDec(),
+				MaxAddressEpochInflow: sdk.NewInt(-1),
+			},
+			wantErr: true,
+		}, {
+			name: "valid outflow caps",
+			fields: fields{
+				DepositFee:          sdk.ZeroDec(),
+				RestakeFee:          sdk.ZeroDec(),
+				UnstakeFee:          sdk.ZeroDec(),
+				RedemptionFee:       sdk.ZeroDec(),
+				MaxEpochUnstake:     sdk.NewInt(1000),
+				MaxEpochRedeemRatio: sdk.MustNewDecFromStr("0.5"),
+			},
+			wantErr: false,
+		}, {
+			name: "invalid max epoch unstake",
+			fields: fields{
+				DepositFee:      sdk.ZeroDec(),
+				RestakeFee:      sdk.ZeroDec(),
+				UnstakeFee:      sdk.ZeroDec(),
+				RedemptionFee:   sdk.ZeroDec(),
+				MaxEpochUnstake: sdk.NewInt(-1),
+			},
+			wantErr: true,
+		}, {
+			name: "invalid max epoch redeem ratio",
+			fields: fields{
+				DepositFee:          sdk.ZeroDec(),
+				RestakeFee:          sdk.ZeroDec(),
+				UnstakeFee:          sdk.ZeroDec(),
+				RedemptionFee:       sdk.ZeroDec(),
+				MaxEpochRedeemRatio: sdk.MustNewDecFromStr("1.5"),
+			},
+			wantErr: true,
+		}, {
+			name: "valid slash threshold",
+			fields: fields{
+				DepositFee:     sdk.ZeroDec(),
+				RestakeFee:     sdk.ZeroDec(),
+				UnstakeFee:     sdk.ZeroDec(),
+				RedemptionFee:  sdk.ZeroDec(),
+				SlashThreshold: sdk.MustNewDecFromStr("0.05"),
+			},
+			wantErr: false,
+		}, {
+			name: "invalid slash threshold",
+			fields: fields{
+				DepositFee:     sdk.ZeroDec(),
+				RestakeFee:     sdk.ZeroDec(),
+				UnstakeFee:     sdk.ZeroDec(),
+				RedemptionFee:  sdk.ZeroDec(),
+				SlashThreshold: sdk.MustNewDecFromStr("-0.05"),
+			},
+			wantErr: true,
+		}, {
+			name: "valid max validator commission",
+			fields: fields{
+				DepositFee:             sdk.ZeroDec(),
+				RestakeFee:             sdk.ZeroDec(),
+				UnstakeFee:             sdk.ZeroDec(),
+				RedemptionFee:          sdk.ZeroDec(),
+				MaxValidatorCommission: sdk.MustNewDecFromStr("0.2"),
+			},
+			wantErr: false,
+		}, {
+			name: "invalid max validator commission",
+			fields: fields{
+				DepositFee:             sdk.ZeroDec(),
+				RestakeFee:             sdk.ZeroDec(),
+				UnstakeFee:             sdk.ZeroDec(),
+				RedemptionFee:          sdk.ZeroDec(),
+				MaxValidatorCommission: sdk.MustNewDecFromStr("1.05"),
+			},
+			wantErr: true,
+		}, {
+			name: "valid c value limits",
+			fields: fields{
+				DepositFee:       sdk.ZeroDec(),
+				RestakeFee:       sdk.ZeroDec(),
+				UnstakeFee:       sdk.ZeroDec(),
+				RedemptionFee:    sdk.ZeroDec(),
+				UpperCValueLimit: sdk.MustNewDecFromStr("1.1"),
+				LowerCValueLimit: sdk.MustNewDecFromStr("0.9"),
+				MaxCValueDelta:   sdk.MustNewDecFromStr("0.05"),
+			},
+			wantErr: false,
+		}, {
+			name: "inverted c value limits",
+			fields: fields{
+				DepositFee:       sdk.ZeroDec(),
+				RestakeFee:       sdk.ZeroDec(),
+				UnstakeFee:       sdk.ZeroDec(),
+				RedemptionFee:    sdk.ZeroDec(),
+				UpperCValueLimit: sdk.MustNewDecFromStr("0.8"),
+				LowerCValueLimit: sdk.MustNewDecFromStr("0.9"),
+			},
+			wantErr: true,
+		}, {
+			name: "negative max c value delta",
+			fields: fields{
+				DepositFee:     sdk.ZeroDec(),
+				RestakeFee:     sdk.ZeroDec(),
+				UnstakeFee:     sdk.ZeroDec(),
+				RedemptionFee:  sdk.ZeroDec(),
+				MaxCValueDelta: sdk.MustNewDecFromStr("-0.05"),
+			},
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -617,6 +874,17 @@ func TestHostChainLSParams_Validate(t *testing.T) {
 				RestakeFee:    tt.fields.RestakeFee,
 				UnstakeFee:    tt.fields.UnstakeFee,
 				RedemptionFee: tt.fields.RedemptionFee,
+
+				MaxTvl:                 tt.fields.MaxTvl,
+				MaxEpochInflow:         tt.fields.MaxEpochInflow,
+				MaxAddressEpochInflow:  tt.fields.MaxAddressEpochInflow,
+				MaxEpochUnstake:        tt.fields.MaxEpochUnstake,
+				MaxEpochRedeemRatio:    tt.fields.MaxEpochRedeemRatio,
+				SlashThreshold:         tt.fields.SlashThreshold,
+				MaxValidatorCommission: tt.fields.MaxValidatorCommission,
+				UpperCValueLimit:       tt.fields.UpperCValueLimit,
+				LowerCValueLimit:       tt.fields.LowerCValueLimit,
+				MaxCValueDelta:         tt.fields.MaxCValueDelta,
 			}
 			if err := params.Validate(); (err != nil) != tt.wantErr {
 				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
@@ -713,3 +981,495 @@ func TestValidator_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestSlashRecord_Validate(t *testing.T) {
+	type fields struct {
+		ValidatorAddress   string
+		Height             int64
+		SlashedAmount      sdk.Int
+		ExchangeRateBefore sdk.Dec
+		ExchangeRateAfter  sdk.Dec
+	}
+	validatorAddress := authtypes.NewModuleAddressOrBech32Address("testval").String()
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			fields: fields{
+				ValidatorAddress:   validatorAddress,
+				Height:             10,
+				SlashedAmount:      sdk.NewInt(100),
+				ExchangeRateBefore: sdk.OneDec(),
+				ExchangeRateAfter:  sdk.MustNewDecFromStr("0.95"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid addr",
+			fields: fields{
+				ValidatorAddress:   "testval",
+				Height:             10,
+				SlashedAmount:      sdk.NewInt(100),
+				ExchangeRateBefore: sdk.OneDec(),
+				ExchangeRateAfter:  sdk.MustNewDecFromStr("0.95"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid height",
+			fields: fields{
+				ValidatorAddress:   validatorAddress,
+				Height:             -1,
+				SlashedAmount:      sdk.NewInt(100),
+				ExchangeRateBefore: sdk.OneDec(),
+				ExchangeRateAfter:  sdk.MustNewDecFromStr("0.95"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid slashed amount",
+			fields: fields{
+				ValidatorAddress:   validatorAddress,
+				Height:             10,
+				SlashedAmount:      sdk.ZeroInt(),
+				ExchangeRateBefore: sdk.OneDec(),
+				ExchangeRateAfter:  sdk.MustNewDecFromStr("0.95"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid exchange rate",
+			fields: fields{
+				ValidatorAddress:   validatorAddress,
+				Height:             10,
+				SlashedAmount:      sdk.NewInt(100),
+				ExchangeRateBefore: sdk.OneDec(),
+				ExchangeRateAfter:  sdk.MustNewDecFromStr("-0.95"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sr := &types.SlashRecord{
+				ChainId:            "chain-1",
+				ValidatorAddress:   tt.fields.ValidatorAddress,
+				Height:             tt.fields.Height,
+				SlashedAmount:      tt.fields.SlashedAmount,
+				ExchangeRateBefore: tt.fields.ExchangeRateBefore,
+				ExchangeRateAfter:  tt.fields.ExchangeRateAfter,
+			}
+			if err := sr.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestInflow_Validate(t *testing.T) {
+	address := authtypes.NewModuleAddressOrBech32Address("delegator").String()
+	tests := []struct {
+		name    string
+		inflow  types.Inflow
+		wantErr bool
+	}{
+		{
+			name:    "valid chain inflow",
+			inflow:  types.Inflow{ChainId: "chain-1", Epoch: 1, Amount: sdk.NewInt(100)},
+			wantErr: false,
+		},
+		{
+			name:    "valid address inflow",
+			inflow:  types.Inflow{ChainId: "chain-1", Epoch: 1, Address: address, Amount: sdk.NewInt(100)},
+			wantErr: false,
+		},
+		{
+			name:    "invalid epoch",
+			inflow:  types.Inflow{ChainId: "chain-1", Epoch: -1, Amount: sdk.NewInt(100)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid address",
+			inflow:  types.Inflow{ChainId: "chain-1", Epoch: 1, Address: "delegator", Amount: sdk.NewInt(100)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid amount",
+			inflow:  types.Inflow{ChainId: "chain-1", Epoch: 1, Amount: sdk.NewInt(-1)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.inflow.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseInflowStoreKey(t *testing.T) {
+	chainID, epoch, address, err := types.ParseInflowStoreKey(types.GetInflowStoreKey("chain-1", 7, "persistence1"))
+	require.NoError(t, err)
+	require.Equal(t, "chain-1", chainID)
+	require.Equal(t, int64(7), epoch)
+	require.Equal(t, "persistence1", address)
+
+	_, _, address, err = types.ParseInflowStoreKey(types.GetInflowEpochPrefix("chain-1", 7))
+	require.NoError(t, err)
+	require.Empty(t, address)
+
+	_, _, _, err = types.ParseInflowStoreKey([]byte{0x07, 'c'})
+	require.Error(t, err)
+}
+
+func TestRedeemOutflow_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		outflow types.RedeemOutflow
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			outflow: types.RedeemOutflow{ChainId: "chain-1", Epoch: 1, Amount: sdk.NewInt(100)},
+			wantErr: false,
+		},
+		{
+			name:    "invalid epoch",
+			outflow: types.RedeemOutflow{ChainId: "chain-1", Epoch: -1, Amount: sdk.NewInt(100)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid amount",
+			outflow: types.RedeemOutflow{ChainId: "chain-1", Epoch: 1, Amount: sdk.NewInt(-1)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.outflow.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTimelockedUpdate_Validate(t *testing.T) {
+	authority := authtypes.NewModuleAddress(types.ModuleName).String()
+	pinned := func(chainID string, weight sdk.Dec) string {
+		return types.MustMarshalPinnedValidatorSetProposal(&types.ValidatorSetProposal{
+			ChainId: chainID,
+			Height:  10,
+			Validators: []*types.ValidatorScore{
+				{OperatorAddress: sdk.ValAddress("validatorA").String(), Score: sdk.OneDec(), Weight: weight},
+			},
+		})
+	}
+
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{
+			name:    "valid pinned proposal",
+			value:   pinned("chain-1", sdk.OneDec()),
+			wantErr: false,
+		},
+		{
+			name:    "proposal of another chain",
+			value:   pinned("chain-2", sdk.OneDec()),
+			wantErr: true,
+		},
+		{
+			name:    "invalid proposal weights",
+			value:   pinned("chain-1", sdk.MustNewDecFromStr("0.5")),
+			wantErr: true,
+		},
+		{
+			name:    "invalid proposal",
+			value:   "invalid",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			update := types.TimelockedUpdate{
+				Id:        1,
+				ChainId:   "chain-1",
+				Authority: authority,
+				Updates:   []*types.KVUpdate{{Key: types.KeyApplyValidatorSet, Value: tt.value}},
+			}
+			if err := update.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// a proposal can only be pinned by queueing the apply, not submitted with the host chain update
+	msg := types.NewMsgUpdateHostChain(
+		"chain-1",
+		authority,
+		[]*types.KVUpdate{{Key: types.KeyApplyValidatorSet, Value: pinned("chain-1", sdk.OneDec())}},
+	)
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestParseRedeemOutflowStoreKey(t *testing.T) {
+	chainID, epoch, err := types.ParseRedeemOutflowStoreKey(types.GetRedeemOutflowStoreKey("chain-1", 7))
+	require.NoError(t, err)
+	require.Equal(t, "chain-1", chainID)
+	require.Equal(t, int64(7), epoch)
+
+	_, _, err = types.ParseRedeemOutflowStoreKey([]byte{0x07, 'c'})
+	require.Error(t, err)
+}
+
+func TestValidatorSetConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  types.ValidatorSetConfig
+		wantErr bool
+	}{
+		{
+			name:    "disabled",
+			config:  types.ValidatorSetConfig{},
+			wantErr: false,
+		},
+		{
+			name: "valid",
+			config: types.ValidatorSetConfig{
+				Enabled:       true,
+				MaxValidators: 10,
+				MinWeight:     sdk.MustNewDecFromStr("0.05"),
+				MaxWeight:     sdk.MustNewDecFromStr("0.2"),
+				MaxCommission: sdk.MustNewDecFromStr("0.1"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "no validators",
+			config: types.ValidatorSetConfig{
+				Enabled: true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "min weight above max weight",
+			config: types.ValidatorSetConfig{
+				Enabled:       true,
+				MaxValidators: 10,
+				MinWeight:     sdk.MustNewDecFromStr("0.1"),
+				MaxWeight:     sdk.MustNewDecFromStr("0.05"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "min weight too high",
+			config: types.ValidatorSetConfig{
+				Enabled:       true,
+				MaxValidators: 10,
+				MinWeight:     sdk.MustNewDecFromStr("0.2"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "max weight too low",
+			config: types.ValidatorSetConfig{
+				Enabled:       true,
+				MaxValidators: 10,
+				MaxWeight:     sdk.MustNewDecFromStr("0.05"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid max commission",
+			config: types.ValidatorSetConfig{
+				Enabled:       true,
+				MaxValidators: 10,
+				MaxCommission: sdk.MustNewDecFromStr("1.1"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatorSetCandidate_Validate(t *testing.T) {
+	verified := func() types.ValidatorSetCandidate {
+		return types.ValidatorSetCandidate{
+			ChainId:             "chain-1",
+			OperatorAddress:     sdk.ValAddress("validator").String(),
+			ConsensusAddress:    sdk.ConsAddress("validator").String(),
+			Tokens:              sdk.NewInt(100),
+			Commission:          sdk.ZeroDec(),
+			DelegatorShares:     sdk.NewDec(100),
+			LiquidShares:        sdk.ZeroDec(),
+			ValidatorBondShares: sdk.ZeroDec(),
+			ValidatorVerified:   true,
+			SigningInfoVerified: true,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		candidate func() types.ValidatorSetCandidate
+		wantErr   bool
+	}{
+		{
+			name:      "valid",
+			candidate: verified,
+			wantErr:   false,
+		},
+		{
+			name: "invalid operator address",
+			candidate: func() types.ValidatorSetCandidate {
+				c := verified()
+				c.OperatorAddress = "invalid"
+				return c
+			},
+			wantErr: true,
+		},
+		{
+			name: "verified without consensus address",
+			candidate: func() types.ValidatorSetCandidate {
+				c := verified()
+				c.ConsensusAddress = ""
+				return c
+			},
+			wantErr: true,
+		},
+		{
+			name: "signing info verified before the validator",
+			candidate: func() types.ValidatorSetCandidate {
+				c := verified()
+				c.ValidatorVerified = false
+				return c
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative tokens",
+			candidate: func() types.ValidatorSetCandidate {
+				c := verified()
+				c.Tokens = sdk.NewInt(-1)
+				return c
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative missed blocks",
+			candidate: func() types.ValidatorSetCandidate {
+				c := verified()
+				c.MissedBlocksCounter = -1
+				return c
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidate := tt.candidate()
+			if err := candidate.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatorSetRound_Validate(t *testing.T) {
+	require.NoError(t, (&types.ValidatorSetRound{ChainId: "chain-1", Epoch: 1, Candidates: 2, Verified: 1}).Validate())
+	require.Error(t, (&types.ValidatorSetRound{ChainId: "chain-1", Epoch: -1}).Validate())
+	require.Error(t, (&types.ValidatorSetRound{ChainId: "chain-1", SignedBlocksWindow: -1}).Validate())
+	require.Error(t, (&types.ValidatorSetRound{ChainId: "chain-1", Candidates: 1, Verified: 2}).Validate())
+}
+
+func TestVoteSignalingCurrentTally(t *testing.T) {
+	options := [][]govv1beta1.WeightedVoteOption{
+		govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes),
+		{
+			{Option: govv1beta1.OptionYes, Weight: sdk.MustNewDecFromStr("0.5")},
+			{Option: govv1beta1.OptionNo, Weight: sdk.MustNewDecFromStr("0.5")},
+		},
+		govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionNoWithVeto),
+	}
+
+	tests := []struct {
+		name       string
+		powers     []math.Int
+		removed    []bool
+		want       []govv1beta1.WeightedVoteOption
+		totalPower math.Int
+	}{
+		{
+			name:    "weighted by power, dust to the heaviest option",
+			powers:  []math.Int{sdk.NewInt(2), sdk.NewInt(1), sdk.ZeroInt()},
+			removed: []bool{false, false, false},
+			want: []govv1beta1.WeightedVoteOption{
+				{Option: govv1beta1.OptionYes, Weight: sdk.MustNewDecFromStr("0.833333333333333334")},
+				{Option: govv1beta1.OptionNo, Weight: sdk.MustNewDecFromStr("0.166666666666666666")},
+			},
+			totalPower: sdk.NewInt(3),
+		},
+		{
+			name:    "single signaler",
+			powers:  []math.Int{sdk.ZeroInt(), sdk.ZeroInt(), sdk.NewInt(5)},
+			removed: []bool{false, false, false},
+			want: []govv1beta1.WeightedVoteOption{
+				{Option: govv1beta1.OptionNoWithVeto, Weight: sdk.OneDec()},
+			},
+			totalPower: sdk.NewInt(5),
+		},
+		{
+			name:    "replaced signals are taken out",
+			powers:  []math.Int{sdk.NewInt(2), sdk.NewInt(4), sdk.NewInt(1)},
+			removed: []bool{false, true, false},
+			want: []govv1beta1.WeightedVoteOption{
+				{Option: govv1beta1.OptionYes, Weight: sdk.MustNewDecFromStr("0.666666666666666667")},
+				{Option: govv1beta1.OptionNoWithVeto, Weight: sdk.MustNewDecFromStr("0.333333333333333333")},
+			},
+			totalPower: sdk.NewInt(3),
+		},
+		{
+			name:       "no power",
+			powers:     []math.Int{sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()},
+			removed:    []bool{false, false, false},
+			want:       []govv1beta1.WeightedVoteOption{},
+			totalPower: sdk.ZeroInt(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signaling := &types.VoteSignaling{}
+			for i, power := range tt.powers {
+				signal := &types.VoteSignal{Options: options[i], Power: power}
+				signaling.AddSignal(signal)
+				if tt.removed[i] {
+					signaling.RemoveSignal(signal)
+				}
+			}
+
+			if !signaling.TotalPower.Equal(tt.totalPower) {
+				t.Errorf("CurrentTally() total power = %v, want %v", signaling.TotalPower, tt.totalPower)
+			}
+			got := signaling.CurrentTally()
+			if govv1beta1.WeightedVoteOptions(got).String() != govv1beta1.WeightedVoteOptions(tt.want).String() {
+				t.Errorf("CurrentTally() = %v, want %v", got, tt.want)
+			}
+			if len(got) > 0 {
+				if err := types.ValidateVoteOptions(got); err != nil {
+					t.Errorf("CurrentTally() invalid options: %v", err)
+				}
+			}
+		})
+	}
+}
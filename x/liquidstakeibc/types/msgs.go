@@ -11,19 +11,26 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 )
 
 const (
-	MsgTypeRegisterHostChain string = "msg_register_host_chain"
-	MsgTypeUpdateHostChain   string = "msg_update_host_chain"
-	MsgTypeLiquidStake       string = "msg_liquid_stake"
-	MsgTypeLiquidStakeLSM    string = "msg_liquid_stake_lsm"
-	MsgTypeLiquidUnstake     string = "msg_liquid_unstake"
-	MsgTypeRedeem            string = "msg_redeem"
-	MsgTypeUpdateParams      string = "msg_update_params"
+	MsgTypeRegisterHostChain       string = "msg_register_host_chain"
+	MsgTypeUpdateHostChain         string = "msg_update_host_chain"
+	MsgTypeLiquidStake             string = "msg_liquid_stake"
+	MsgTypeLiquidStakeLSM          string = "msg_liquid_stake_lsm"
+	MsgTypeLiquidUnstake           string = "msg_liquid_unstake"
+	MsgTypeRedeem                  string = "msg_redeem"
+	MsgTypeUpdateParams            string = "msg_update_params"
+	MsgTypeVoteOnHostChainProposal string = "msg_vote_on_host_chain_proposal"
+	MsgTypeSignalHostChainVote     string = "msg_signal_host_chain_vote"
+	MsgTypeDeregisterHostChain     string = "msg_deregister_host_chain"
+	MsgTypeCancelTimelockedUpdate  string = "msg_cancel_timelocked_update"
+	MsgTypeClaim                   string = "msg_claim"
+	MsgTypeSetAutoClaim            string = "msg_set_auto_claim"
 )
 
 var (
@@ -32,6 +39,12 @@ var (
 	_ sdk.Msg = &MsgLiquidStake{}
 	_ sdk.Msg = &MsgLiquidUnstake{}
 	_ sdk.Msg = &MsgRedeem{}
+	_ sdk.Msg = &MsgVoteOnHostChainProposal{}
+	_ sdk.Msg = &MsgSignalHostChainVote{}
+	_ sdk.Msg = &MsgDeregisterHostChain{}
+	_ sdk.Msg = &MsgCancelTimelockedUpdate{}
+	_ sdk.Msg = &MsgClaim{}
+	_ sdk.Msg = &MsgSetAutoClaim{}
 )
 
 func NewMsgRegisterHostChain(
@@ -206,6 +219,10 @@ func (m *MsgUpdateHostChain) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", m.Authority, err)
 	}
+	// an update without keys would pass the role checks of any signer
+	if len(m.Updates) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "updates cannot be empty")
+	}
 	for _, update := range m.Updates {
 		switch update.Key {
 		case KeyAddValidator:
@@ -334,6 +351,111 @@ func (m *MsgUpdateHostChain) ValidateBasic() error {
 			if err != nil {
 				return fmt.Errorf("unable to unmarshal flags update string")
 			}
+		case KeyPauses:
+			if _, err := ParsePausesUpdate(update.Value); err != nil {
+				return err
+			}
+		case KeyRebalance:
+			if update.Value != "" {
+				return fmt.Errorf("expected value for key:Rebalance is empty")
+			}
+		case KeyValidatorSetConfig:
+			var config ValidatorSetConfig
+			if err := json.Unmarshal([]byte(update.Value), &config); err != nil {
+				return fmt.Errorf("unable to unmarshal validator set config update string")
+			}
+
+			if err := config.Validate(); err != nil {
+				return err
+			}
+		case KeyApplyValidatorSet:
+			if update.Value != "" {
+				return fmt.Errorf("expected value for key:ApplyValidatorSet is empty")
+			}
+		case KeyVoteSignaling:
+			var signaling VoteSignaling
+			if err := json.Unmarshal([]byte(update.Value), &signaling); err != nil {
+				return fmt.Errorf("unable to unmarshal vote signaling update string")
+			}
+
+			if signaling.ProposalId == 0 {
+				return fmt.Errorf("vote signaling proposal id cannot be zero")
+			}
+
+			if signaling.EndTime.IsZero() {
+				return fmt.Errorf("vote signaling end time cannot be empty")
+			}
+		case KeyMaxRedelegationEntries:
+			maxEntries, err := strconv.ParseUint(update.Value, 10, 32)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to uint32")
+			}
+
+			if maxEntries == 0 {
+				return fmt.Errorf("invalid max redelegation entries value equal to zero")
+			}
+		case KeyMaxTVL, KeyMaxEpochInflow, KeyMaxAddressEpochInflow, KeyMaxEpochUnstake:
+			limit, ok := sdk.NewIntFromString(update.Value)
+			if !ok {
+				return fmt.Errorf("unable to parse string to sdk.Int")
+			}
+
+			if limit.IsNegative() {
+				return fmt.Errorf("invalid %s value less than zero", update.Key)
+			}
+		case KeyMaxEpochRedeemRatio:
+			ratio, err := sdk.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec")
+			}
+
+			if ratio.IsNegative() || ratio.GT(sdk.OneDec()) {
+				return fmt.Errorf("invalid max epoch redeem ratio value should be 0<=ratio<=1")
+			}
+		case KeySlashThreshold:
+			threshold, err := sdk.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec")
+			}
+
+			if threshold.IsNegative() || threshold.GT(sdk.OneDec()) {
+				return fmt.Errorf("invalid slash threshold value should be 0<=threshold<=1")
+			}
+		case KeyMaxValidatorCommission:
+			commission, err := sdk.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec")
+			}
+
+			if commission.IsNegative() || commission.GT(sdk.OneDec()) {
+				return fmt.Errorf("invalid max validator commission value should be 0<=commission<=1")
+			}
+		case KeyUpperCValueLimit, KeyMaxCValueDelta:
+			value, err := sdk.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec")
+			}
+
+			if value.IsNegative() {
+				return fmt.Errorf("invalid %s value less than zero", update.Key)
+			}
+		case KeyLowerCValueLimit:
+			limit, err := sdk.NewDecFromStr(update.Value)
+			if err != nil {
+				return fmt.Errorf("unable to parse string to sdk.Dec")
+			}
+
+			if limit.IsNegative() || limit.GT(sdk.OneDec()) {
+				return fmt.Errorf("invalid lower c value limit value should be 0<=limit<=1")
+			}
+		case KeyCValueRecoveryCount:
+			if _, err := strconv.ParseUint(update.Value, 10, 32); err != nil {
+				return fmt.Errorf("unable to parse string to uint32")
+			}
+		case KeyDelegationStrategy:
+			if _, ok := HostChain_DelegationStrategy_value[update.Value]; !ok {
+				return fmt.Errorf("invalid delegation strategy: %s", update.Value)
+			}
 		default:
 			return fmt.Errorf("invalid or unexpected update key: %s", update.Key)
 		}
@@ -588,3 +710,296 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 	}
 	return nil
 }
+
+//nolint:interfacer
+func NewMsgVoteOnHostChainProposal(
+	authority sdk.AccAddress,
+	chainID string,
+	proposalID uint64,
+	options []govv1beta1.WeightedVoteOption,
+) *MsgVoteOnHostChainProposal {
+	return &MsgVoteOnHostChainProposal{
+		Authority:  authority.String(),
+		ChainId:    chainID,
+		ProposalId: proposalID,
+		Options:    options,
+	}
+}
+
+func (m *MsgVoteOnHostChainProposal) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgVoteOnHostChainProposal) Type() string {
+	return MsgTypeVoteOnHostChainProposal
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgVoteOnHostChainProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgVoteOnHostChainProposal) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgVoteOnHostChainProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", m.Authority, err)
+	}
+
+	if m.ChainId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "chain id cannot be empty")
+	}
+
+	if m.ProposalId == 0 {
+		return errorsmod.Wrap(ErrInvalidVote, "proposal id cannot be zero")
+	}
+
+	return ValidateVoteOptions(m.Options)
+}
+
+//nolint:interfacer
+func NewMsgSignalHostChainVote(
+	signaler sdk.AccAddress,
+	chainID string,
+	proposalID uint64,
+	options []govv1beta1.WeightedVoteOption,
+) *MsgSignalHostChainVote {
+	return &MsgSignalHostChainVote{
+		Signaler:   signaler.String(),
+		ChainId:    chainID,
+		ProposalId: proposalID,
+		Options:    options,
+	}
+}
+
+// Route should return the name of the module
+func (m *MsgSignalHostChainVote) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgSignalHostChainVote) Type() string {
+	return MsgTypeSignalHostChainVote
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgSignalHostChainVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgSignalHostChainVote) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signaler)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgSignalHostChainVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signaler); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signaler address %q: %v", m.Signaler, err)
+	}
+
+	if m.ChainId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "chain id cannot be empty")
+	}
+
+	if m.ProposalId == 0 {
+		return errorsmod.Wrap(ErrInvalidVote, "proposal id cannot be zero")
+	}
+
+	return ValidateVoteOptions(m.Options)
+}
+
+//nolint:interfacer
+func NewMsgDeregisterHostChain(authority sdk.AccAddress, chainID string) *MsgDeregisterHostChain {
+	return &MsgDeregisterHostChain{
+		Authority: authority.String(),
+		ChainId:   chainID,
+	}
+}
+
+// Route should return the name of the module
+func (m *MsgDeregisterHostChain) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgDeregisterHostChain) Type() string {
+	return MsgTypeDeregisterHostChain
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgDeregisterHostChain) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgDeregisterHostChain) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgDeregisterHostChain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", m.Authority, err)
+	}
+
+	if m.ChainId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "chain id cannot be empty")
+	}
+
+	return nil
+}
+
+//nolint:interfacer
+func NewMsgCancelTimelockedUpdate(authority sdk.AccAddress, chainID string, id uint64) *MsgCancelTimelockedUpdate {
+	return &MsgCancelTimelockedUpdate{
+		Authority: authority.String(),
+		ChainId:   chainID,
+		Id:        id,
+	}
+}
+
+// Route should return the name of the module
+func (m *MsgCancelTimelockedUpdate) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgCancelTimelockedUpdate) Type() string {
+	return MsgTypeCancelTimelockedUpdate
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgCancelTimelockedUpdate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgCancelTimelockedUpdate) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgCancelTimelockedUpdate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address %q: %v", m.Authority, err)
+	}
+
+	if m.ChainId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "chain id cannot be empty")
+	}
+
+	if m.Id == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "timelocked update id cannot be zero")
+	}
+
+	return nil
+}
+
+//nolint:interfacer
+func NewMsgClaim(delegatorAddress sdk.AccAddress, chainID string, recipient string) *MsgClaim {
+	return &MsgClaim{
+		DelegatorAddress: delegatorAddress.String(),
+		ChainId:          chainID,
+		Recipient:        recipient,
+	}
+}
+
+// Route should return the name of the module
+func (m *MsgClaim) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgClaim) Type() string {
+	return MsgTypeClaim
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgClaim) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DelegatorAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address %q: %v", m.DelegatorAddress, err)
+	}
+
+	if m.ChainId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "chain id cannot be empty")
+	}
+
+	if m.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address %q: %v", m.Recipient, err)
+		}
+	}
+
+	return nil
+}
+
+//nolint:interfacer
+func NewMsgSetAutoClaim(delegatorAddress sdk.AccAddress, autoClaim bool) *MsgSetAutoClaim {
+	return &MsgSetAutoClaim{
+		DelegatorAddress: delegatorAddress.String(),
+		AutoClaim:        autoClaim,
+	}
+}
+
+// Route should return the name of the module
+func (m *MsgSetAutoClaim) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgSetAutoClaim) Type() string {
+	return MsgTypeSetAutoClaim
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgSetAutoClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgSetAutoClaim) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgSetAutoClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DelegatorAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address %q: %v", m.DelegatorAddress, err)
+	}
+
+	return nil
+}
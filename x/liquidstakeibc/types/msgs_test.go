@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/stretchr/testify/require"
 
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
@@ -255,6 +256,60 @@ func TestMsgUpdateHostChain(t *testing.T) {
 		}, {
 			Key:   types.KeyAutocompoundFactor,
 			Value: "2",
+		}, {
+			Key:   types.KeyDelegationStrategy,
+			Value: types.HostChain_DELEGATION_STRATEGY_STAKE_INVERSE.String(),
+		}, {
+			Key:   types.KeyRebalance,
+			Value: "",
+		}, {
+			Key:   types.KeyMaxRedelegationEntries,
+			Value: "7",
+		}, {
+			Key:   types.KeyMaxTVL,
+			Value: "1000000000",
+		}, {
+			Key:   types.KeyMaxEpochInflow,
+			Value: "0",
+		}, {
+			Key:   types.KeyMaxAddressEpochInflow,
+			Value: "1000000",
+		}, {
+			Key:   types.KeyMaxEpochUnstake,
+			Value: "1000000000",
+		}, {
+			Key:   types.KeyMaxEpochRedeemRatio,
+			Value: "0.1",
+		}, {
+			Key:   types.KeySlashThreshold,
+			Value: "0.05",
+		}, {
+			Key:   types.KeyMaxValidatorCommission,
+			Value: "0.2",
+		}, {
+			Key:   types.KeyUpperCValueLimit,
+			Value: "1.1",
+		}, {
+			Key:   types.KeyLowerCValueLimit,
+			Value: "0.9",
+		}, {
+			Key:   types.KeyMaxCValueDelta,
+			Value: "0.05",
+		}, {
+			Key:   types.KeyCValueRecoveryCount,
+			Value: "3",
+		}, {
+			Key:   types.KeyValidatorSetConfig,
+			Value: `{"enabled":true,"auto_apply":false,"max_validators":10,"min_weight":"0.05","max_weight":"0.2"}`,
+		}, {
+			Key:   types.KeyApplyValidatorSet,
+			Value: "",
+		}, {
+			Key:   types.KeyVoteSignaling,
+			Value: `{"proposal_id":10,"end_time":"2023-06-01T00:00:00Z"}`,
+		}, {
+			Key:   types.KeyPauses,
+			Value: `{"liquid_stake":true,"ica_sends":true}`,
 		},
 	}
 	msgUpdateHostChain := &types.MsgUpdateHostChain{
@@ -355,6 +410,87 @@ func TestMsgUpdateHostChain(t *testing.T) {
 		}, {
 			Key:   types.KeyAutocompoundFactor,
 			Value: "InvalidDec",
+		}, {
+			Key:   types.KeyDelegationStrategy,
+			Value: "InvalidStrategy",
+		}, {
+			Key:   types.KeyRebalance,
+			Value: "SomeStrHere",
+		}, {
+			Key:   types.KeyMaxRedelegationEntries,
+			Value: "0",
+		}, {
+			Key:   types.KeyMaxRedelegationEntries,
+			Value: "-1",
+		}, {
+			Key:   types.KeyMaxTVL,
+			Value: "-1",
+		}, {
+			Key:   types.KeyMaxEpochInflow,
+			Value: "1.5",
+		}, {
+			Key:   types.KeyMaxAddressEpochInflow,
+			Value: "",
+		}, {
+			Key:   types.KeyMaxEpochUnstake,
+			Value: "-1",
+		}, {
+			Key:   types.KeyMaxEpochRedeemRatio,
+			Value: "1.1",
+		}, {
+			Key:   types.KeyMaxEpochRedeemRatio,
+			Value: "-0.1",
+		}, {
+			Key:   types.KeySlashThreshold,
+			Value: "1.1",
+		}, {
+			Key:   types.KeySlashThreshold,
+			Value: "-0.1",
+		}, {
+			Key:   types.KeyMaxValidatorCommission,
+			Value: "1.1",
+		}, {
+			Key:   types.KeyMaxValidatorCommission,
+			Value: "-0.1",
+		}, {
+			Key:   types.KeyUpperCValueLimit,
+			Value: "-1",
+		}, {
+			Key:   types.KeyLowerCValueLimit,
+			Value: "1.1",
+		}, {
+			Key:   types.KeyMaxCValueDelta,
+			Value: "invalid",
+		}, {
+			Key:   types.KeyCValueRecoveryCount,
+			Value: "-1",
+		}, {
+			Key:   types.KeyValidatorSetConfig,
+			Value: "invalid",
+		}, {
+			Key:   types.KeyValidatorSetConfig,
+			Value: `{"enabled":true,"max_validators":0}`,
+		}, {
+			Key:   types.KeyApplyValidatorSet,
+			Value: "value",
+		}, {
+			Key:   types.KeyVoteSignaling,
+			Value: "invalid",
+		}, {
+			Key:   types.KeyVoteSignaling,
+			Value: `{"proposal_id":0,"end_time":"2023-06-01T00:00:00Z"}`,
+		}, {
+			Key:   types.KeyVoteSignaling,
+			Value: `{"proposal_id":10}`,
+		}, {
+			Key:   types.KeyPauses,
+			Value: "invalid",
+		}, {
+			Key:   types.KeyPauses,
+			Value: `{}`,
+		}, {
+			Key:   types.KeyPauses,
+			Value: `{"liquid_stake":true,"deposit":true}`,
 		}, {
 			Key:   "InvalidKey",
 			Value: "InvalidKey",
@@ -364,6 +500,8 @@ func TestMsgUpdateHostChain(t *testing.T) {
 		invalidMsg := types.NewMsgUpdateHostChain("chain-1", addr1.String(), []*types.KVUpdate{update})
 		require.Error(t, invalidMsg.ValidateBasic())
 	}
+
+	require.Error(t, types.NewMsgUpdateHostChain("chain-1", addr1.String(), nil).ValidateBasic())
 }
 
 func TestMsgUpdateParams(t *testing.T) {
@@ -394,3 +532,155 @@ func TestMsgUpdateParams(t *testing.T) {
 	require.Error(t, invalidParamsMsg.ValidateBasic())
 
 }
+
+func TestMsgVoteOnHostChainProposal(t *testing.T) {
+	options := govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes)
+	msg := &types.MsgVoteOnHostChainProposal{
+		Authority:  addr1.String(),
+		ChainId:    "chain-1",
+		ProposalId: 1,
+		Options:    options,
+	}
+	newMsg := types.NewMsgVoteOnHostChainProposal(addr1, "chain-1", 1, options)
+	require.Equal(t, msg, newMsg)
+	require.Equal(t, types.ModuleName, msg.Route())
+	require.Equal(t, types.MsgTypeVoteOnHostChainProposal, msg.Type())
+	require.Equal(t, addr1, msg.GetSigners()[0])
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	require.NoError(t, msg.ValidateBasic())
+
+	weightedMsg := types.NewMsgVoteOnHostChainProposal(addr1, "chain-1", 1, govv1beta1.WeightedVoteOptions{
+		{Option: govv1beta1.OptionYes, Weight: sdk.MustNewDecFromStr("0.6")},
+		{Option: govv1beta1.OptionNo, Weight: sdk.MustNewDecFromStr("0.4")},
+	})
+	require.NoError(t, weightedMsg.ValidateBasic())
+
+	invalidMsgs := []*types.MsgVoteOnHostChainProposal{
+		types.NewMsgVoteOnHostChainProposal(sdk.AccAddress{}, "chain-1", 1, options),
+		types.NewMsgVoteOnHostChainProposal(addr1, "", 1, options),
+		types.NewMsgVoteOnHostChainProposal(addr1, "chain-1", 0, options),
+		types.NewMsgVoteOnHostChainProposal(addr1, "chain-1", 1, nil),
+		types.NewMsgVoteOnHostChainProposal(addr1, "chain-1", 1, govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionEmpty)),
+		types.NewMsgVoteOnHostChainProposal(addr1, "chain-1", 1, govv1beta1.WeightedVoteOptions{
+			{Option: govv1beta1.OptionYes, Weight: sdk.MustNewDecFromStr("0.6")},
+			{Option: govv1beta1.OptionNo, Weight: sdk.MustNewDecFromStr("0.3")},
+		}),
+		types.NewMsgVoteOnHostChainProposal(addr1, "chain-1", 1, govv1beta1.WeightedVoteOptions{
+			{Option: govv1beta1.OptionYes, Weight: sdk.MustNewDecFromStr("0.5")},
+			{Option: govv1beta1.OptionYes, Weight: sdk.MustNewDecFromStr("0.5")},
+		}),
+	}
+	for _, invalidMsg := range invalidMsgs {
+		require.Error(t, invalidMsg.ValidateBasic())
+	}
+}
+
+func TestMsgSignalHostChainVote(t *testing.T) {
+	options := govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes)
+	msg := &types.MsgSignalHostChainVote{
+		Signaler:   addr1.String(),
+		ChainId:    "chain-1",
+		ProposalId: 1,
+		Options:    options,
+	}
+	newMsg := types.NewMsgSignalHostChainVote(addr1, "chain-1", 1, options)
+	require.Equal(t, msg, newMsg)
+	require.Equal(t, types.ModuleName, msg.Route())
+	require.Equal(t, types.MsgTypeSignalHostChainVote, msg.Type())
+	require.Equal(t, addr1, msg.GetSigners()[0])
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	require.NoError(t, msg.ValidateBasic())
+
+	invalidMsgs := []*types.MsgSignalHostChainVote{
+		types.NewMsgSignalHostChainVote(sdk.AccAddress{}, "chain-1", 1, options),
+		types.NewMsgSignalHostChainVote(addr1, "", 1, options),
+		types.NewMsgSignalHostChainVote(addr1, "chain-1", 0, options),
+		types.NewMsgSignalHostChainVote(addr1, "chain-1", 1, nil),
+		types.NewMsgSignalHostChainVote(addr1, "chain-1", 1, govv1beta1.WeightedVoteOptions{
+			{Option: govv1beta1.OptionYes, Weight: sdk.MustNewDecFromStr("0.6")},
+		}),
+	}
+	for _, invalidMsg := range invalidMsgs {
+		require.Error(t, invalidMsg.ValidateBasic())
+	}
+}
+
+func TestMsgClaim(t *testing.T) {
+	msg := &types.MsgClaim{
+		DelegatorAddress: addr1.String(),
+		ChainId:          "chain-1",
+		Recipient:        "",
+	}
+	newMsg := types.NewMsgClaim(addr1, "chain-1", "")
+	require.Equal(t, msg, newMsg)
+	require.Equal(t, types.ModuleName, msg.Route())
+	require.Equal(t, types.MsgTypeClaim, msg.Type())
+	require.Equal(t, addr1, msg.GetSigners()[0])
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	require.NoError(t, msg.ValidateBasic())
+	require.NoError(t, types.NewMsgClaim(addr1, "chain-1", addr1.String()).ValidateBasic())
+
+	invalidMsgs := []*types.MsgClaim{
+		types.NewMsgClaim(sdk.AccAddress{}, "chain-1", ""),
+		types.NewMsgClaim(addr1, "", ""),
+		types.NewMsgClaim(addr1, "chain-1", "invalid"),
+	}
+	for _, invalidMsg := range invalidMsgs {
+		require.Error(t, invalidMsg.ValidateBasic())
+	}
+}
+
+func TestMsgSetAutoClaim(t *testing.T) {
+	msg := &types.MsgSetAutoClaim{
+		DelegatorAddress: addr1.String(),
+		AutoClaim:        false,
+	}
+	newMsg := types.NewMsgSetAutoClaim(addr1, false)
+	require.Equal(t, msg, newMsg)
+	require.Equal(t, types.ModuleName, msg.Route())
+	require.Equal(t, types.MsgTypeSetAutoClaim, msg.Type())
+	require.Equal(t, addr1, msg.GetSigners()[0])
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	require.NoError(t, msg.ValidateBasic())
+	require.Error(t, types.NewMsgSetAutoClaim(sdk.AccAddress{}, true).ValidateBasic())
+}
+
+func TestMsgDeregisterHostChain(t *testing.T) {
+	msg := &types.MsgDeregisterHostChain{
+		Authority: addr1.String(),
+		ChainId:   "chain-1",
+	}
+	newMsg := types.NewMsgDeregisterHostChain(addr1, "chain-1")
+	require.Equal(t, msg, newMsg)
+	require.Equal(t, types.ModuleName, msg.Route())
+	require.Equal(t, types.MsgTypeDeregisterHostChain, msg.Type())
+	require.Equal(t, addr1, msg.GetSigners()[0])
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	require.NoError(t, msg.ValidateBasic())
+	require.Error(t, types.NewMsgDeregisterHostChain(sdk.AccAddress{}, "chain-1").ValidateBasic())
+	require.Error(t, types.NewMsgDeregisterHostChain(addr1, "").ValidateBasic())
+}
+
+func TestMsgCancelTimelockedUpdate(t *testing.T) {
+	msg := &types.MsgCancelTimelockedUpdate{
+		Authority: addr1.String(),
+		ChainId:   "chain-1",
+		Id:        1,
+	}
+	newMsg := types.NewMsgCancelTimelockedUpdate(addr1, "chain-1", 1)
+	require.Equal(t, msg, newMsg)
+	require.Equal(t, types.ModuleName, msg.Route())
+	require.Equal(t, types.MsgTypeCancelTimelockedUpdate, msg.Type())
+	require.Equal(t, addr1, msg.GetSigners()[0])
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	require.NoError(t, msg.ValidateBasic())
+	require.Error(t, types.NewMsgCancelTimelockedUpdate(sdk.AccAddress{}, "chain-1", 1).ValidateBasic())
+	require.Error(t, types.NewMsgCancelTimelockedUpdate(addr1, "", 1).ValidateBasic())
+	require.Error(t, types.NewMsgCancelTimelockedUpdate(addr1, "chain-1", 0).ValidateBasic())
+}
@@ -10,6 +10,10 @@ var (
 	DefaultFeeAddress       = authtypes.NewModuleAddress("placeholder") // will be set manually upon module initialisation
 	DefaultUpperCValueLimit = sdktypes.MustNewDecFromStr("1.1")
 	DefaultLowerCValueLimit = sdktypes.MustNewDecFromStr("0.85")
+
+	DefaultUpdateTimelockEpochs int64  = 2   // 2 days of delegation epochs
+	DefaultCValueHistoryLength  uint64 = 720 // 30 days of hourly c value epochs
+	DefaultMaxRecordsPerBlock   uint64 = 100
 )
 
 // NewParams creates a new Params object
@@ -18,13 +22,25 @@ func NewParams(
 	feeAddress string,
 	upperCValueLimit sdktypes.Dec,
 	lowerCValueLimit sdktypes.Dec,
+	guardianAddress string,
+	feeManagerAddress string,
+	validatorSetManagerAddress string,
+	updateTimelockEpochs int64,
+	cValueHistoryLength uint64,
+	maxRecordsPerBlock uint64,
 ) Params {
 
 	return Params{
-		AdminAddress:     adminAddress,
-		FeeAddress:       feeAddress,
-		UpperCValueLimit: upperCValueLimit,
-		LowerCValueLimit: lowerCValueLimit,
+		AdminAddress:               adminAddress,
+		FeeAddress:                 feeAddress,
+		UpperCValueLimit:           upperCValueLimit,
+		LowerCValueLimit:           lowerCValueLimit,
+		GuardianAddress:            guardianAddress,
+		FeeManagerAddress:          feeManagerAddress,
+		ValidatorSetManagerAddress: validatorSetManagerAddress,
+		UpdateTimelockEpochs:       updateTimelockEpochs,
+		CValueHistoryLength:        cValueHistoryLength,
+		MaxRecordsPerBlock:         maxRecordsPerBlock,
 	}
 }
 
@@ -35,6 +51,12 @@ func DefaultParams() Params {
 		DefaultFeeAddress.String(),
 		DefaultUpperCValueLimit,
 		DefaultLowerCValueLimit,
+		"",
+		"",
+		"",
+		DefaultUpdateTimelockEpochs,
+		DefaultCValueHistoryLength,
+		DefaultMaxRecordsPerBlock,
 	)
 }
 
@@ -46,9 +68,62 @@ func (p *Params) Validate() error {
 	if _, err := sdktypes.AccAddressFromBech32(p.FeeAddress); err != nil {
 		return err
 	}
+	// the operational roles are optional
+	for _, address := range []string{p.GuardianAddress, p.FeeManagerAddress, p.ValidatorSetManagerAddress} {
+		if address == "" {
+			continue
+		}
+		if _, err := sdktypes.AccAddressFromBech32(address); err != nil {
+			return err
+		}
+	}
 	if p.LowerCValueLimit.GT(sdktypes.OneDec()) || p.LowerCValueLimit.GTE(p.UpperCValueLimit) {
 		return ErrInvalidParams.Wrapf("LowerCValue limit should be less than both 1 and UpperCValue limit, lowerCValue: %s, UpperCValue: %s", p.LowerCValueLimit, p.UpperCValueLimit)
 	}
+	if p.UpdateTimelockEpochs < 0 {
+		return ErrInvalidParams.Wrapf("update timelock epochs should not be negative, timelock: %d", p.UpdateTimelockEpochs)
+	}
+	if p.MaxRecordsPerBlock == 0 {
+		return ErrInvalidParams.Wrap("max records per block should be positive")
+	}
 
 	return nil
 }
+
+// CanUpdateHostChainKey returns whether the address holds a role that allows it to update the host chain key.
+// The admin can update every key, while the rest of the roles can only update the keys related to them.
+func (p *Params) CanUpdateHostChainKey(address, key string) bool {
+	if address == "" {
+		return false
+	}
+
+	if address == p.AdminAddress {
+		return true
+	}
+
+	var role string
+	switch key {
+	case KeyPauses:
+		role = p.GuardianAddress
+	case KeyDepositFee, KeyRestakeFee, KeyUnstakeFee, KeyRedemptionFee:
+		role = p.FeeManagerAddress
+	case KeyAddValidator, KeyRemoveValidator, KeyValidatorUpdate, KeyValidatorWeight, KeyDelegationStrategy,
+		KeyRebalance, KeyMaxRedelegationEntries, KeyMaxValidatorCommission, KeyValidatorSetConfig, KeyApplyValidatorSet:
+		role = p.ValidatorSetManagerAddress
+	}
+
+	// disabled roles are empty and match no address
+	return role != "" && address == role
+}
+
+// IsTimelockedKey returns whether the host chain updates of the key are sensitive, so they are queued behind the
+// update timelock before being applied
+func IsTimelockedKey(key string) bool {
+	switch key {
+	case KeyDepositFee, KeyRestakeFee, KeyUnstakeFee, KeyRedemptionFee,
+		KeyRemoveValidator, KeyValidatorWeight, KeyApplyValidatorSet, KeyAutocompoundFactor, KeyFlags:
+		return true
+	default:
+		return false
+	}
+}
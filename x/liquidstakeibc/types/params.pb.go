@@ -28,10 +28,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
-	AdminAddress     string                                 `protobuf:"bytes,1,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty"`
-	FeeAddress       string                                 `protobuf:"bytes,2,opt,name=fee_address,json=feeAddress,proto3" json:"fee_address,omitempty"`
-	UpperCValueLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=upper_c_value_limit,json=upperCValueLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"upper_c_value_limit"`
-	LowerCValueLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=lower_c_value_limit,json=lowerCValueLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lower_c_value_limit"`
+	AdminAddress               string                                 `protobuf:"bytes,1,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty"`
+	FeeAddress                 string                                 `protobuf:"bytes,2,opt,name=fee_address,json=feeAddress,proto3" json:"fee_address,omitempty"`
+	UpperCValueLimit           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=upper_c_value_limit,json=upperCValueLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"upper_c_value_limit"`
+	LowerCValueLimit           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=lower_c_value_limit,json=lowerCValueLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lower_c_value_limit"`
+	GuardianAddress            string                                 `protobuf:"bytes,5,opt,name=guardian_address,json=guardianAddress,proto3" json:"guardian_address,omitempty"`
+	FeeManagerAddress          string                                 `protobuf:"bytes,6,opt,name=fee_manager_address,json=feeManagerAddress,proto3" json:"fee_manager_address,omitempty"`
+	ValidatorSetManagerAddress string                                 `protobuf:"bytes,7,opt,name=validator_set_manager_address,json=validatorSetManagerAddress,proto3" json:"validator_set_manager_address,omitempty"`
+	// number of delegation epochs the sensitive host chain updates are queued for, applied right away if 0
+	UpdateTimelockEpochs int64 `protobuf:"varint,8,opt,name=update_timelock_epochs,json=updateTimelockEpochs,proto3" json:"update_timelock_epochs,omitempty"`
+	// number of c value computations kept in the history of each host chain, not recorded if 0
+	CValueHistoryLength uint64 `protobuf:"varint,9,opt,name=c_value_history_length,json=cValueHistoryLength,proto3" json:"c_value_history_length,omitempty"`
+	// number of records each begin block workflow visits per host chain in a block
+	MaxRecordsPerBlock uint64 `protobuf:"varint,10,opt,name=max_records_per_block,json=maxRecordsPerBlock,proto3" json:"max_records_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -81,6 +90,48 @@ func (m *Params) GetFeeAddress() string {
 	return ""
 }
 
+func (m *Params) GetGuardianAddress() string {
+	if m != nil {
+		return m.GuardianAddress
+	}
+	return ""
+}
+
+func (m *Params) GetFeeManagerAddress() string {
+	if m != nil {
+		return m.FeeManagerAddress
+	}
+	return ""
+}
+
+func (m *Params) GetValidatorSetManagerAddress() string {
+	if m != nil {
+		return m.ValidatorSetManagerAddress
+	}
+	return ""
+}
+
+func (m *Params) GetUpdateTimelockEpochs() int64 {
+	if m != nil {
+		return m.UpdateTimelockEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetCValueHistoryLength() uint64 {
+	if m != nil {
+		return m.CValueHistoryLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRecordsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxRecordsPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "pstake.liquidstakeibc.v1beta1.Params")
 }
@@ -90,31 +141,41 @@ func init() {
 }
 
 var fileDescriptor_ed8bf02c8aabc0b0 = []byte{
-	// 369 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2a, 0x28, 0x2e, 0x49,
-	0xcc, 0x4e, 0xd5, 0xcf, 0xc9, 0x2c, 0x2c, 0xcd, 0x4c, 0x01, 0xb3, 0x33, 0x93, 0x92, 0xf5, 0xcb,
-	0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0x64, 0x21, 0x6a, 0xf5, 0x50, 0xd5, 0xea, 0x41, 0xd5, 0x4a, 0x89, 0xa4,
-	0xe7, 0xa7, 0xe7, 0x83, 0x55, 0xea, 0x83, 0x58, 0x10, 0x4d, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9,
-	0xf9, 0xc5, 0xf1, 0x10, 0x09, 0x08, 0x07, 0x2a, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f,
-	0x26, 0x21, 0x42, 0x4a, 0x8d, 0xcc, 0x5c, 0x6c, 0x01, 0x60, 0x3b, 0x85, 0x6c, 0xb9, 0x78, 0x13,
-	0x53, 0x72, 0x33, 0xf3, 0xe2, 0x13, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x8b, 0x25, 0x18, 0x15, 0x18,
-	0x35, 0x38, 0x9d, 0x24, 0x2e, 0x6d, 0xd1, 0x15, 0x81, 0x1a, 0xe3, 0x08, 0x91, 0x09, 0x2e, 0x29,
-	0xca, 0xcc, 0x4b, 0x0f, 0xe2, 0x01, 0x2b, 0x87, 0x8a, 0x09, 0x59, 0x72, 0x71, 0xa7, 0xa5, 0xa6,
-	0xc2, 0x35, 0x33, 0x11, 0xd0, 0xcc, 0x95, 0x96, 0x9a, 0x0a, 0xd3, 0x9a, 0xcd, 0x25, 0x5c, 0x5a,
-	0x50, 0x90, 0x5a, 0x14, 0x9f, 0x1c, 0x5f, 0x96, 0x98, 0x53, 0x9a, 0x1a, 0x9f, 0x93, 0x99, 0x9b,
-	0x59, 0x22, 0xc1, 0x0c, 0x36, 0xc2, 0xe6, 0xc4, 0x3d, 0x79, 0x86, 0x5b, 0xf7, 0xe4, 0xd5, 0xd2,
-	0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xa1, 0xbe, 0x82, 0x52, 0xba, 0xc5, 0x29,
-	0xd9, 0xfa, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x7a, 0x2e, 0xa9, 0xc9, 0x97, 0xb6, 0xe8, 0x72, 0x41,
-	0x2d, 0x74, 0x49, 0x4d, 0x0e, 0x12, 0x00, 0x1b, 0xec, 0x1c, 0x06, 0x32, 0xd6, 0x07, 0x64, 0x2a,
-	0xc8, 0xb2, 0x9c, 0xfc, 0x72, 0x0c, 0xcb, 0x58, 0xa8, 0x61, 0x19, 0xd8, 0x60, 0x24, 0xcb, 0xac,
-	0x94, 0xbb, 0x9e, 0x6f, 0xd0, 0x92, 0x83, 0x46, 0x79, 0x05, 0x7a, 0xa4, 0x43, 0x02, 0xde, 0x29,
-	0xfa, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x1c, 0x91, 0x9c, 0x51, 0x90,
-	0x5a, 0x54, 0x9c, 0x59, 0x5c, 0x92, 0x9a, 0x97, 0x9c, 0xea, 0x9f, 0x97, 0xaa, 0x0f, 0x31, 0x53,
-	0x37, 0x2f, 0xb1, 0x24, 0xb3, 0x2c, 0x55, 0xbf, 0xcc, 0x08, 0xd3, 0x74, 0xb0, 0x2b, 0x93, 0xd8,
-	0xc0, 0xf1, 0x6c, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x14, 0xca, 0xbb, 0x78, 0x02, 0x00,
-	0x00,
+	// 533 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x63, 0x5a, 0x02, 0x3d, 0x40, 0xb4, 0x4e, 0xa8, 0x4c, 0xa4, 0xba, 0x11, 0x48, 0x28,
+	0xaa, 0x94, 0x58, 0xa1, 0x2c, 0x20, 0x18, 0x9a, 0x16, 0xa9, 0x43, 0x11, 0x55, 0x8a, 0x18, 0xe8,
+	0x70, 0xba, 0x9c, 0x5f, 0x9c, 0x53, 0x6c, 0x9f, 0xb9, 0x3b, 0x87, 0xf4, 0x2b, 0xc0, 0xc2, 0x47,
+	0x61, 0xe8, 0x87, 0xe8, 0x58, 0x75, 0x42, 0x0c, 0x15, 0x4a, 0x06, 0xbe, 0x06, 0xf2, 0x9d, 0x1d,
+	0x4a, 0x33, 0x64, 0xe9, 0x92, 0x9c, 0xef, 0xff, 0xfe, 0xbf, 0xbf, 0xfc, 0xfc, 0x1e, 0xda, 0x4a,
+	0xa4, 0x22, 0x43, 0xf0, 0x42, 0xf6, 0x39, 0x65, 0xbe, 0x3e, 0xb3, 0x1e, 0xf5, 0x46, 0xed, 0x1e,
+	0x28, 0xd2, 0xf6, 0x12, 0x22, 0x48, 0x24, 0x5b, 0x89, 0xe0, 0x8a, 0xdb, 0x1b, 0xa6, 0xb6, 0xf5,
+	0x7f, 0x6d, 0x2b, 0xaf, 0xad, 0x55, 0x03, 0x1e, 0x70, 0x5d, 0xe9, 0x65, 0x27, 0x63, 0xaa, 0x3d,
+	0xa6, 0x5c, 0x46, 0x5c, 0x62, 0x23, 0x98, 0x87, 0x5c, 0x5a, 0x23, 0x11, 0x8b, 0xb9, 0xa7, 0x7f,
+	0xcd, 0xd5, 0x93, 0x6f, 0x65, 0x54, 0x3e, 0xd4, 0x99, 0xf6, 0x1b, 0xf4, 0x80, 0xf8, 0x11, 0x8b,
+	0x31, 0xf1, 0x7d, 0x01, 0x52, 0x3a, 0x56, 0xdd, 0x6a, 0xac, 0x74, 0x9c, 0x8b, 0xd3, 0x66, 0x35,
+	0xc7, 0xec, 0x18, 0xe5, 0x48, 0x09, 0x16, 0x07, 0xdd, 0xfb, 0xba, 0x3c, 0xbf, 0xb3, 0x5f, 0xa2,
+	0x7b, 0x7d, 0x80, 0x99, 0xf9, 0xd6, 0x02, 0x33, 0xea, 0x03, 0x14, 0xd6, 0x21, 0xaa, 0xa4, 0x49,
+	0x02, 0x02, 0x53, 0x3c, 0x22, 0x61, 0x0a, 0x38, 0x64, 0x11, 0x53, 0xce, 0x92, 0x46, 0xbc, 0x3e,
+	0xbb, 0xdc, 0x2c, 0xfd, 0xba, 0xdc, 0x7c, 0x16, 0x30, 0x35, 0x48, 0x7b, 0x2d, 0xca, 0xa3, 0xfc,
+	0xad, 0xf2, 0xbf, 0xa6, 0xf4, 0x87, 0x9e, 0x3a, 0x49, 0x40, 0xb6, 0xf6, 0x80, 0x5e, 0x9c, 0x36,
+	0x51, 0x1e, 0xb8, 0x07, 0xb4, 0xbb, 0xaa, 0xc1, 0xbb, 0x1f, 0x33, 0xec, 0x41, 0x46, 0xcd, 0xc2,
+	0x42, 0xfe, 0x65, 0x2e, 0x6c, 0xf9, 0x26, 0xc2, 0x34, 0xf8, 0x6a, 0xd8, 0x2e, 0x5a, 0x0d, 0x52,
+	0x22, 0x7c, 0x46, 0xfe, 0xb5, 0xf5, 0xf6, 0x82, 0xce, 0x3c, 0x2c, 0x1c, 0x45, 0x7b, 0xf6, 0x51,
+	0x25, 0xeb, 0x6c, 0x44, 0x62, 0x12, 0x80, 0x98, 0x71, 0xca, 0x0b, 0x38, 0x6b, 0x7d, 0x80, 0x77,
+	0xc6, 0x53, 0x90, 0x8e, 0xd1, 0xc6, 0x88, 0x84, 0xcc, 0x27, 0x8a, 0x0b, 0x2c, 0x41, 0xcd, 0x31,
+	0xef, 0x2c, 0x60, 0xd6, 0x66, 0xf6, 0x23, 0x50, 0xd7, 0xe0, 0x2f, 0xd0, 0x7a, 0x9a, 0xf8, 0x44,
+	0x01, 0x56, 0x2c, 0x82, 0x90, 0xd3, 0x21, 0x86, 0x84, 0xd3, 0x81, 0x74, 0xee, 0xd6, 0xad, 0xc6,
+	0x52, 0xb7, 0x6a, 0xd4, 0x0f, 0xb9, 0xf8, 0x56, 0x6b, 0xf6, 0x36, 0x5a, 0x2f, 0x3e, 0xc4, 0x80,
+	0x49, 0xc5, 0xc5, 0x09, 0x0e, 0x21, 0x0e, 0xd4, 0xc0, 0x59, 0xa9, 0x5b, 0x8d, 0xe5, 0x6e, 0x85,
+	0xea, 0x76, 0xee, 0x1b, 0xed, 0x40, 0x4b, 0x76, 0x1b, 0x3d, 0x8a, 0xc8, 0x18, 0x0b, 0xa0, 0x5c,
+	0xf8, 0x12, 0x67, 0xa3, 0xd3, 0xcb, 0x98, 0x0e, 0xd2, 0x1e, 0x3b, 0x22, 0xe3, 0xae, 0xd1, 0x0e,
+	0x41, 0x74, 0x32, 0xe5, 0xd5, 0xd3, 0xaf, 0x7f, 0x7e, 0x6c, 0xb9, 0xf9, 0xf2, 0x8d, 0xaf, 0xaf,
+	0x9f, 0x59, 0x81, 0xce, 0xf1, 0xd9, 0xc4, 0xb5, 0xce, 0x27, 0xae, 0xf5, 0x7b, 0xe2, 0x5a, 0xdf,
+	0xa7, 0x6e, 0xe9, 0x7c, 0xea, 0x96, 0x7e, 0x4e, 0xdd, 0xd2, 0xa7, 0x9d, 0x2b, 0x03, 0x91, 0x80,
+	0x90, 0x4c, 0x2a, 0x88, 0x29, 0xbc, 0x8f, 0xc1, 0x33, 0xcc, 0x66, 0x4c, 0x14, 0x1b, 0x81, 0x37,
+	0x7a, 0x3e, 0x4f, 0xd7, 0xf3, 0xd2, 0x2b, 0xeb, 0x8d, 0xdb, 0xfe, 0x3b, 0x00, 0x13, 0x2c, 0xd6,
+	0xd6, 0x02, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -137,6 +198,42 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxRecordsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRecordsPerBlock))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CValueHistoryLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CValueHistoryLength))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.UpdateTimelockEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UpdateTimelockEpochs))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ValidatorSetManagerAddress) > 0 {
+		i -= len(m.ValidatorSetManagerAddress)
+		copy(dAtA[i:], m.ValidatorSetManagerAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ValidatorSetManagerAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FeeManagerAddress) > 0 {
+		i -= len(m.FeeManagerAddress)
+		copy(dAtA[i:], m.FeeManagerAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeManagerAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.GuardianAddress) > 0 {
+		i -= len(m.GuardianAddress)
+		copy(dAtA[i:], m.GuardianAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.GuardianAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.LowerCValueLimit.Size()
 		i -= size
@@ -203,6 +300,27 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.LowerCValueLimit.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = len(m.GuardianAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.FeeManagerAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.ValidatorSetManagerAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.UpdateTimelockEpochs != 0 {
+		n += 1 + sovParams(uint64(m.UpdateTimelockEpochs))
+	}
+	if m.CValueHistoryLength != 0 {
+		n += 1 + sovParams(uint64(m.CValueHistoryLength))
+	}
+	if m.MaxRecordsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxRecordsPerBlock))
+	}
 	return n
 }
 
@@ -373,6 +491,159 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeManagerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeManagerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetManagerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSetManagerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTimelockEpochs", wireType)
+			}
+			m.UpdateTimelockEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateTimelockEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CValueHistoryLength", wireType)
+			}
+			m.CValueHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CValueHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordsPerBlock", wireType)
+			}
+			m.MaxRecordsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecordsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
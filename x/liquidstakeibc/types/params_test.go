@@ -10,10 +10,13 @@ import (
 
 func TestParams_Validate(t *testing.T) {
 	type fields struct {
-		AdminAddress     sdk.AccAddress
-		FeeAddress       sdk.AccAddress
-		UpperCValueLimit sdk.Dec
-		LowerCValueLimit sdk.Dec
+		AdminAddress       sdk.AccAddress
+		FeeAddress         sdk.AccAddress
+		UpperCValueLimit   sdk.Dec
+		LowerCValueLimit   sdk.Dec
+		GuardianAddress    string
+		FeeManagerAddress  string
+		MaxRecordsPerBlock uint64
 	}
 	tests := []struct {
 		name    string
@@ -23,40 +26,90 @@ func TestParams_Validate(t *testing.T) {
 		{
 			name: "Valid Params",
 			fields: fields{
-				AdminAddress:     types.DefaultAdminAddress,
-				FeeAddress:       types.DefaultFeeAddress,
-				UpperCValueLimit: sdk.OneDec(),
-				LowerCValueLimit: sdk.ZeroDec(),
+				AdminAddress:       types.DefaultAdminAddress,
+				FeeAddress:         types.DefaultFeeAddress,
+				UpperCValueLimit:   sdk.OneDec(),
+				LowerCValueLimit:   sdk.ZeroDec(),
+				MaxRecordsPerBlock: types.DefaultMaxRecordsPerBlock,
 			},
 			wantErr: false,
 		},
 		{
 			name: "Invalid admin address",
 			fields: fields{
-				AdminAddress:     sdk.AccAddress{},
-				FeeAddress:       types.DefaultFeeAddress,
-				UpperCValueLimit: sdk.OneDec(),
-				LowerCValueLimit: sdk.ZeroDec(),
+				AdminAddress:       sdk.AccAddress{},
+				FeeAddress:         types.DefaultFeeAddress,
+				UpperCValueLimit:   sdk.OneDec(),
+				LowerCValueLimit:   sdk.ZeroDec(),
+				MaxRecordsPerBlock: types.DefaultMaxRecordsPerBlock,
 			},
 			wantErr: true,
 		},
 		{
 			name: "invalid fee address",
 			fields: fields{
-				AdminAddress:     types.DefaultAdminAddress,
-				FeeAddress:       sdk.AccAddress{},
-				UpperCValueLimit: sdk.OneDec(),
-				LowerCValueLimit: sdk.ZeroDec(),
+				AdminAddress:       types.DefaultAdminAddress,
+				FeeAddress:         sdk.AccAddress{},
+				UpperCValueLimit:   sdk.OneDec(),
+				LowerCValueLimit:   sdk.ZeroDec(),
+				MaxRecordsPerBlock: types.DefaultMaxRecordsPerBlock,
 			},
 			wantErr: true,
 		},
 		{
 			name: "Invalid Lower Limit",
+			fields: fields{
+				AdminAddress:       types.DefaultAdminAddress,
+				FeeAddress:         types.DefaultFeeAddress,
+				UpperCValueLimit:   sdk.OneDec(),
+				LowerCValueLimit:   sdk.OneDec(),
+				MaxRecordsPerBlock: types.DefaultMaxRecordsPerBlock,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Valid guardian address",
+			fields: fields{
+				AdminAddress:       types.DefaultAdminAddress,
+				FeeAddress:         types.DefaultFeeAddress,
+				UpperCValueLimit:   sdk.OneDec(),
+				LowerCValueLimit:   sdk.ZeroDec(),
+				MaxRecordsPerBlock: types.DefaultMaxRecordsPerBlock,
+				GuardianAddress:    types.DefaultAdminAddress.String(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid guardian address",
+			fields: fields{
+				AdminAddress:       types.DefaultAdminAddress,
+				FeeAddress:         types.DefaultFeeAddress,
+				UpperCValueLimit:   sdk.OneDec(),
+				LowerCValueLimit:   sdk.ZeroDec(),
+				MaxRecordsPerBlock: types.DefaultMaxRecordsPerBlock,
+				GuardianAddress:    "guardian",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid fee manager address",
+			fields: fields{
+				AdminAddress:       types.DefaultAdminAddress,
+				FeeAddress:         types.DefaultFeeAddress,
+				UpperCValueLimit:   sdk.OneDec(),
+				LowerCValueLimit:   sdk.ZeroDec(),
+				MaxRecordsPerBlock: types.DefaultMaxRecordsPerBlock,
+				FeeManagerAddress:  "fee-manager",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Zero max records per block",
 			fields: fields{
 				AdminAddress:     types.DefaultAdminAddress,
 				FeeAddress:       types.DefaultFeeAddress,
 				UpperCValueLimit: sdk.OneDec(),
-				LowerCValueLimit: sdk.OneDec(),
+				LowerCValueLimit: sdk.ZeroDec(),
 			},
 			wantErr: true,
 		},
@@ -64,10 +117,13 @@ func TestParams_Validate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := &types.Params{
-				AdminAddress:     tt.fields.AdminAddress.String(),
-				FeeAddress:       tt.fields.FeeAddress.String(),
-				UpperCValueLimit: tt.fields.UpperCValueLimit,
-				LowerCValueLimit: tt.fields.LowerCValueLimit,
+				AdminAddress:       tt.fields.AdminAddress.String(),
+				FeeAddress:         tt.fields.FeeAddress.String(),
+				UpperCValueLimit:   tt.fields.UpperCValueLimit,
+				LowerCValueLimit:   tt.fields.LowerCValueLimit,
+				GuardianAddress:    tt.fields.GuardianAddress,
+				FeeManagerAddress:  tt.fields.FeeManagerAddress,
+				MaxRecordsPerBlock: tt.fields.MaxRecordsPerBlock,
 			}
 			if err := p.Validate(); (err != nil) != tt.wantErr {
 				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
@@ -75,3 +131,55 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_CanUpdateHostChainKey(t *testing.T) {
+	admin := sdk.AccAddress("admin").String()
+	guardian := sdk.AccAddress("guardian").String()
+	feeManager := sdk.AccAddress("fee-manager").String()
+	validatorSetManager := sdk.AccAddress("validator-set-manager").String()
+
+	params := types.NewParams(
+		admin,
+		types.DefaultFeeAddress.String(),
+		types.DefaultUpperCValueLimit,
+		types.DefaultLowerCValueLimit,
+		guardian,
+		feeManager,
+		validatorSetManager,
+		0,
+		types.DefaultCValueHistoryLength,
+		types.DefaultMaxRecordsPerBlock,
+	)
+
+	tests := []struct {
+		name    string
+		address string
+		key     string
+		want    bool
+	}{
+		{"admin updates any key", admin, types.KeyActive, true},
+		{"guardian updates pauses", guardian, types.KeyPauses, true},
+		{"guardian can't update fees", guardian, types.KeyDepositFee, false},
+		{"fee manager updates fees", feeManager, types.KeyRedemptionFee, true},
+		{"fee manager can't update pauses", feeManager, types.KeyPauses, false},
+		{"validator set manager updates weights", validatorSetManager, types.KeyValidatorWeight, true},
+		{"validator set manager can't update active", validatorSetManager, types.KeyActive, false},
+		{"unknown address", sdk.AccAddress("other").String(), types.KeyPauses, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := params.CanUpdateHostChainKey(tt.address, tt.key); got != tt.want {
+				t.Errorf("CanUpdateHostChainKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// unset roles can't update any key
+	params.GuardianAddress = ""
+	if params.CanUpdateHostChainKey("", types.KeyPauses) {
+		t.Errorf("CanUpdateHostChainKey() = true for an empty address")
+	}
+	if params.CanUpdateHostChainKey(guardian, types.KeyPauses) {
+		t.Errorf("CanUpdateHostChainKey() = true for an unset role")
+	}
+}